@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/royalfork/usdx/pkg/srcmap"
+	"github.com/royalfork/usdx/pkg/txdebug"
+)
+
+func init() {
+	register("debug", "explain why a transaction failed", debug)
+}
+
+func debug(args []string) error {
+	fs := newFlagSet("debug", "[-rpc <url>] [-combined <file>] <txhash>")
+	var (
+		url      = fs.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint supporting debug_traceTransaction")
+		combined = fs.String("combined", "", "solc --combined-json output, to show source lines")
+		dir      = fs.String("dir", "", "directory solc was run in (default: the combined file's directory)")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("expected a transaction hash")
+	}
+	b, err := hexutil.Decode(fs.Arg(0))
+	if err != nil || len(b) != common.HashLength {
+		return fmt.Errorf("invalid transaction hash: %s", fs.Arg(0))
+	}
+
+	var prog *srcmap.Program
+	if *combined != "" {
+		if *dir == "" {
+			*dir = filepath.Dir(*combined)
+		}
+		if prog, err = srcmap.LoadCombined(*combined, *dir); err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	client, err := rpc.DialContext(ctx, *url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	res, err := txdebug.DebugRPC(ctx, client, common.BytesToHash(b), prog)
+	if err != nil {
+		return err
+	}
+	return res.Write(os.Stdout)
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/royalfork/usdx/pkg/devnet"
+)
+
+func init() {
+	register("devnet", "run a local chain with USDX and MockOracle deployed", runDevnet)
+}
+
+func runDevnet(args []string) error {
+	fs := newFlagSet("devnet", "[-addr <host:port>] [-accounts <names>] [-balance <eth>] [-price <usd>]")
+	var (
+		addr     = fs.String("addr", "127.0.0.1:8545", "HTTP and WebSocket JSON-RPC listen address")
+		accounts = fs.String("accounts", strings.Join(devnet.DefaultConfig.Accounts, ","), "comma separated names of funded accounts; the first owns the contracts")
+		balance  = fs.Uint64("balance", 1000, "eth funded to each account")
+		price    = fs.String("price", "2000", "initial eth/usd oracle price")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	p, err := devnet.ParsePrice(*price)
+	if err != nil {
+		return err
+	}
+	d, err := devnet.New(devnet.Config{
+		Accounts: strings.Split(*accounts, ","),
+		Balance:  new(big.Int).Mul(new(big.Int).SetUint64(*balance), big.NewInt(1e18)),
+		Price:    p,
+	})
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+	srv := d.Server()
+	defer srv.Stop()
+	go http.Serve(ln, devnet.Handler(srv))
+
+	fmt.Printf("rpc:        http://%s ws://%s\n", ln.Addr(), ln.Addr())
+	fmt.Printf("chain id:   %v\n", d.ChainID())
+	fmt.Printf("MockOracle: %s\n", d.OracleAddr.Hex())
+	fmt.Printf("USDX:       %s\n", d.USDXAddr.Hex())
+	fmt.Printf("accounts:\n")
+	for _, a := range d.Accounts {
+		fmt.Printf("  %-8s %s  key: %x\n", a.Name, a.Addr.Hex(), crypto.FromECDSA(a.Key))
+	}
+	fmt.Printf("\nadmin methods: devnet_setPrice(\"<usd>\"), devnet_mine([blocks]), devnet_increaseTime(seconds), devnet_info()\n")
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	<-sigs
+	return ln.Close()
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/royalfork/usdx/pkg/accounting"
+)
+
+func init() {
+	register("export", "export a tax ledger or journal of addresses' USDX history", export)
+}
+
+func export(args []string) error {
+	fs := newFlagSet("export", "[-rpc <url>] [-from <n>] [-to <n>] [-method fifo|average] [-format ledger|journal] [-out <file>] <usdx address> <address>...")
+	var (
+		url    = fs.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint, which must support calls at past blocks")
+		from   = fs.Uint64("from", 0, "first block of history")
+		to     = fs.Int64("to", -1, "last block of history (default: latest)")
+		method = fs.String("method", "fifo", "lot matching method: fifo or average")
+		format = fs.String("format", "ledger", "output format: ledger (acquisitions and disposals) or journal (double-entry)")
+		out    = fs.String("out", "", "file to write to (default: stdout)")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 2 {
+		fs.Usage()
+		return fmt.Errorf("expected a USDX address and addresses to export")
+	}
+	var addrs []common.Address
+	for _, a := range fs.Args() {
+		if !common.IsHexAddress(a) {
+			return fmt.Errorf("invalid address %q", a)
+		}
+		addrs = append(addrs, common.HexToAddress(a))
+	}
+	m, err := accounting.ParseMethod(*method)
+	if err != nil {
+		return err
+	}
+	if *format != "ledger" && *format != "journal" {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, *url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	last := uint64(*to)
+	if *to < 0 {
+		if last, err = client.BlockNumber(ctx); err != nil {
+			return err
+		}
+	}
+	events, err := accounting.Events(ctx, client, addrs[0], addrs[1:], *from, last)
+	if err != nil {
+		return err
+	}
+	l := accounting.NewLedger(m)
+	for i := range events {
+		l.Add(&events[i])
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	if *format == "journal" {
+		return l.WriteJournal(w)
+	}
+	return l.WriteCSV(w)
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/royalfork/usdx/pkg/keeper"
+)
+
+func init() {
+	register("keeper", "execute USDX stop-loss and take-profit orders once triggered", runKeeper)
+	register("collector", "collect the appreciation of USDX accounts which enrolled the collector", runCollector)
+}
+
+func runKeeper(args []string) error {
+	fs := newFlagSet("keeper", "[-rpc <url>] [-key <hex>] [-interval <duration>] <usdx address>")
+	var (
+		url      = fs.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+		key      = fs.String("key", "", "hex private key of the account sending executions (default: $USDX_KEY)")
+		interval = fs.Duration("interval", 2*time.Second, "how often to poll for new blocks")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || !common.IsHexAddress(fs.Arg(0)) {
+		fs.Usage()
+		return fmt.Errorf("expected a USDX address")
+	}
+
+	ctx, stop := interruptContext()
+	defer stop()
+	client, auth, err := dialSigner(ctx, *url, *key)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	k, err := keeper.New(client, common.HexToAddress(fs.Arg(0)), auth)
+	if err != nil {
+		return err
+	}
+	k.Logf = log.Printf
+	log.Printf("keeping orders of %s from %s", fs.Arg(0), auth.From.Hex())
+	if err := k.Run(ctx, *interval); err != nil && err != context.Canceled {
+		return err
+	}
+	return nil
+}
+
+func runCollector(args []string) error {
+	fs := newFlagSet("collector", "[-rpc <url>] [-key <hex>] [-interval <duration>] <usdx address>")
+	var (
+		url      = fs.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+		key      = fs.String("key", "", "hex private key of the collector account (default: $USDX_KEY)")
+		interval = fs.Duration("interval", 2*time.Second, "how often to poll for new blocks")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || !common.IsHexAddress(fs.Arg(0)) {
+		fs.Usage()
+		return fmt.Errorf("expected a USDX address")
+	}
+
+	ctx, stop := interruptContext()
+	defer stop()
+	client, auth, err := dialSigner(ctx, *url, *key)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	c, err := keeper.NewCollector(client, common.HexToAddress(fs.Arg(0)), auth)
+	if err != nil {
+		return err
+	}
+	c.Logf = log.Printf
+	log.Printf("collecting appreciation of %s accounts enrolling %s", fs.Arg(0), auth.From.Hex())
+	if err := c.Run(ctx, *interval); err != nil && err != context.Canceled {
+		return err
+	}
+	return nil
+}
+
+// interruptContext returns a context which is cancelled on interrupt.
+func interruptContext() (context.Context, context.CancelFunc) {
+	ctx, stop := context.WithCancel(context.Background())
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	go func() {
+		select {
+		case <-sig:
+			stop()
+		case <-ctx.Done():
+		}
+		signal.Stop(sig)
+	}()
+	return ctx, stop
+}
+
+// dialSigner dials url, and returns a transactor signing with key, or
+// $USDX_KEY if key is empty, for the chain it's connected to.
+func dialSigner(ctx context.Context, url, key string) (*ethclient.Client, *bind.TransactOpts, error) {
+	priv, err := parseKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := ethclient.DialContext(ctx, url)
+	if err != nil {
+		return nil, nil, err
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(priv, chainID)
+	if err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+	return client, auth, nil
+}
+
+// parseKey parses a hex private key, such as one printed by devnet.  If
+// hex is empty, the key is read from $USDX_KEY, so it needn't be passed
+// on the command line.
+func parseKey(hex string) (*ecdsa.PrivateKey, error) {
+	if hex == "" {
+		hex = os.Getenv("USDX_KEY")
+	}
+	if hex == "" {
+		return nil, fmt.Errorf("no private key: pass -key or set USDX_KEY")
+	}
+	priv, err := crypto.HexToECDSA(strings.TrimPrefix(hex, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %v", err)
+	}
+	return priv, nil
+}
@@ -0,0 +1,69 @@
+// Command usdx is a collection of tools for working with the USDX
+// contract.
+//
+// Usage:
+//
+//	usdx <command> [flags]
+//
+// Run "usdx <command> -h" for the flags of each command.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+// command is a usdx subcommand.  run is passed the command's
+// arguments, excluding the command name.
+type command struct {
+	name  string
+	short string
+	run   func(args []string) error
+}
+
+var commands []command
+
+// register adds a subcommand.  Subcommands register themselves in
+// init.
+func register(name, short string, run func(args []string) error) {
+	commands = append(commands, command{name, short, run})
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: usdx <command> [flags]\n\ncommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", c.name, c.short)
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	for _, c := range commands {
+		if c.name == os.Args[1] {
+			if err := c.run(os.Args[2:]); err != nil {
+				if err != flag.ErrHelp {
+					fmt.Fprintf(os.Stderr, "usdx %s: %v\n", c.name, err)
+				}
+				os.Exit(1)
+			}
+			return
+		}
+	}
+	usage()
+	os.Exit(2)
+}
+
+// newFlagSet returns a flag set for subcommand name, which returns
+// errors rather than exiting.
+func newFlagSet(name, args string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: usdx %s %s\n", name, args)
+		fs.PrintDefaults()
+	}
+	return fs
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/royalfork/usdx/pkg/deploy"
+)
+
+func init() {
+	register("mine", "mine a CREATE2 salt for a vanity USDX address", mine)
+}
+
+// mainnetFeed is the chainlink ETH/USD price feed on mainnet.
+const mainnetFeed = "0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419"
+
+func mine(args []string) error {
+	fs := newFlagSet("mine", "-factory <addr> -deployer <addr> [-prefix <hex>] [-suffix <hex>]")
+	var (
+		factory  = fs.String("factory", "", "CREATE2 factory address")
+		deployer = fs.String("deployer", "", "account which will send the deploy transaction")
+		feed     = fs.String("feed", mainnetFeed, "USDX constructor price feed address")
+		prefix   = fs.String("prefix", "8888", "hex prefix of the mined address")
+		suffix   = fs.String("suffix", "8888", "hex suffix of the mined address")
+		workers  = fs.Int("workers", 0, "mining goroutines (0 uses all cpus)")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if !common.IsHexAddress(*factory) || !common.IsHexAddress(*deployer) || !common.IsHexAddress(*feed) {
+		fs.Usage()
+		return fmt.Errorf("factory, deployer and feed must be addresses")
+	}
+
+	initCode, err := deploy.USDXInitCode(common.HexToAddress(*feed))
+	if err != nil {
+		return err
+	}
+
+	p := deploy.Pattern{Prefix: *prefix, Suffix: *suffix}
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	go func() {
+		<-sigs
+		cancel()
+	}()
+
+	var attempts uint64
+	start := time.Now()
+	go func() {
+		for range time.Tick(10 * time.Second) {
+			n := atomic.LoadUint64(&attempts)
+			fmt.Fprintf(os.Stderr, "%d attempts (%.0f/s), expect %.0f\n", n, float64(n)/time.Since(start).Seconds(), p.Difficulty())
+		}
+	}()
+
+	res, err := deploy.Mine(ctx, common.HexToAddress(*factory), common.HexToAddress(*deployer), crypto.Keccak256Hash(initCode), p, *workers, &attempts)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("salt:     0x%x\naddress:  %s\nattempts: %d\n", res.Salt, res.Address.Hex(), res.Attempts)
+	return nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/royalfork/usdx/pkg/opendata"
+)
+
+func init() {
+	register("opendata", "generate a day's (or block range's) open data dataset", openData)
+}
+
+func openData(args []string) error {
+	fs := newFlagSet("opendata", "[-rpc <url>] [-day <YYYY-MM-DD> | -from <n> -to <n>] [-accounts <file>] [-out <dir>] <usdx address>")
+	var (
+		url      = fs.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint, which must support calls at past blocks")
+		date     = fs.String("day", "", "UTC day whose blocks to generate (default: yesterday)")
+		from     = fs.Int64("from", -1, "first block of the range, instead of -day")
+		to       = fs.Int64("to", -1, "last block of the range, instead of -day")
+		accounts = fs.String("accounts", "", "file of additional account addresses, one per line, to include beyond those found from events")
+		out      = fs.String("out", "", "directory to write the dataset to (default: usdx-<day> or usdx-<from>-<to>)")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || !common.IsHexAddress(fs.Arg(0)) {
+		fs.Usage()
+		return fmt.Errorf("expected a USDX address")
+	}
+	if (*from < 0) != (*to < 0) || (*from >= 0 && *date != "") {
+		fs.Usage()
+		return fmt.Errorf("expected either -day, or -from and -to")
+	}
+	var extra []common.Address
+	if *accounts != "" {
+		var err error
+		if extra, err = readAddresses(*accounts); err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, *url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	first, last := uint64(*from), uint64(*to)
+	name := fmt.Sprintf("usdx-%d-%d", first, last)
+	if *from < 0 {
+		day := time.Now().UTC().AddDate(0, 0, -1)
+		if *date != "" {
+			if day, err = time.Parse(opendata.DateFormat, *date); err != nil {
+				return fmt.Errorf("invalid day: %v", err)
+			}
+		}
+		if first, last, err = opendata.BlockRange(ctx, client, day); err != nil {
+			return err
+		}
+		name = "usdx-" + day.Format(opendata.DateFormat)
+	}
+	if *out == "" {
+		*out = name
+	}
+
+	ds, err := opendata.Generate(ctx, client, common.HexToAddress(fs.Arg(0)), first, last, extra)
+	if err != nil {
+		return err
+	}
+	if err := ds.Write(*out); err != nil {
+		return err
+	}
+	fmt.Printf("%s: blocks %d-%d, %d accounts\n", *out, first, last, ds.Summary.Accounts)
+	return nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/royalfork/usdx/pkg/accounting"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+func init() {
+	register("positions", "show USDX position tokens by holder or token id", positions)
+}
+
+func positions(args []string) error {
+	fs := newFlagSet("positions", "[-rpc <url>] [-block <n>] <usdx address> <holder address | token id>...")
+	var (
+		url   = fs.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+		block = fs.Int64("block", -1, "block to read positions at (default: latest)")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 2 || !common.IsHexAddress(fs.Arg(0)) {
+		fs.Usage()
+		return fmt.Errorf("expected a USDX address, and holders or token ids")
+	}
+	contract := common.HexToAddress(fs.Arg(0))
+
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, *url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	opts := &bind.CallOpts{Context: ctx}
+	if *block >= 0 {
+		opts.BlockNumber = big.NewInt(*block)
+	}
+
+	var lots []usdx.Lot
+	for _, arg := range fs.Args()[1:] {
+		if common.IsHexAddress(arg) {
+			held, err := usdx.AccountLots(opts, client, contract, common.HexToAddress(arg))
+			if err != nil {
+				return err
+			}
+			lots = append(lots, held...)
+			continue
+		}
+		id, ok := new(big.Int).SetString(arg, 10)
+		if !ok || id.Sign() <= 0 {
+			return fmt.Errorf("invalid holder or token id %q", arg)
+		}
+		l, err := usdx.LotByID(opts, client, contract, id)
+		if err != nil {
+			return fmt.Errorf("token %v: %v", id, err)
+		}
+		lots = append(lots, *l)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(w, "token\tholder\tlocked eth\tmint usdx\tunlock usd/eth\tappreciation usdx\t\n")
+	for _, l := range lots {
+		// usd/eth of the lot's eth to usdx ratio, in 1e-18 usd.
+		price := new(big.Int).Mul(l.Mint, big.NewInt(1e18))
+		price.Quo(price, l.Locked)
+		fmt.Fprintf(w, "%v\t%s\t%s\t%s\t%s\t%s\t\n", l.ID, l.Owner.Hex(), accounting.FormatUnits(l.Locked),
+			accounting.FormatUnits(l.Mint), accounting.FormatUnits(price), accounting.FormatUnits(l.Appreciation))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/royalfork/usdx/pkg/accounting"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+func init() {
+	register("redemption", "quote redeeming usdx for eth, and the lots redeemed from", quoteRedemption)
+}
+
+func quoteRedemption(args []string) error {
+	fs := newFlagSet("redemption", "[-rpc <url>] [-block <n>] [-tolerance <bps>] <usdx address> <usdx amount>")
+	var (
+		url       = fs.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+		block     = fs.Int64("block", -1, "block to quote at (default: latest)")
+		tolerance = fs.Uint64("tolerance", 50, "basis points the rate may fall by before the redemption is mined")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 || !common.IsHexAddress(fs.Arg(0)) {
+		fs.Usage()
+		return fmt.Errorf("expected a USDX address and an amount")
+	}
+	amt, err := accounting.ParseUnits(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	if amt.Sign() <= 0 {
+		return fmt.Errorf("amount must be positive")
+	}
+	contract := common.HexToAddress(fs.Arg(0))
+
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, *url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	opts := &bind.CallOpts{Context: ctx}
+	if *block >= 0 {
+		opts.BlockNumber = big.NewInt(*block)
+	}
+
+	q, err := usdx.QuoteRedeem(opts, client, contract, amt)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("redeem %s usdx for %s eth (at least %s eth at %d bps tolerance)\n",
+		accounting.FormatUnits(q.USDX), accounting.FormatUnits(q.ETH), accounting.FormatUnits(usdx.MinUSDX(q.ETH, *tolerance)), *tolerance)
+	if q.USDX.Cmp(amt) < 0 {
+		fmt.Printf("only %s of %s usdx is redeemable\n", accounting.FormatUnits(q.USDX), accounting.FormatUnits(amt))
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(w, "lot\towner\tlocked eth\tmint usdx\t\n")
+	for _, id := range q.IDs {
+		l, err := usdx.LotByID(opts, client, contract, id)
+		if err != nil {
+			return fmt.Errorf("lot %v: %v", id, err)
+		}
+		fmt.Fprintf(w, "%v\t%s\t%s\t%s\t\n", l.ID, l.Owner.Hex(), accounting.FormatUnits(l.Locked), accounting.FormatUnits(l.Mint))
+	}
+	return w.Flush()
+}
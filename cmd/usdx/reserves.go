@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/royalfork/usdx/pkg/reserves"
+)
+
+func init() {
+	register("reserves", "publish a proof-of-reserves report of every USDX account", publishReserves)
+	register("audit", "verify a proof-of-reserves report, or an account's inclusion in it", audit)
+}
+
+func publishReserves(args []string) error {
+	fs := newFlagSet("reserves", "[-rpc <url>] [-block <n>] [-accounts <file>] [-out <file>] <usdx address>")
+	var (
+		url      = fs.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+		block    = fs.Int64("block", -1, "block to snapshot (default: latest)")
+		accounts = fs.String("accounts", "", "file of additional account addresses, one per line, to include beyond those found from events")
+		out      = fs.String("out", "", "file to write the report to (default: stdout)")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || !common.IsHexAddress(fs.Arg(0)) {
+		fs.Usage()
+		return fmt.Errorf("expected a USDX address")
+	}
+	var extra []common.Address
+	if *accounts != "" {
+		var err error
+		if extra, err = readAddresses(*accounts); err != nil {
+			return err
+		}
+	}
+	var number *big.Int
+	if *block >= 0 {
+		number = big.NewInt(*block)
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, *url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	r, err := reserves.Take(ctx, client, common.HexToAddress(fs.Arg(0)), number, extra)
+	if err != nil {
+		return err
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "root %s: %d accounts at block %d\n", r.Root.Hex(), r.Accounts, r.Block)
+	return nil
+}
+
+// readAddresses reads a file of addresses, one per line.  Blank lines
+// and lines starting with # are ignored.
+func readAddresses(path string) ([]common.Address, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var addrs []common.Address
+	s := bufio.NewScanner(f)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !common.IsHexAddress(line) {
+			return nil, fmt.Errorf("%s:%d: invalid address %q", path, n, line)
+		}
+		addrs = append(addrs, common.HexToAddress(line))
+	}
+	return addrs, s.Err()
+}
+
+func audit(args []string) error {
+	fs := newFlagSet("audit", "[-root <hash>] [-account <addr>] [-rpc <url>] <report.json>")
+	var (
+		root    = fs.String("root", "", "published root the report must have")
+		account = fs.String("account", "", "only verify this account's inclusion, rather than the whole report")
+		url     = fs.String("rpc", "", "JSON-RPC endpoint to check the report against (default: don't check the chain)")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || (*account != "" && !common.IsHexAddress(*account)) {
+		fs.Usage()
+		return fmt.Errorf("expected a report")
+	}
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var r reserves.Report
+	if err := json.NewDecoder(f).Decode(&r); err != nil {
+		return fmt.Errorf("decoding report: %v", err)
+	}
+	if *root != "" && common.HexToHash(*root) != r.Root {
+		return fmt.Errorf("report's root is %s, not %s", r.Root.Hex(), *root)
+	}
+
+	var check []reserves.Account
+	if *account != "" {
+		if r.TotalSupply == nil || r.Balance == nil || r.Settled == nil || r.Pool == nil || r.Commitment.Root() != r.Root {
+			return fmt.Errorf("root %s doesn't commit to report", r.Root.Hex())
+		}
+		p, ok := r.Proof(common.HexToAddress(*account))
+		if !ok {
+			return fmt.Errorf("%s isn't in the report", *account)
+		}
+		if err := r.VerifyProof(p); err != nil {
+			return err
+		}
+		fmt.Printf("%s: locked %v wei, mint %v usdx wei, included in root %s\n", p.Address.Hex(), p.Locked.ToInt(), p.Mint.ToInt(), r.Root.Hex())
+		check = append(check, p.Account)
+	} else {
+		if err := r.Verify(); err != nil {
+			return err
+		}
+		fmt.Printf("root %s: %d accounts at block %d, total supply %v, balance %v wei\n", r.Root.Hex(), r.Accounts, r.Block, r.TotalSupply.ToInt(), r.Balance.ToInt())
+		for _, p := range r.Proofs {
+			check = append(check, p.Account)
+		}
+	}
+
+	if *url == "" {
+		return nil
+	}
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, *url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if err := reserves.CheckChain(ctx, client, &r.Commitment, check...); err != nil {
+		return err
+	}
+	fmt.Printf("matches chain at block %d (%s)\n", r.Block, r.BlockHash.Hex())
+	return nil
+}
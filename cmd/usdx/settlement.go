@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/royalfork/usdx/pkg/accounting"
+	"github.com/royalfork/usdx/pkg/devnet"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+func init() {
+	register("settlement", "show, or settle, the lots and claims of a USDX shutdown", showSettlement)
+}
+
+func showSettlement(args []string) error {
+	fs := newFlagSet("settlement", "[-rpc <url>] [-block <n>] [-price <usd>] [-settle [-key <hex>] [-batch <n>]] <usdx address>")
+	var (
+		url    = fs.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+		block  = fs.Int64("block", -1, "block to show the settlement at (default: latest)")
+		price  = fs.String("price", "", "usd/eth to simulate a shutdown at, if not shut down (default: the feed's latest)")
+		settle = fs.Bool("settle", false, "send settleLots for every unsettled lot, once shut down")
+		key    = fs.String("key", "", "hex private key of the account settling lots (default: $USDX_KEY)")
+		batch  = fs.Int("batch", 50, "lots settled per transaction")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || !common.IsHexAddress(fs.Arg(0)) {
+		fs.Usage()
+		return fmt.Errorf("expected a USDX address")
+	}
+	if *batch <= 0 {
+		return fmt.Errorf("batch must be positive")
+	}
+	var at *big.Int
+	if *price != "" {
+		p, err := devnet.ParsePrice(*price)
+		if err != nil {
+			return err
+		}
+		at = p
+	}
+	contract := common.HexToAddress(fs.Arg(0))
+
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, *url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	opts := &bind.CallOpts{Context: ctx}
+	if *block >= 0 {
+		opts.BlockNumber = big.NewInt(*block)
+	}
+
+	caller, err := usdx.NewUSDXCaller(contract, client)
+	if err != nil {
+		return err
+	}
+	settled, err := caller.SettlementPrice(opts)
+	if err != nil {
+		return err
+	}
+	s, err := usdx.SettlementAt(opts, client, contract, at)
+	if err != nil {
+		return err
+	}
+
+	state := "simulated"
+	if settled.Sign() > 0 {
+		state = "shut down"
+	}
+	usd := new(big.Int).Mul(s.Price, big.NewInt(1e10))
+	fmt.Printf("%s at %s usd/eth: %d lots unsettled\n", state, accounting.FormatUnits(usd), len(s.Lots))
+	one := big.NewInt(1e18)
+	fmt.Printf("%s usdx claims %s eth: %s eth per usdx, par %s\n",
+		accounting.FormatUnits(s.Supply), accounting.FormatUnits(s.Pool), accounting.FormatUnits(s.Claim(one)), accounting.FormatUnits(s.Par(one)))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(w, "lot\towner\tdebt eth\tsurplus eth\t\n")
+	for _, l := range s.Lots {
+		fmt.Fprintf(w, "%v\t%s\t%s\t%s\t\n", l.ID, l.Owner.Hex(), accounting.FormatUnits(l.Debt), accounting.FormatUnits(l.Surplus))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if !*settle {
+		return nil
+	}
+	if settled.Sign() == 0 {
+		return fmt.Errorf("not shut down")
+	}
+	if *block >= 0 {
+		return fmt.Errorf("-settle settles at the latest block; drop -block")
+	}
+	sclient, auth, err := dialSigner(ctx, *url, *key)
+	if err != nil {
+		return err
+	}
+	defer sclient.Close()
+	transactor, err := usdx.NewUSDXTransactor(contract, sclient)
+	if err != nil {
+		return err
+	}
+	for _, ids := range s.Batches(*batch) {
+		tx, err := transactor.SettleLots(auth, ids)
+		if err != nil {
+			return err
+		}
+		rcpt, err := bind.WaitMined(ctx, sclient, tx)
+		if err != nil {
+			return err
+		}
+		if rcpt.Status != types.ReceiptStatusSuccessful {
+			return fmt.Errorf("settling %d lots in %s failed", len(ids), tx.Hash().Hex())
+		}
+		fmt.Printf("settled %d lots in %s\n", len(ids), tx.Hash().Hex())
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/royalfork/usdx/pkg/usdx"
+	"github.com/royalfork/usdx/pkg/verify"
+)
+
+func init() {
+	register("verify", "verify deployed USDX code against the repository's sources", verifyCode)
+}
+
+func verifyCode(args []string) error {
+	fs := newFlagSet("verify", "[-rpc <url>] [-feed <addr>] <usdx address>")
+	var (
+		url  = fs.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+		feed = fs.String("feed", "", "constructor price feed address (default: the contract's current feed)")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || !common.IsHexAddress(fs.Arg(0)) || (*feed != "" && !common.IsHexAddress(*feed)) {
+		fs.Usage()
+		return fmt.Errorf("expected a USDX address")
+	}
+	addr := common.HexToAddress(fs.Arg(0))
+
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, *url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	priceFeed := common.HexToAddress(*feed)
+	if *feed == "" {
+		// The feed is stored, not immutable, so it doesn't change
+		// the runtime code, but is needed to execute the constructor.
+		contract, err := usdx.NewUSDXCaller(addr, client)
+		if err != nil {
+			return err
+		}
+		if priceFeed, err = contract.UsdPriceFeed(&bind.CallOpts{Context: ctx}); err != nil {
+			return fmt.Errorf("reading price feed: %v", err)
+		}
+	}
+
+	res, err := verify.USDX(ctx, client, addr, priceFeed)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s: %s\n", addr.Hex(), res)
+	if res.Status == verify.Diverged {
+		return fmt.Errorf("deployed code doesn't match sol/Usdx.sol")
+	}
+	return nil
+}
@@ -0,0 +1,502 @@
+package accounting
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/royalfork/usdx/pkg/devnet"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+// units returns s, a decimal, in 1e-18 units.
+func units(s string) *big.Int {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		panic(s)
+	}
+	r.Mul(r, new(big.Rat).SetInt(big.NewInt(1e18)))
+	return new(big.Int).Quo(r.Num(), r.Denom())
+}
+
+func TestLots(t *testing.T) {
+	for _, tt := range []struct {
+		method         Method
+		basis1, basis2 string
+	}{
+		// Acquire 1@100 and 3@600, then dispose 2, and 3 of which
+		// 1 isn't held.
+		{FIFO, "300", "400"},
+		{Average, "350", "350"},
+	} {
+		l := newLots(tt.method)
+		l.acquire(units("1"), units("100"))
+		l.acquire(units("3"), units("600"))
+		basis, unmatched := l.dispose(units("2"))
+		if basis.Cmp(units(tt.basis1)) != 0 || unmatched.Sign() != 0 {
+			t.Errorf("%v: want basis %s, got: %s, unmatched: %s", tt.method, tt.basis1, FormatUnits(basis), FormatUnits(unmatched))
+		}
+		basis, unmatched = l.dispose(units("3"))
+		if basis.Cmp(units(tt.basis2)) != 0 || unmatched.Cmp(units("1")) != 0 {
+			t.Errorf("%v: want basis %s, unmatched 1, got: %s, %s", tt.method, tt.basis2, FormatUnits(basis), FormatUnits(unmatched))
+		}
+		if l.qty.Sign() != 0 || l.cost.Sign() != 0 || len(l.held) != 0 {
+			t.Errorf("%v: want nothing held, got: %v@%v %v", tt.method, l.qty, l.cost, l.held)
+		}
+	}
+}
+
+func TestFormatUnits(t *testing.T) {
+	for in, want := range map[string]string{
+		"0":                    "0",
+		"1":                    "0.000000000000000001",
+		"1000000000000000000":  "1",
+		"2500000000000000000":  "2.5",
+		"-2500000000000000000": "-2.5",
+	} {
+		x, _ := new(big.Int).SetString(in, 10)
+		if got := FormatUnits(x); got != want {
+			t.Errorf("%s: want %s, got: %s", in, want, got)
+		}
+		if back, err := ParseUnits(want); err != nil || back.Cmp(x) != 0 {
+			t.Errorf("%s: want parsed %v, got: %v, err: %v", want, x, back, err)
+		}
+	}
+	for _, in := range []string{"", "x", "1/2", "1e3", "0.0000000000000000001"} {
+		if _, err := ParseUnits(in); err == nil {
+			t.Errorf("%q: want error", in)
+		}
+	}
+}
+
+func TestLedger(t *testing.T) {
+	d, err := devnet.New(devnet.DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	ctx := context.Background()
+	transactor := func(name string) *bind.TransactOpts {
+		auth, err := d.Transactor(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return auth
+	}
+	alice, bob, carol := transactor("alice"), transactor("bob"), transactor("carol")
+	send := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rcpt, err := d.TransactionReceipt(ctx, tx.Hash()); err != nil || rcpt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("tx failed: %v", err)
+		}
+	}
+	mint := func(auth *bind.TransactOpts, eth string) {
+		t.Helper()
+		auth.Value = units(eth)
+		send((&usdx.USDXRaw{Contract: d.USDX}).Transfer(auth))
+		auth.Value = nil
+	}
+	setPrice := func(usd int64) {
+		t.Helper()
+		if err := d.SetPrice(big.NewInt(usd * 1e8)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	start := d.Blockchain().CurrentHeader().Number.Uint64()
+
+	mint(alice, "1") // 2000 usdx, locking eth of unknown cost
+	setPrice(3000)
+	send(d.USDX.CollectAppreciation(alice, new(big.Int))) // 1000 usdx
+	send(d.USDX.Transfer(alice, carol.From, units("500")))
+	send(d.USDX.Transfer(carol, alice.From, units("100")))
+	send(d.USDX.Transfer(alice, bob.From, units("100"))) // within the book
+	setPrice(4000)
+	send(d.USDX.Unlock(alice, units("1500"))) // .5 eth of 1 locked for 3000 usdx
+	setPrice(5000)
+	send(d.USDX.Unlock(alice, units("750"))) // .25 eth
+	mint(alice, "0.5")                       // 2500 usdx
+
+	events, err := Events(ctx, d, d.USDXAddr, []common.Address{alice.From, bob.From}, start, d.Blockchain().CurrentHeader().Number.Uint64())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kinds []Kind
+	for _, e := range events {
+		kinds = append(kinds, e.Kind)
+	}
+	if want := []Kind{Mint, Collect, TransferOut, TransferIn, Unlock, Unlock, Mint}; !reflect.DeepEqual(kinds, want) {
+		t.Fatalf("want events: %v, got: %v", want, kinds)
+	}
+	for i, want := range map[int]string{0: "1", 4: "0.5", 5: "0.25", 6: "0.5"} {
+		if events[i].ETH.Cmp(units(want)) != 0 {
+			t.Errorf("event %d: want %s eth, got: %s", i, want, FormatUnits(events[i].ETH))
+		}
+	}
+
+	type row struct {
+		action                         Action
+		asset, qty, value, basis, gain string
+	}
+	ledger := func(m Method) (*Ledger, []row) {
+		l := NewLedger(m)
+		for i := range events {
+			l.Add(&events[i])
+		}
+		var rows []row
+		for _, r := range l.Rows {
+			rows = append(rows, row{r.Action, r.Asset, FormatUnits(r.Quantity), FormatUnits(r.Value), FormatUnits(r.Basis), FormatUnits(r.Gain)})
+		}
+		return l, rows
+	}
+	shared := []row{
+		{Dispose, ETH, "1", "2000", "0", "2000"},
+		{Acquire, USDX, "2000", "2000", "", ""},
+		{Income, USDX, "1000", "1000", "", ""},
+		{Dispose, USDX, "500", "500", "500", "0"},
+		{Acquire, USDX, "100", "100", "", ""},
+		{Dispose, USDX, "1500", "2000", "1500", "500"},
+		{Acquire, ETH, "0.5", "2000", "", ""},
+		{Dispose, USDX, "750", "1250", "750", "500"},
+		{Acquire, ETH, "0.25", "1250", "", ""},
+	}
+	for _, tt := range []struct {
+		method Method
+		last   []row
+	}{
+		{FIFO, []row{
+			{Dispose, ETH, "0.5", "2500", "2000", "500"},
+			{Acquire, USDX, "2500", "2500", "", ""},
+		}},
+		// .5 of .75 eth costing 3250 usd.
+		{Average, []row{
+			{Dispose, ETH, "0.5", "2500", "2166.666666666666666666", "333.333333333333333334"},
+			{Acquire, USDX, "2500", "2500", "", ""},
+		}},
+	} {
+		l, rows := ledger(tt.method)
+		want := append(append([]row(nil), shared...), tt.last...)
+		if !reflect.DeepEqual(rows, want) {
+			t.Errorf("%v: want rows:\n%v\ngot:\n%v", tt.method, want, rows)
+		}
+		if u := l.Rows[0].Unmatched; u.Cmp(units("1")) != 0 {
+			t.Errorf("%v: want 1 eth unmatched, got: %s", tt.method, FormatUnits(u))
+		}
+
+		for _, entry := range l.Journal() {
+			sum := new(big.Int)
+			for _, p := range entry.Postings {
+				sum.Add(sum, p.Amount)
+			}
+			if sum.Sign() != 0 {
+				t.Errorf("%v: unbalanced %v entry: %+v", tt.method, entry.Event.Kind, entry.Postings)
+			}
+		}
+
+		var buf bytes.Buffer
+		if err := l.WriteCSV(&buf); err != nil {
+			t.Fatal(err)
+		}
+		recs, err := csv.NewReader(&buf).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(recs) != len(want)+1 || recs[1][4] != "mint" || recs[1][14] != tt.method.String() {
+			t.Errorf("%v: unexpected ledger csv: %v", tt.method, recs)
+		}
+		buf.Reset()
+		if err := l.WriteJournal(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(buf.String(), ",Income:Appreciation,,1000,collect ") {
+			t.Errorf("%v: journal doesn't credit appreciation:\n%s", tt.method, buf.String())
+		}
+	}
+}
+
+// TestCollect checks that appreciation is a Collect however it's
+// collected, and whoever sends the transaction.
+func TestCollect(t *testing.T) {
+	d, err := devnet.New(devnet.DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	ctx := context.Background()
+	alice, err := d.Transactor("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keeper, err := d.Transactor("bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	send := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rcpt, err := d.TransactionReceipt(ctx, tx.Hash()); err != nil || rcpt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("tx failed: %v", err)
+		}
+	}
+	alice.Value = units("1")
+	send((&usdx.USDXRaw{Contract: d.USDX}).Transfer(alice)) // 2000 usdx
+	alice.Value = nil
+	acct, err := d.USDX.Accounts(&bind.CallOpts{}, alice.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each collects the 1000 usdx a 1000 usd/eth rise appreciates
+	// alice's 1 eth lot by.
+	collects := []struct {
+		name    string
+		collect func()
+	}{
+		{"collectAppreciation", func() { send(d.USDX.CollectAppreciation(alice, new(big.Int))) }},
+		{"collectLotAppreciation", func() { send(d.USDX.CollectLotAppreciation(alice, acct.FirstLot, new(big.Int))) }},
+		{"executeOrder", func() {
+			// alice's take-profit order, executed by a keeper.
+			send(d.USDX.PlaceOrder(alice, usdx.TakeProfit, big.NewInt(1e8), new(big.Int)))
+			id, err := d.USDX.LastOrderId(&bind.CallOpts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			send(d.USDX.ExecuteOrder(keeper, id))
+		}},
+		{"collectAppreciationFor", func() {
+			// by alice's collector.
+			send(d.USDX.SetCollector(alice, keeper.From, new(big.Int)))
+			send(d.USDX.CollectAppreciationFor(keeper, alice.From, new(big.Int)))
+		}},
+	}
+	start := d.Blockchain().CurrentHeader().Number.Uint64() + 1
+	for i, c := range collects {
+		if err := d.SetPrice(big.NewInt(int64(3000+1000*i) * 1e8)); err != nil {
+			t.Fatal(err)
+		}
+		c.collect()
+	}
+
+	events, err := Events(ctx, d, d.USDXAddr, []common.Address{alice.From}, start, d.Blockchain().CurrentHeader().Number.Uint64())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != len(collects) {
+		t.Fatalf("want %d events, got: %d", len(collects), len(events))
+	}
+	for i, e := range events {
+		if e.Kind != Collect || e.ETH != nil || e.USDX.Cmp(units("1000")) != 0 {
+			t.Errorf("%s: want collect of 1000 usdx, got: %v of %s usdx, eth: %v", collects[i].name, e.Kind, FormatUnits(e.USDX), e.ETH)
+		}
+	}
+}
+
+// TestLotTransfers checks that lots moved into and out of the book are
+// events, and that accounts are reconstructed across lots moved within
+// it.
+func TestLotTransfers(t *testing.T) {
+	d, err := devnet.New(devnet.DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	ctx := context.Background()
+	transactor := func(name string) *bind.TransactOpts {
+		auth, err := d.Transactor(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return auth
+	}
+	alice, bob, carol := transactor("alice"), transactor("bob"), transactor("carol")
+	send := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rcpt, err := d.TransactionReceipt(ctx, tx.Hash()); err != nil || rcpt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("tx failed: %v", err)
+		}
+	}
+	mint := func(auth *bind.TransactOpts, eth string) {
+		t.Helper()
+		auth.Value = units(eth)
+		send((&usdx.USDXRaw{Contract: d.USDX}).Transfer(auth))
+		auth.Value = nil
+	}
+	positionsAddr, err := d.USDX.Positions(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	positions, err := usdx.NewUSDXPositions(positionsAddr, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	firstLot := func(auth *bind.TransactOpts) *big.Int {
+		t.Helper()
+		acct, err := d.USDX.Accounts(&bind.CallOpts{}, auth.From)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return acct.FirstLot
+	}
+	// alice accepts lots from carol and bob, and carol from alice.
+	send(d.USDX.SetLotSender(alice, carol.From, true))
+	send(d.USDX.SetLotSender(alice, bob.From, true))
+	send(d.USDX.SetLotSender(carol, alice.From, true))
+	start := d.Blockchain().CurrentHeader().Number.Uint64() + 1
+
+	mint(alice, "1")   // 2000 usdx
+	mint(bob, "0.5")   // 1000 usdx
+	mint(carol, "1.5") // 3000 usdx
+
+	send(positions.TransferFrom(carol, carol.From, alice.From, firstLot(carol))) // into the book
+	send(positions.TransferFrom(bob, bob.From, alice.From, firstLot(bob)))       // within the book
+	send(d.USDX.Unlock(alice, units("2000")))                                    // alice's own lot
+	send(d.USDX.TransferAcct(alice, carol.From))                                 // out of the book
+
+	events, err := Events(ctx, d, d.USDXAddr, []common.Address{alice.From, bob.From}, start, d.Blockchain().CurrentHeader().Number.Uint64())
+	if err != nil {
+		t.Fatal(err)
+	}
+	type event struct {
+		kind               Kind
+		addr, counterparty common.Address
+		eth, usdx          string
+	}
+	var got []event
+	for _, e := range events {
+		got = append(got, event{e.Kind, e.Address, e.Counterparty, FormatUnits(e.ETH), FormatUnits(e.USDX)})
+	}
+	want := []event{
+		{Mint, alice.From, common.Address{}, "1", "2000"},
+		{Mint, bob.From, common.Address{}, "0.5", "1000"},
+		{LotIn, alice.From, carol.From, "1.5", "3000"},
+		{Unlock, alice.From, common.Address{}, "1", "2000"},
+		{LotOut, alice.From, carol.From, "1.5", "3000"},
+		{LotOut, alice.From, carol.From, "0.5", "1000"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want events:\n%v\ngot:\n%v", want, got)
+	}
+
+	// Lots aren't holdings, so their moves have no rows.
+	l := NewLedger(FIFO)
+	for i := range events {
+		if rows := l.Add(&events[i]); (events[i].Kind == LotIn || events[i].Kind == LotOut) != (len(rows) == 0) {
+			t.Errorf("%v: unexpected rows: %v", events[i].Kind, rows)
+		}
+	}
+}
+
+// TestShutdown checks a book's events spanning a shutdown: its lots'
+// settlements, and its claims of the settlement pool.
+func TestShutdown(t *testing.T) {
+	d, err := devnet.New(devnet.DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	ctx := context.Background()
+	opts := &bind.CallOpts{}
+	transactor := func(name string) *bind.TransactOpts {
+		auth, err := d.Transactor(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return auth
+	}
+	owner, alice, bob := transactor("owner"), transactor("alice"), transactor("bob")
+	send := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rcpt, err := d.TransactionReceipt(ctx, tx.Hash()); err != nil || rcpt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("tx failed: %v", err)
+		}
+	}
+	mint := func(auth *bind.TransactOpts, eth string) {
+		t.Helper()
+		auth.Value = units(eth)
+		send((&usdx.USDXRaw{Contract: d.USDX}).Transfer(auth))
+		auth.Value = nil
+	}
+	start := d.Blockchain().CurrentHeader().Number.Uint64() + 1
+
+	mint(alice, "1") // 2000 usdx
+	mint(bob, "1")
+	if err := d.SetPrice(big.NewInt(4000e8)); err != nil {
+		t.Fatal(err)
+	}
+	// Each lot's debt is .5 eth, so the pool is 1 eth, and alice's
+	// surplus .5 eth.
+	send(d.USDX.Shutdown(owner, big.NewInt(4000e8)))
+	s, err := usdx.SettlementAt(opts, d, d.USDXAddr, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ids := range s.Batches(10) {
+		send(d.USDX.SettleLots(owner, ids))
+	}
+	send(d.USDX.Claim(alice, units("1000"))) // .25 eth
+
+	events, err := Events(ctx, d, d.USDXAddr, []common.Address{alice.From}, start, d.Blockchain().CurrentHeader().Number.Uint64())
+	if err != nil {
+		t.Fatal(err)
+	}
+	type event struct {
+		kind      Kind
+		eth, usdx string
+	}
+	var got []event
+	for _, e := range events {
+		got = append(got, event{e.Kind, FormatUnits(e.ETH), FormatUnits(e.USDX)})
+	}
+	if want := []event{{Mint, "1", "2000"}, {Settled, "0.5", "2000"}, {Claim, "0.25", "1000"}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("want events: %v, got: %v", want, got)
+	}
+
+	type row struct {
+		action                         Action
+		asset, qty, value, basis, gain string
+	}
+	l := NewLedger(FIFO)
+	var rows []row
+	for i := range events {
+		for _, r := range l.Add(&events[i]) {
+			rows = append(rows, row{r.Action, r.Asset, FormatUnits(r.Quantity), FormatUnits(r.Value), FormatUnits(r.Basis), FormatUnits(r.Gain)})
+		}
+	}
+	want := []row{
+		{Dispose, ETH, "1", "2000", "0", "2000"},
+		{Acquire, USDX, "2000", "2000", "", ""},
+		// The surplus is the lot's appreciation, paid in eth.
+		{Income, ETH, "0.5", "2000", "", ""},
+		{Dispose, USDX, "1000", "1000", "1000", "0"},
+		{Acquire, ETH, "0.25", "1000", "", ""},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("want rows:\n%v\ngot:\n%v", want, rows)
+	}
+	for _, entry := range l.Journal() {
+		sum := new(big.Int)
+		for _, p := range entry.Postings {
+			sum.Add(sum, p.Amount)
+		}
+		if sum.Sign() != 0 {
+			t.Errorf("unbalanced %v entry: %+v", entry.Event.Kind, entry.Postings)
+		}
+	}
+}
@@ -0,0 +1,539 @@
+// Package accounting exports the history of USDX accounts for tax and
+// bookkeeping.
+//
+// Each mint, unlock, appreciation collection, redemption, usdx
+// transfer, and settlement and claim after a shutdown, of a set of
+// addresses is valued at the oracle rate of its block, and
+// matched against earlier acquisitions of the same asset (FIFO or
+// average cost) to find its cost basis.  The addresses are treated as
+// one book: transfers between them, of usdx or lots, aren't recorded.
+package accounting
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/royalfork/usdx/pkg/proof"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+var (
+	transferID       = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	redeemedID       = crypto.Keccak256Hash([]byte("Redeemed(address,address,uint256,uint256,uint256,uint256)"))
+	collectedID      = crypto.Keccak256Hash([]byte("Collected(address,uint256)"))
+	lotTransferredID = crypto.Keccak256Hash([]byte("LotTransferred(address,address,uint256,uint256,uint256)"))
+	lotSettledID     = crypto.Keccak256Hash([]byte("LotSettled(uint256,address,uint256,uint256,uint256)"))
+	claimedID        = crypto.Keccak256Hash([]byte("Claimed(address,uint256,uint256)"))
+)
+
+// rateUnit is 1 in the oracle's 8 decimals.
+var rateUnit = big.NewInt(1e8)
+
+// Backend reads chain state, logs and transactions at past blocks,
+// such as *ethclient.Client.  Calls must be supported at past blocks.
+type Backend interface {
+	ethereum.ChainStateReader
+	ethereum.LogFilterer
+	ethereum.TransactionReader
+	bind.ContractCaller
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Kind is the kind of an event.
+type Kind int
+
+const (
+	// Mint is eth sent to USDX to mint usdx.
+	Mint Kind = iota
+	// Unlock is usdx burned to unlock eth.
+	Unlock
+	// Collect is usdx minted from appreciation.
+	Collect
+	// TransferIn is usdx received from outside the book.
+	TransferIn
+	// TransferOut is usdx sent outside the book.
+	TransferOut
+	// Redeem is usdx burned for eth at the oracle rate, redeemed
+	// from others' lots.
+	Redeem
+	// Redeemed is usdx of an account's mint redeemed by someone
+	// else, for the account's locked eth.  Its ETH is the surplus
+	// eth of a lot whose mint was fully redeemed, credited to the
+	// account, which is the lot's appreciation paid in eth.
+	Redeemed
+	// LotIn is a lot moved to the book from outside it, by a
+	// transfer of its position token or its account.  Its ETH and
+	// USDX are the lot's locked eth and mint.
+	LotIn
+	// LotOut is a lot moved outside the book.
+	LotOut
+	// Settled is a lot of an account settled after a shutdown.  Its
+	// USDX is the lot's mint, which the account's owner keeps, and
+	// its ETH the lot's surplus eth credited to the account, as with
+	// Redeemed.
+	Settled
+	// Claim is usdx burned for eth from the settlement pool, after a
+	// shutdown.
+	Claim
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Mint:
+		return "mint"
+	case Unlock:
+		return "unlock"
+	case Collect:
+		return "collect"
+	case TransferIn:
+		return "transfer_in"
+	case TransferOut:
+		return "transfer_out"
+	case Redeem:
+		return "redeem"
+	case Redeemed:
+		return "redeemed"
+	case LotIn:
+		return "lot_in"
+	case LotOut:
+		return "lot_out"
+	case Settled:
+		return "settled"
+	case Claim:
+		return "claim"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Event is a change to a book's holdings.
+type Event struct {
+	Kind         Kind
+	Block        uint64
+	Time         uint64 // unix time
+	Tx           common.Hash
+	Index        uint           // of the event's log in the block
+	Address      common.Address // the book's address
+	Counterparty common.Address // of transfers, and redeemer of Redeemed
+	ETH          *big.Int       // wei locked by a mint or lot, unlocked, redeemed, claimed, or surplus; nil otherwise
+	USDX         *big.Int       // minted, burned, collected, transferred, redeemed, or a lot's mint
+	Rate         *big.Int       // usd/eth at Block, with 8 decimals
+}
+
+// Value returns the event's usd value, in 1e-18 usd: the eth of mints
+// and unlocks at the event's rate, and the face value of usdx
+// otherwise.
+func (e *Event) Value() *big.Int {
+	if e.ETH == nil {
+		return new(big.Int).Set(e.USDX)
+	}
+	v := new(big.Int).Mul(e.ETH, e.Rate)
+	return v.Quo(v, rateUnit)
+}
+
+// position is an account's locked eth and minted usdx.
+type position struct {
+	locked, mint *big.Int
+}
+
+// Events returns the events of addrs, in the USDX contract at
+// contract, from block from through to, in order.
+func Events(ctx context.Context, backend Backend, contract common.Address, addrs []common.Address, from, to uint64) ([]Event, error) {
+	book := make(map[common.Address]bool)
+	var topics []common.Hash
+	for _, a := range addrs {
+		book[a] = true
+		topics = append(topics, a.Hash())
+	}
+	if len(topics) == 0 {
+		return nil, nil
+	}
+
+	// Logs sent from, and sent to, the book, its redemptions, its
+	// lots' moves, and its lots' settlements.
+	var logs []types.Log
+	seen := make(map[common.Hash]map[uint]bool)
+	for _, q := range [][][]common.Hash{
+		{{transferID}, topics},
+		{{transferID}, nil, topics},
+		{{redeemedID}, topics},
+		{{redeemedID}, nil, topics},
+		{{lotTransferredID}, topics},
+		{{lotTransferredID}, nil, topics},
+		{{lotSettledID}, nil, topics},
+	} {
+		found, err := backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: []common.Address{contract},
+			Topics:    q,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range found {
+			if seen[l.TxHash] == nil {
+				seen[l.TxHash] = make(map[uint]bool)
+			}
+			if len(l.Topics) >= 3 && !seen[l.TxHash][l.Index] {
+				seen[l.TxHash][l.Index] = true
+				logs = append(logs, l)
+			}
+		}
+	}
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+
+	// Mints which collect appreciation, and burns which claim, by
+	// transaction and log index: a Collected or Claimed log follows
+	// each one's Transfer.  Claims map to the eth claimed.
+	collects := make(map[common.Hash]map[uint]bool)
+	claims := make(map[common.Hash]map[uint]*big.Int)
+	found, err := backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{contract},
+		Topics:    [][]common.Hash{{collectedID, claimedID}, topics},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range found {
+		if l.Index == 0 {
+			continue
+		}
+		if l.Topics[0] == claimedID {
+			if claims[l.TxHash] == nil {
+				claims[l.TxHash] = make(map[uint]*big.Int)
+			}
+			claims[l.TxHash][l.Index-1] = new(big.Int).SetBytes(l.Data[32:64])
+			continue
+		}
+		if collects[l.TxHash] == nil {
+			collects[l.TxHash] = make(map[uint]bool)
+		}
+		collects[l.TxHash][l.Index-1] = true
+	}
+
+	r := &reader{ctx: ctx, backend: backend, contract: contract, collects: collects, claims: claims, rates: make(map[uint64]*big.Int)}
+	var events []Event
+	for i := 0; i < len(logs); {
+		// Events of a block are reconstructed together.
+		j := i
+		for j < len(logs) && logs[j].BlockNumber == logs[i].BlockNumber {
+			j++
+		}
+		evs, err := r.block(logs[i:j], book)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, evs...)
+		i = j
+	}
+	return events, nil
+}
+
+// reader reads the chain for Events.
+type reader struct {
+	ctx      context.Context
+	backend  Backend
+	contract common.Address
+	collects map[common.Hash]map[uint]bool
+	claims   map[common.Hash]map[uint]*big.Int
+	rates    map[uint64]*big.Int
+}
+
+// block returns the events of logs, which are a block's logs involving
+// book.
+func (r *reader) block(logs []types.Log, book map[common.Address]bool) ([]Event, error) {
+	number := logs[0].BlockNumber
+	header, err := r.backend.HeaderByNumber(r.ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, err
+	}
+	rate, err := r.rate(header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	// The eth each of the book's redeemers redeemed, by transaction.
+	redemptions := make(map[common.Hash]map[common.Address]*big.Int)
+	for _, l := range logs {
+		redeemer := common.BytesToAddress(l.Topics[1].Bytes())
+		if l.Topics[0] != redeemedID || !book[redeemer] {
+			continue
+		}
+		if redemptions[l.TxHash] == nil {
+			redemptions[l.TxHash] = make(map[common.Address]*big.Int)
+		}
+		eth := redemptions[l.TxHash][redeemer]
+		if eth == nil {
+			eth = new(big.Int)
+			redemptions[l.TxHash][redeemer] = eth
+		}
+		eth.Add(eth, new(big.Int).SetBytes(l.Data[32:64]))
+	}
+
+	var events []Event
+	accounts := make(map[common.Address][]int) // events changing each account
+	removed := make(map[int]*big.Int)          // eth removed from accounts by Redeemed and Settled events
+	moved := make(map[common.Address]position) // lots moved into each account, less those moved out
+	for _, l := range logs {
+		from := common.BytesToAddress(l.Topics[1].Bytes())
+		to := common.BytesToAddress(l.Topics[2].Bytes())
+		if l.Topics[0] == lotTransferredID {
+			locked, mint := new(big.Int).SetBytes(l.Data[:32]), new(big.Int).SetBytes(l.Data[32:64])
+			for _, m := range []struct {
+				addr common.Address
+				sign int64
+			}{{from, -1}, {to, 1}} {
+				if !book[m.addr] {
+					continue
+				}
+				p, ok := moved[m.addr]
+				if !ok {
+					p = position{new(big.Int), new(big.Int)}
+					moved[m.addr] = p
+				}
+				p.locked.Add(p.locked, new(big.Int).Mul(locked, big.NewInt(m.sign)))
+				p.mint.Add(p.mint, new(big.Int).Mul(mint, big.NewInt(m.sign)))
+			}
+			e := Event{
+				Block: number,
+				Time:  header.Time,
+				Tx:    l.TxHash,
+				Index: l.Index,
+				ETH:   locked,
+				USDX:  mint,
+				Rate:  rate,
+			}
+			switch {
+			case book[from] && book[to]:
+				continue // within the book
+			case book[from]:
+				e.Kind, e.Address, e.Counterparty = LotOut, from, to
+			default:
+				e.Kind, e.Address, e.Counterparty = LotIn, to, from
+			}
+			events = append(events, e)
+			continue
+		}
+		if l.Topics[0] == redeemedID {
+			if !book[to] {
+				continue // the book's redemption of another's lot
+			}
+			surplus := new(big.Int).SetBytes(l.Data[64:96])
+			accounts[to] = append(accounts[to], len(events))
+			removed[len(events)] = new(big.Int).Add(new(big.Int).SetBytes(l.Data[32:64]), surplus)
+			events = append(events, Event{
+				Kind:         Redeemed,
+				Block:        number,
+				Time:         header.Time,
+				Tx:           l.TxHash,
+				Index:        l.Index,
+				Address:      to,
+				Counterparty: from,
+				ETH:          surplus,
+				USDX:         new(big.Int).SetBytes(l.Data[:32]),
+				Rate:         rate,
+			})
+			continue
+		}
+		if l.Topics[0] == lotSettledID {
+			// The lot's debt and surplus are all its eth.
+			surplus := new(big.Int).SetBytes(l.Data[64:96])
+			accounts[to] = append(accounts[to], len(events))
+			removed[len(events)] = new(big.Int).Add(new(big.Int).SetBytes(l.Data[32:64]), surplus)
+			events = append(events, Event{
+				Kind:    Settled,
+				Block:   number,
+				Time:    header.Time,
+				Tx:      l.TxHash,
+				Index:   l.Index,
+				Address: to,
+				ETH:     surplus,
+				USDX:    new(big.Int).SetBytes(l.Data[:32]),
+				Rate:    rate,
+			})
+			continue
+		}
+		e := Event{
+			Block: number,
+			Time:  header.Time,
+			Tx:    l.TxHash,
+			Index: l.Index,
+			USDX:  new(big.Int).SetBytes(l.Data),
+			Rate:  rate,
+		}
+		switch {
+		case from == common.Address{} && r.collects[l.TxHash][l.Index]:
+			e.Kind, e.Address = Collect, to
+		case from == common.Address{}:
+			e.Kind, e.Address = Mint, to
+			if e.ETH, err = r.value(l.TxHash); err != nil { // nil if unknown
+				return nil, err
+			}
+		case to == common.Address{} && redemptions[l.TxHash][from] != nil:
+			e.Kind, e.Address, e.ETH = Redeem, from, redemptions[l.TxHash][from]
+		case to == common.Address{} && r.claims[l.TxHash][l.Index] != nil:
+			e.Kind, e.Address, e.ETH = Claim, from, r.claims[l.TxHash][l.Index]
+		case to == common.Address{}:
+			e.Kind, e.Address = Unlock, from
+		case book[from] && book[to]:
+			continue // within the book
+		case book[from]:
+			e.Kind, e.Address, e.Counterparty = TransferOut, from, to
+		default:
+			e.Kind, e.Address, e.Counterparty = TransferIn, to, from
+		}
+		if e.Kind == Mint || e.Kind == Unlock || e.Kind == Collect {
+			accounts[e.Address] = append(accounts[e.Address], len(events))
+		}
+		events = append(events, e)
+	}
+
+	for addr := range moved {
+		if _, ok := accounts[addr]; !ok {
+			accounts[addr] = nil
+		}
+	}
+	for addr, idx := range accounts {
+		if err := r.replay(addr, number, events, idx, removed, moved[addr]); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// value returns the eth sent by the transaction which minted, if it
+// was sent to USDX.  It isn't known for mints by contracts.
+func (r *reader) value(hash common.Hash) (*big.Int, error) {
+	tx, _, err := r.backend.TransactionByHash(r.ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("transaction %s: %v", hash.Hex(), err)
+	}
+	if tx.To() == nil || *tx.To() != r.contract {
+		return nil, nil
+	}
+	return tx.Value(), nil
+}
+
+// replay finds the eth of addr's mints and unlocks in block, events
+// idx, from addr's account before and after the block.  Unlocks are of
+// lots, whose eth depends on which lots were unlocked, so the eth an
+// unlock returned is the change in the account's locked eth not
+// explained by its mints, redemptions, settlements and lot moves; a
+// block can't have more than one of addr's unlocks.  removed is the eth
+// removed from the account by Redeemed and Settled events, by index,
+// and moved is the lots moved into the account less those moved out,
+// if any were.
+func (r *reader) replay(addr common.Address, block uint64, events []Event, idx []int, removed map[int]*big.Int, moved position) error {
+	before, err := r.position(addr, new(big.Int).SetUint64(block-1))
+	if err != nil {
+		return err
+	}
+	after, err := r.position(addr, new(big.Int).SetUint64(block))
+	if err != nil {
+		return err
+	}
+	locked, mint := new(big.Int).Set(before.locked), new(big.Int).Set(before.mint)
+	if moved.locked != nil {
+		locked.Add(locked, moved.locked)
+		mint.Add(mint, moved.mint)
+	}
+	if len(idx) == 1 {
+		// A lone mint, whose eth isn't known, locked the
+		// account's change.
+		if e := &events[idx[0]]; e.Kind == Mint && e.ETH == nil {
+			e.ETH = new(big.Int).Sub(after.locked, locked)
+		}
+	}
+
+	var unlock *Event
+	for _, i := range idx {
+		e := &events[i]
+		switch e.Kind {
+		case Mint:
+			if e.ETH == nil {
+				return fmt.Errorf("block %d: unable to find eth of %s's mints by contract", block, addr.Hex())
+			}
+			locked.Add(locked, e.ETH)
+			mint.Add(mint, e.USDX)
+		case Collect:
+			mint.Add(mint, e.USDX)
+		case Redeemed, Settled:
+			locked.Sub(locked, removed[i])
+			mint.Sub(mint, e.USDX)
+		case Unlock:
+			if unlock != nil {
+				return fmt.Errorf("block %d: unable to find eth of %s's multiple unlocks", block, addr.Hex())
+			}
+			unlock = e
+			mint.Sub(mint, e.USDX)
+		}
+	}
+	if unlock != nil {
+		unlock.ETH = new(big.Int).Sub(locked, after.locked)
+		locked.Set(after.locked)
+	}
+	if mint.Cmp(after.mint) != 0 || locked.Cmp(after.locked) != 0 || (unlock != nil && unlock.ETH.Sign() < 0) {
+		return fmt.Errorf("block %d: unable to reconstruct %s's account", block, addr.Hex())
+	}
+	return nil
+}
+
+func (r *reader) position(addr common.Address, block *big.Int) (position, error) {
+	lockedSlot, mintSlot := proof.AccountSlots(addr)
+	var p position
+	for _, s := range []struct {
+		slot common.Hash
+		val  **big.Int
+	}{
+		{lockedSlot, &p.locked},
+		{mintSlot, &p.mint},
+	} {
+		val, err := r.backend.StorageAt(r.ctx, r.contract, s.slot, block)
+		if err != nil {
+			return p, err
+		}
+		*s.val = new(big.Int).SetBytes(val)
+	}
+	return p, nil
+}
+
+// rate returns the oracle's rate at block.
+func (r *reader) rate(block *big.Int) (*big.Int, error) {
+	if rate, ok := r.rates[block.Uint64()]; ok {
+		return rate, nil
+	}
+	opts := &bind.CallOpts{BlockNumber: block, Context: r.ctx}
+	caller, err := usdx.NewUSDXCaller(r.contract, r.backend)
+	if err != nil {
+		return nil, err
+	}
+	feedAddr, err := caller.UsdPriceFeed(opts)
+	if err != nil {
+		return nil, fmt.Errorf("reading price feed: %v", err)
+	}
+	// MockOracle has the feed's latestRoundData.
+	feed, err := usdx.NewMockOracleCaller(feedAddr, r.backend)
+	if err != nil {
+		return nil, err
+	}
+	round, err := feed.LatestRoundData(opts)
+	if err != nil {
+		return nil, fmt.Errorf("reading oracle rate at block %v: %v", block, err)
+	}
+	r.rates[block.Uint64()] = round.Answer
+	return round.Answer, nil
+}
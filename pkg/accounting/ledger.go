@@ -0,0 +1,251 @@
+package accounting
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Assets of a book.
+const (
+	ETH  = "ETH"
+	USDX = "USDX"
+)
+
+// Action is what a row does to a book's holdings of an asset.
+type Action string
+
+const (
+	Acquire Action = "acquire"
+	Dispose Action = "dispose"
+	// Income is an acquisition which is also income.
+	Income Action = "income"
+)
+
+// Row is an acquisition or disposal of an asset.  Usd amounts are in
+// 1e-18 usd, and quantities in 1e-18 of the asset.
+type Row struct {
+	Event    *Event
+	Action   Action
+	Asset    string
+	Quantity *big.Int
+	Value    *big.Int // proceeds of disposals, cost of acquisitions
+
+	// Disposals only.
+	Basis     *big.Int // cost of the matched acquisitions
+	Gain      *big.Int // Value - Basis; negative for losses
+	Unmatched *big.Int // quantity disposed without a known cost
+}
+
+// Ledger matches a book's events with lots.
+type Ledger struct {
+	Method Method
+	Rows   []Row
+	lots   map[string]*lots
+}
+
+// NewLedger returns an empty ledger, matching lots with m.
+func NewLedger(m Method) *Ledger {
+	return &Ledger{Method: m, lots: map[string]*lots{ETH: newLots(m), USDX: newLots(m)}}
+}
+
+// Add adds e, which must be later than events already added, to l.
+// It returns e's rows.
+func (l *Ledger) Add(e *Event) []Row {
+	v := e.Value()
+	var rows []Row
+	acquire := func(action Action, asset string, qty *big.Int) {
+		l.lots[asset].acquire(qty, v)
+		rows = append(rows, Row{Event: e, Action: action, Asset: asset, Quantity: qty, Value: v})
+	}
+	dispose := func(asset string, qty *big.Int) {
+		basis, unmatched := l.lots[asset].dispose(qty)
+		rows = append(rows, Row{
+			Event: e, Action: Dispose, Asset: asset, Quantity: qty, Value: v,
+			Basis: basis, Gain: new(big.Int).Sub(v, basis), Unmatched: unmatched,
+		})
+	}
+	switch e.Kind {
+	case Mint:
+		dispose(ETH, e.ETH)
+		acquire(Acquire, USDX, e.USDX)
+	case Unlock, Redeem, Claim:
+		dispose(USDX, e.USDX)
+		acquire(Acquire, ETH, e.ETH)
+	case Redeemed, Settled:
+		// The account's usdx is kept; only surplus eth is received.
+		if e.ETH.Sign() > 0 {
+			acquire(Income, ETH, e.ETH)
+		}
+	case Collect:
+		acquire(Income, USDX, e.USDX)
+	case TransferIn:
+		acquire(Acquire, USDX, e.USDX)
+	case TransferOut:
+		dispose(USDX, e.USDX)
+	case LotIn, LotOut:
+		// Lots aren't holdings: a lot's mint exchanged its eth for
+		// usdx, and unlocking it exchanges usdx for eth whoever
+		// minted it, so moving a lot has no rows.
+	}
+	l.Rows = append(l.Rows, rows...)
+	return rows
+}
+
+// FormatUnits formats x, in 1e-18 units, as a decimal without trailing
+// zeros.
+func FormatUnits(x *big.Int) string {
+	if x == nil {
+		return ""
+	}
+	s := new(big.Int).Abs(x).String()
+	if len(s) <= 18 {
+		s = strings.Repeat("0", 19-len(s)) + s
+	}
+	whole, frac := s[:len(s)-18], strings.TrimRight(s[len(s)-18:], "0")
+	if frac != "" {
+		whole += "." + frac
+	}
+	if x.Sign() < 0 {
+		whole = "-" + whole
+	}
+	return whole
+}
+
+// ParseUnits parses a decimal, such as "1000.5", into 1e-18 units; it
+// is the inverse of FormatUnits.  Digits beyond 18 decimals are an
+// error.
+func ParseUnits(s string) (*big.Int, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || strings.ContainsAny(s, "/eE") {
+		return nil, fmt.Errorf("invalid amount %q", s)
+	}
+	r.Mul(r, new(big.Rat).SetInt(big.NewInt(1e18)))
+	if !r.IsInt() {
+		return nil, fmt.Errorf("amount %q has more than 18 decimals", s)
+	}
+	return r.Num(), nil
+}
+
+// formatRate formats an oracle rate, which has 8 decimals.
+func formatRate(r *big.Int) string {
+	return FormatUnits(new(big.Int).Mul(r, big.NewInt(1e10)))
+}
+
+func date(t uint64) string {
+	return time.Unix(int64(t), 0).UTC().Format(time.RFC3339)
+}
+
+// WriteCSV writes l's rows as CSV.
+func (l *Ledger) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"time", "block", "tx", "address", "event", "counterparty", "action", "asset", "quantity",
+		"eth_usd", "value_usd", "basis_usd", "gain_usd", "unmatched", "method"})
+	for _, r := range l.Rows {
+		e := r.Event
+		counterparty := ""
+		if e.Kind == TransferIn || e.Kind == TransferOut {
+			counterparty = e.Counterparty.Hex()
+		}
+		cw.Write([]string{
+			date(e.Time), strconv.FormatUint(e.Block, 10), e.Tx.Hex(), e.Address.Hex(), e.Kind.String(), counterparty,
+			string(r.Action), r.Asset, FormatUnits(r.Quantity),
+			formatRate(e.Rate), FormatUnits(r.Value), FormatUnits(r.Basis), FormatUnits(r.Gain), FormatUnits(r.Unmatched), l.Method.String(),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Journal accounts.
+const (
+	AssetsETH      = "Assets:ETH"
+	AssetsUSDX     = "Assets:USDX"
+	IncomeAppr     = "Income:Appreciation"
+	IncomeGains    = "Income:Realized Gains"
+	ExpensesLosses = "Expenses:Realized Losses"
+	TransfersIn    = "Equity:Transfers In"
+	TransfersOut   = "Equity:Transfers Out"
+)
+
+// Posting is a debit (positive Amount) or credit (negative Amount) of
+// an account, in 1e-18 usd.
+type Posting struct {
+	Account string
+	Amount  *big.Int
+}
+
+// Entry is a balanced journal entry, recording an event.
+type Entry struct {
+	Event    *Event
+	Postings []Posting
+}
+
+// Journal returns l's rows as double-entry journal entries, one per
+// event, whose postings sum to zero.  Assets are carried at cost.
+func (l *Ledger) Journal() []Entry {
+	var entries []Entry
+	for i := 0; i < len(l.Rows); {
+		e := l.Rows[i].Event
+		entry := Entry{Event: e}
+		post := func(account string, amt *big.Int) {
+			if amt.Sign() != 0 {
+				entry.Postings = append(entry.Postings, Posting{account, amt})
+			}
+		}
+		neg := func(x *big.Int) *big.Int { return new(big.Int).Neg(x) }
+		for ; i < len(l.Rows) && l.Rows[i].Event == e; i++ {
+			r := l.Rows[i]
+			asset := AssetsUSDX
+			if r.Asset == ETH {
+				asset = AssetsETH
+			}
+			switch r.Action {
+			case Acquire, Income:
+				post(asset, r.Value)
+			case Dispose:
+				post(asset, neg(r.Basis))
+				if r.Gain.Sign() > 0 {
+					post(IncomeGains, neg(r.Gain))
+				} else {
+					post(ExpensesLosses, neg(r.Gain))
+				}
+			}
+		}
+		// Rows of collects, surpluses and transfers have no other
+		// side.
+		switch e.Kind {
+		case Collect, Redeemed, Settled:
+			post(IncomeAppr, neg(e.Value()))
+		case TransferIn:
+			post(TransfersIn, neg(e.Value()))
+		case TransferOut:
+			post(TransfersOut, e.Value())
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// WriteJournal writes l's journal as CSV, with a row per posting.
+func (l *Ledger) WriteJournal(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"entry", "time", "block", "tx", "account", "debit_usd", "credit_usd", "memo"})
+	for i, entry := range l.Journal() {
+		e := entry.Event
+		memo := e.Kind.String() + " " + e.Address.Hex()
+		for _, p := range entry.Postings {
+			debit, credit := FormatUnits(p.Amount), ""
+			if p.Amount.Sign() < 0 {
+				debit, credit = "", FormatUnits(new(big.Int).Neg(p.Amount))
+			}
+			cw.Write([]string{strconv.Itoa(i + 1), date(e.Time), strconv.FormatUint(e.Block, 10), e.Tx.Hex(), p.Account, debit, credit, memo})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
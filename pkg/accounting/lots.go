@@ -0,0 +1,110 @@
+package accounting
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Method is how disposals are matched with earlier acquisitions.
+type Method int
+
+const (
+	// FIFO matches disposals with the earliest acquisitions held.
+	FIFO Method = iota
+	// Average matches disposals at the average cost of all
+	// acquisitions held.
+	Average
+)
+
+func (m Method) String() string {
+	switch m {
+	case FIFO:
+		return "fifo"
+	case Average:
+		return "average"
+	}
+	return fmt.Sprintf("Method(%d)", int(m))
+}
+
+// ParseMethod parses "fifo" or "average".
+func ParseMethod(s string) (Method, error) {
+	for _, m := range []Method{FIFO, Average} {
+		if m.String() == s {
+			return m, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown lot matching method %q", s)
+}
+
+// lot is a quantity of an asset, and its cost in usd.
+type lot struct {
+	qty, cost *big.Int
+}
+
+// lots are the acquisitions of an asset held.
+type lots struct {
+	method Method
+	held   []lot // in order of acquisition, for FIFO
+	qty    *big.Int
+	cost   *big.Int
+}
+
+func newLots(m Method) *lots {
+	return &lots{method: m, qty: new(big.Int), cost: new(big.Int)}
+}
+
+func (l *lots) acquire(qty, cost *big.Int) {
+	if qty.Sign() == 0 {
+		return
+	}
+	l.qty.Add(l.qty, qty)
+	l.cost.Add(l.cost, cost)
+	if l.method == FIFO {
+		l.held = append(l.held, lot{new(big.Int).Set(qty), new(big.Int).Set(cost)})
+	}
+}
+
+// dispose removes qty, and returns the cost of what was held of it.
+// Unmatched is the quantity disposed of which wasn't held, such as eth
+// acquired before the book's history, whose cost isn't known.
+func (l *lots) dispose(qty *big.Int) (basis, unmatched *big.Int) {
+	take := new(big.Int).Set(qty)
+	if take.Cmp(l.qty) > 0 {
+		take.Set(l.qty)
+	}
+	unmatched = new(big.Int).Sub(qty, take)
+	basis = new(big.Int)
+
+	switch l.method {
+	case FIFO:
+		for rem := new(big.Int).Set(take); rem.Sign() > 0; {
+			h := &l.held[0]
+			n := new(big.Int).Set(rem)
+			if n.Cmp(h.qty) > 0 {
+				n.Set(h.qty)
+			}
+			c := proRata(h.cost, n, h.qty)
+			h.qty.Sub(h.qty, n)
+			h.cost.Sub(h.cost, c)
+			if h.qty.Sign() == 0 {
+				l.held = l.held[1:]
+			}
+			basis.Add(basis, c)
+			rem.Sub(rem, n)
+		}
+	case Average:
+		basis = proRata(l.cost, take, l.qty)
+	}
+	l.qty.Sub(l.qty, take)
+	l.cost.Sub(l.cost, basis)
+	return basis, unmatched
+}
+
+// proRata returns cost*n/of, or all of cost if n is of.
+func proRata(cost, n, of *big.Int) *big.Int {
+	if n.Cmp(of) == 0 {
+		return new(big.Int).Set(cost)
+	}
+	c := new(big.Int).Mul(cost, n)
+	return c.Quo(c, of)
+}
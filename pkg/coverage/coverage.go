@@ -0,0 +1,123 @@
+// Package coverage measures which lines of solidity sources are
+// executed by transactions on a simulated chain.
+//
+// A Collector traces every transaction a chain's blocks execute, and
+// records the program counters executed by each contract code.  A
+// Report maps the recorded program counters back to source lines
+// using the source maps of solc's combined json output, and is
+// written as LCOV or HTML:
+//
+//	c := coverage.NewCollector()
+//	c.Attach(chain.Blockchain())
+//	// ... send and commit transactions ...
+//	prog, err := srcmap.LoadCombined("testdata/combined.json", ".")
+//	report := c.Report(prog)
+//	report.WriteLCOV(w)
+//
+// Only transactions are traced; calls made with eth_call (such as
+// view functions called by tests) aren't recorded.
+package coverage
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// codeHits are the instructions executed within a contract code.
+type codeHits struct {
+	code []byte
+	pcs  map[uint64]uint64     // pc => times executed
+	jmps map[uint64]*[2]uint64 // JUMPI pc => times not taken, taken
+}
+
+// Collector records instructions executed by traced chains.  It is
+// safe for concurrent use, so a single collector can be attached to
+// chains used by parallel tests.
+type Collector struct {
+	mu   sync.Mutex
+	hits map[common.Hash]*codeHits // code hash => hits
+}
+
+// NewCollector returns an empty collector.
+func NewCollector() *Collector {
+	return &Collector{hits: make(map[common.Hash]*codeHits)}
+}
+
+// Attach traces transactions executed when bc inserts blocks.  It must
+// be called before any block is committed to bc that should be
+// covered, and mustn't be called while bc is inserting blocks.
+func (c *Collector) Attach(bc *core.BlockChain) {
+	cfg := bc.GetVMConfig()
+	cfg.Debug = true
+	cfg.Tracer = &tracer{c: c, hashes: make(map[*vm.Contract]common.Hash)}
+}
+
+// tracer records a single chain's execution into a collector.  The
+// chain executes transactions sequentially, so only the collector is
+// shared.
+type tracer struct {
+	c *Collector
+
+	// hashes caches init code hashes, which the evm doesn't compute
+	// for CREATE.
+	hashes map[*vm.Contract]common.Hash
+}
+
+func (t *tracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	return nil
+}
+
+func (t *tracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, rData []byte, contract *vm.Contract, depth int, err error) error {
+	hash := contract.CodeHash
+	if hash == (common.Hash{}) {
+		var ok bool
+		if hash, ok = t.hashes[contract]; !ok {
+			hash = crypto.Keccak256Hash(contract.Code)
+			t.hashes[contract] = hash
+		}
+	}
+
+	t.c.mu.Lock()
+	defer t.c.mu.Unlock()
+	h, ok := t.c.hits[hash]
+	if !ok {
+		h = &codeHits{
+			code: common.CopyBytes(contract.Code),
+			pcs:  make(map[uint64]uint64),
+			jmps: make(map[uint64]*[2]uint64),
+		}
+		t.c.hits[hash] = h
+	}
+	h.pcs[pc]++
+	if op == vm.JUMPI && len(stack.Data()) >= 2 {
+		j, ok := h.jmps[pc]
+		if !ok {
+			j = new([2]uint64)
+			h.jmps[pc] = j
+		}
+		if stack.Back(1).IsZero() {
+			j[0]++
+		} else {
+			j[1]++
+		}
+	}
+	return nil
+}
+
+func (t *tracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+func (t *tracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	// Contracts aren't reused across transactions.
+	for k := range t.hashes {
+		delete(t.hashes, k)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package coverage
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/royalfork/usdx/pkg/srcmap"
+)
+
+// jump.src compiles to code which jumps to the last line when called
+// with non-zero calldata, and stops at the second line otherwise.
+const (
+	jumpSrc = "check(x);\nstop();\nunreachable();\njump();\n"
+	// PUSH1 0, CALLDATALOAD, PUSH1 8, JUMPI, STOP, INVALID, JUMPDEST, STOP
+	jumpBin    = "600035600857" + "00" + "fe" + "5b00"
+	jumpSrcMap = "0:9:0;;;;10:7;18:14;33:7;"
+)
+
+func TestReport(t *testing.T) {
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "jump.src"), []byte(jumpSrc), 0644); err != nil {
+		t.Fatal(err)
+	}
+	combined := `{
+		"contracts": {
+			"jump.src:Jump": {"bin": "", "bin-runtime": "` + jumpBin + `", "srcmap": "", "srcmap-runtime": "` + jumpSrcMap + `"},
+			"jump.src:Abstract": {"bin": "", "bin-runtime": "", "srcmap": "", "srcmap-runtime": ""}
+		},
+		"sourceList": ["jump.src"]
+	}`
+	combinedPath := filepath.Join(dir, "combined.json")
+	if err := ioutil.WriteFile(combinedPath, []byte(combined), 0644); err != nil {
+		t.Fatal(err)
+	}
+	prog, err := srcmap.LoadCombined(combinedPath, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	jump := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	code, _ := hex.DecodeString(jumpBin)
+	chain := backends.NewSimulatedBackend(core.GenesisAlloc{
+		from: {Balance: big.NewInt(1e18)},
+		jump: {Code: code, Balance: new(big.Int)},
+	}, 10000000)
+	defer chain.Close()
+
+	c := NewCollector()
+	c.Attach(chain.Blockchain())
+
+	ctx := context.Background()
+	call := func(data []byte) {
+		t.Helper()
+		nonce, err := chain.PendingNonceAt(ctx, from)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx, err := types.SignNewTx(key, types.NewEIP155Signer(big.NewInt(1337)), &types.LegacyTx{
+			Nonce: nonce, Gas: 100000, GasPrice: new(big.Int), To: &jump, Data: data,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := chain.SendTransaction(ctx, tx); err != nil {
+			t.Fatal(err)
+		}
+		chain.Commit()
+	}
+	call(common.LeftPadBytes([]byte{1}, 32))
+	call(common.LeftPadBytes([]byte{1}, 32))
+
+	report := c.Report(prog)
+	var lcov bytes.Buffer
+	if err := report.WriteLCOV(&lcov); err != nil {
+		t.Fatal(err)
+	}
+	want := "TN:\n" +
+		"SF:" + filepath.Join(dir, "jump.src") + "\n" +
+		"BRDA:1,0,0,0\nBRDA:1,0,1,2\nBRF:2\nBRH:1\n" +
+		"DA:1,2\nDA:2,0\nDA:3,0\nDA:4,2\n" +
+		"LF:4\nLH:2\n" +
+		"end_of_record\n"
+	if lcov.String() != want {
+		t.Fatalf("want lcov:\n%s\ngot:\n%s", want, lcov.String())
+	}
+
+	call(nil)
+	report = c.Report(prog)
+	if hit, total := report.Files[0].Covered(); hit != 3 || total != 4 {
+		t.Fatalf("want 3/4 lines covered, got: %d/%d", hit, total)
+	}
+	if got := report.Files[0].Lines[0].Branches; !reflect.DeepEqual(got, []Branch{{Taken: 2, NotTaken: 1}}) {
+		t.Fatalf("unexpected branches: %v", got)
+	}
+
+	var html bytes.Buffer
+	if err := report.WriteHTML(&html); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		`<tr class="hit"><td class="num">2</td><td class="hits">1</td><td class="text">stop();</td></tr>`,
+		`<tr class="miss"><td class="num">3</td><td class="hits">0</td><td class="text">unreachable();</td></tr>`,
+		`<td>3/4</td><td>75.0%</td>`,
+	} {
+		if !strings.Contains(html.String(), s) {
+			t.Errorf("html doesn't contain %q", s)
+		}
+	}
+}
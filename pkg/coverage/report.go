@@ -0,0 +1,264 @@
+package coverage
+
+import (
+	"bufio"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/royalfork/usdx/pkg/srcmap"
+)
+
+// Report is the line coverage of a program's sources.
+type Report struct {
+	Files []*File // in source list order
+}
+
+// File is the coverage of a source file.  Only lines which
+// instructions were compiled from are included.
+type File struct {
+	Source *srcmap.Source
+	Lines  []*Line // sorted by number
+}
+
+// Line is the coverage of a source line.
+type Line struct {
+	Number   int
+	Hits     uint64 // times the line's most executed instruction was executed
+	Branches []Branch
+}
+
+// Branch is a conditional jump compiled from a line.
+type Branch struct {
+	Taken    uint64
+	NotTaken uint64
+}
+
+// Covered returns the number of f's lines which were executed, and
+// the total number of lines.
+func (f *File) Covered() (hit, total int) {
+	for _, l := range f.Lines {
+		if l.Hits > 0 {
+			hit++
+		}
+	}
+	return hit, len(f.Lines)
+}
+
+// Report maps the instructions recorded by c to p's sources.  Code
+// which wasn't compiled by p, such as precompiles or contracts from
+// other compilations, is ignored.
+func (c *Collector) Report(p *srcmap.Program) *Report {
+	type codeKey struct {
+		contract *srcmap.Contract
+		runtime  bool
+	}
+	// Merge hits of instances of the same code, which may differ by
+	// immutables or constructor arguments.
+	merged := make(map[codeKey]*codeHits)
+	c.mu.Lock()
+	for _, h := range c.hits {
+		contract, runtime := p.Match(h.code)
+		if contract == nil {
+			continue
+		}
+		key := codeKey{contract, runtime}
+		m, ok := merged[key]
+		if !ok {
+			code, _ := contract.Code(runtime)
+			m = &codeHits{code: code, pcs: make(map[uint64]uint64), jmps: make(map[uint64]*[2]uint64)}
+			merged[key] = m
+		}
+		for pc, n := range h.pcs {
+			m.pcs[pc] += n
+		}
+		for pc, j := range h.jmps {
+			mj, ok := m.jmps[pc]
+			if !ok {
+				mj = new([2]uint64)
+				m.jmps[pc] = mj
+			}
+			mj[0] += j[0]
+			mj[1] += j[1]
+		}
+	}
+	c.mu.Unlock()
+
+	lines := make([]map[int]*Line, len(p.Sources))
+	for i := range lines {
+		lines[i] = make(map[int]*Line)
+	}
+	// Iterate in a fixed order, so branches are reported consistently.
+	keys := make([]codeKey, 0, len(merged))
+	for k := range merged {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].contract.Name != keys[j].contract.Name {
+			return keys[i].contract.Name < keys[j].contract.Name
+		}
+		return !keys[i].runtime && keys[j].runtime
+	})
+	for _, k := range keys {
+		h := merged[k]
+		_, srcMap := k.contract.Code(k.runtime)
+		pcs := srcmap.Instructions(h.code)
+		// A line's hits within this code are those of its most
+		// executed instruction, and are summed across codes.
+		hits := make(map[*Line]uint64)
+		for i, r := range srcMap {
+			if i >= len(pcs) {
+				break
+			}
+			src := p.Source(r)
+			if src == nil {
+				continue // compiler generated
+			}
+			n := src.Line(r.Start)
+			l, ok := lines[r.File][n]
+			if !ok {
+				l = &Line{Number: n}
+				lines[r.File][n] = l
+			}
+			pc := pcs[i]
+			if hits[l] < h.pcs[pc] {
+				hits[l] = h.pcs[pc]
+			}
+			if vm.OpCode(h.code[pc]) == vm.JUMPI {
+				var b Branch
+				if j, ok := h.jmps[pc]; ok {
+					b = Branch{Taken: j[1], NotTaken: j[0]}
+				}
+				l.Branches = append(l.Branches, b)
+			}
+		}
+		for l, n := range hits {
+			l.Hits += n
+		}
+	}
+
+	r := new(Report)
+	for i, src := range p.Sources {
+		if len(lines[i]) == 0 {
+			continue
+		}
+		f := &File{Source: src}
+		for _, l := range lines[i] {
+			f.Lines = append(f.Lines, l)
+		}
+		sort.Slice(f.Lines, func(i, j int) bool { return f.Lines[i].Number < f.Lines[j].Number })
+		r.Files = append(r.Files, f)
+	}
+	return r
+}
+
+// WriteLCOV writes r in the LCOV tracefile format read by genhtml and
+// most coverage tools.  Each conditional jump is reported as a branch
+// block with two branches: not taken and taken.
+func (r *Report) WriteLCOV(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "TN:")
+	for _, f := range r.Files {
+		fmt.Fprintf(bw, "SF:%s\n", f.Source.Path)
+		var brf, brh int
+		for _, l := range f.Lines {
+			for i, b := range l.Branches {
+				for j, n := range []uint64{b.NotTaken, b.Taken} {
+					brf++
+					if n > 0 {
+						brh++
+					}
+					count := fmt.Sprint(n)
+					if b.Taken+b.NotTaken == 0 {
+						count = "-"
+					}
+					fmt.Fprintf(bw, "BRDA:%d,%d,%d,%s\n", l.Number, i, j, count)
+				}
+			}
+		}
+		fmt.Fprintf(bw, "BRF:%d\nBRH:%d\n", brf, brh)
+		for _, l := range f.Lines {
+			fmt.Fprintf(bw, "DA:%d,%d\n", l.Number, l.Hits)
+		}
+		hit, total := f.Covered()
+		fmt.Fprintf(bw, "LF:%d\nLH:%d\n", total, hit)
+		fmt.Fprintln(bw, "end_of_record")
+	}
+	return bw.Flush()
+}
+
+type htmlLine struct {
+	Number int
+	Text   string
+	Class  string // "", "hit" or "miss"
+	Hits   string
+}
+
+type htmlFile struct {
+	ID      int
+	Path    string
+	Percent string
+	Hit     int
+	Total   int
+	Lines   []htmlLine
+}
+
+var htmlTmpl = template.Must(template.New("coverage").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Solidity coverage</title>
+<style>
+body { font-family: sans-serif; }
+table.src { border-collapse: collapse; font-family: monospace; white-space: pre; }
+table.src td { padding: 0 0.5em; }
+td.num, td.hits { color: #888; text-align: right; }
+tr.hit td.text { background: #dfd; }
+tr.miss td.text { background: #fdd; }
+</style>
+</head>
+<body>
+<h1>Solidity coverage</h1>
+<table>
+<tr><th>File</th><th>Lines</th><th></th></tr>
+{{range .}}<tr><td><a href="#f{{.ID}}">{{.Path}}</a></td><td>{{.Hit}}/{{.Total}}</td><td>{{.Percent}}</td></tr>
+{{end}}</table>
+{{range .}}<h2 id="f{{.ID}}">{{.Path}}</h2>
+<table class="src">
+{{range .Lines}}<tr class="{{.Class}}"><td class="num">{{.Number}}</td><td class="hits">{{.Hits}}</td><td class="text">{{.Text}}</td></tr>
+{{end}}</table>
+{{end}}</body>
+</html>
+`))
+
+// WriteHTML writes r as a single html page, showing each file's source
+// with executed lines highlighted green and lines never executed
+// highlighted red.
+func (r *Report) WriteHTML(w io.Writer) error {
+	var files []htmlFile
+	for i, f := range r.Files {
+		hit, total := f.Covered()
+		hf := htmlFile{ID: i, Path: f.Source.Path, Hit: hit, Total: total, Percent: "-"}
+		if total > 0 {
+			hf.Percent = fmt.Sprintf("%.1f%%", 100*float64(hit)/float64(total))
+		}
+		cov := make(map[int]*Line)
+		for _, l := range f.Lines {
+			cov[l.Number] = l
+		}
+		for n := 1; n <= f.Source.Lines(); n++ {
+			hl := htmlLine{Number: n, Text: f.Source.LineText(n)}
+			if l, ok := cov[n]; ok {
+				hl.Class, hl.Hits = "miss", "0"
+				if l.Hits > 0 {
+					hl.Class, hl.Hits = "hit", fmt.Sprint(l.Hits)
+				}
+			}
+			hf.Lines = append(hf.Lines, hl)
+		}
+		files = append(files, hf)
+	}
+	return htmlTmpl.Execute(w, files)
+}
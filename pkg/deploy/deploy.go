@@ -0,0 +1,121 @@
+// Package deploy deploys USDX to deterministic CREATE2 addresses, and
+// mines salts for vanity addresses.
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+// FactoryBin is the init code of a CREATE2 factory for Ownable
+// contracts.  Calldata is a 32 byte salt followed by the init code to
+// deploy.  The first 20 bytes of the salt must be the caller's
+// address, so that mined salts can't be front-run by other accounts.
+// After deploying, the factory transfers ownership of the new
+// contract to the caller, and returns its address.  If the deploy or
+// the ownership transfer fails, the factory reverts.
+//
+//	PUSH1 0 CALLDATALOAD PUSH1 0x60 SHR CALLER EQ ISZERO PUSH1 fail JUMPI
+//	PUSH1 0x20 CALLDATASIZE SUB DUP1 PUSH1 0x20 PUSH1 0 CALLDATACOPY
+//	PUSH1 0 CALLDATALOAD SWAP1 PUSH1 0 PUSH1 0 CREATE2
+//	DUP1 ISZERO PUSH1 fail JUMPI
+//	PUSH4 0xf2fde38b PUSH1 0xe0 SHL PUSH1 0 MSTORE CALLER PUSH1 4 MSTORE
+//	PUSH1 0 PUSH1 0 PUSH1 0x24 PUSH1 0 PUSH1 0 DUP6 GAS CALL ISZERO PUSH1 fail JUMPI
+//	PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 RETURN
+//	fail: JUMPDEST PUSH1 0 DUP1 REVERT
+const FactoryBin = "0x605180600b6000396000f3" +
+	"60003560601c331415604c57602036038060206000376000359060006000f580" +
+	"15604c5763f2fde38b60e01b6000523360045260006000602460006000855af1" +
+	"15604c5760005260206000f35b600080fd"
+
+// ErrSaltOwner is returned when a salt's first 20 bytes aren't the
+// deploying account.
+var ErrSaltOwner = errors.New("salt must begin with deployer address")
+
+// DeployFactory deploys a CREATE2 factory.
+func DeployFactory(opts *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, error) {
+	addr, tx, _, err := bind.DeployContract(opts, abi.ABI{}, common.FromHex(FactoryBin), backend)
+	return addr, tx, err
+}
+
+// USDXInitCode returns the init code which deploys USDX with priceFeed.
+func USDXInitCode(priceFeed common.Address) ([]byte, error) {
+	parsed, err := abi.JSON(strings.NewReader(usdx.USDXABI))
+	if err != nil {
+		return nil, err
+	}
+	args, err := parsed.Pack("", priceFeed)
+	if err != nil {
+		return nil, err
+	}
+	return append(common.FromHex(usdx.USDXBin), args...), nil
+}
+
+// Address returns the address that factory deploys initCode to with
+// salt.
+func Address(factory common.Address, salt [32]byte, initCode []byte) common.Address {
+	return crypto.CreateAddress2(factory, salt, crypto.Keccak256(initCode))
+}
+
+// Salt returns a salt for deployer, with nonce as its last 12 bytes.
+func Salt(deployer common.Address, nonce uint64) (salt [32]byte) {
+	copy(salt[:], deployer[:])
+	for i := 31; nonce > 0; i-- {
+		salt[i] = byte(nonce)
+		nonce >>= 8
+	}
+	return salt
+}
+
+// Deploy sends a transaction deploying initCode through factory with
+// salt.  It returns the address the contract will be deployed to.
+// The deployed contract must be Ownable; ownership is transferred to
+// opts.From.
+func Deploy(opts *bind.TransactOpts, backend bind.ContractBackend, factory common.Address, salt [32]byte, initCode []byte) (common.Address, *types.Transaction, error) {
+	if common.BytesToAddress(salt[:20]) != opts.From {
+		return common.Address{}, nil, ErrSaltOwner
+	}
+	addr := Address(factory, salt, initCode)
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if code, err := backend.CodeAt(ctx, addr, nil); err != nil {
+		return common.Address{}, nil, err
+	} else if len(code) > 0 {
+		return common.Address{}, nil, fmt.Errorf("contract already deployed at %s", addr.Hex())
+	}
+
+	data := append(salt[:], initCode...)
+	tx, err := bind.NewBoundContract(factory, abi.ABI{}, backend, backend, backend).RawTransact(opts, data)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return addr, tx, nil
+}
+
+// DeployUSDX deploys USDX through factory with salt, and binds it.
+func DeployUSDX(opts *bind.TransactOpts, backend bind.ContractBackend, factory common.Address, salt [32]byte, priceFeed common.Address) (common.Address, *types.Transaction, *usdx.USDX, error) {
+	initCode, err := USDXInitCode(priceFeed)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	addr, tx, err := Deploy(opts, backend, factory, salt, initCode)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := usdx.NewUSDX(addr, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return addr, tx, contract, nil
+}
@@ -0,0 +1,164 @@
+package deploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/royalfork/soltest"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+func TestPattern(t *testing.T) {
+	addr := common.HexToAddress("0x88880096756cd77BAc67BbB6859ed2b74B648888")
+
+	tests := []struct {
+		p     Pattern
+		match bool
+	}{
+		{Pattern{}, true},
+		{Pattern{"8888", "8888"}, true},
+		{Pattern{"888800", ""}, true},
+		{Pattern{"88880", "648888"}, true},
+		{Pattern{"", "b74b648888"}, true},
+		{Pattern{"8889", ""}, false},
+		{Pattern{"", "7888"}, false},
+	}
+	for _, test := range tests {
+		if err := test.p.Validate(); err != nil {
+			t.Errorf("%s: unexpected err: %v", test.p, err)
+		}
+		if got := test.p.Match(addr); got != test.match {
+			t.Errorf("%s: want match: %t, got: %t", test.p, test.match, got)
+		}
+	}
+
+	for _, p := range []Pattern{{"88g8", ""}, {"", "0x88"}, {"8888888888888888888888", "8888888888888888888888"}} {
+		if err := p.Validate(); err == nil {
+			t.Errorf("%s: want invalid", p)
+		}
+	}
+}
+
+func TestMine(t *testing.T) {
+	var (
+		factory  = common.HexToAddress("0x4e59b44847b379578588920ca78fbf26c0b4956c")
+		deployer = common.HexToAddress("0x1111111111111111111111111111111111111111")
+		initCode = []byte("init code")
+		p        = Pattern{"88", "8"}
+	)
+
+	res, err := Mine(context.Background(), factory, deployer, crypto.Keccak256Hash(initCode), p, 4, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Match(res.Address) {
+		t.Fatalf("mined address %s doesn't match %s", res.Address.Hex(), p)
+	}
+	if want := Address(factory, res.Salt, initCode); want != res.Address {
+		t.Fatalf("want mined address: %s, got: %s", want.Hex(), res.Address.Hex())
+	}
+	if common.BytesToAddress(res.Salt[:20]) != deployer {
+		t.Fatalf("want salt to begin with deployer, got: %x", res.Salt)
+	}
+	if res.Attempts == 0 {
+		t.Fatal("want attempts to be counted")
+	}
+
+	// Impossible patterns stop when ctx is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Mine(ctx, factory, deployer, crypto.Keccak256Hash(initCode), Pattern{Prefix: "0000000000"}, 2, nil); err != context.Canceled {
+		t.Fatalf("want err: %v, got: %v", context.Canceled, err)
+	}
+}
+
+func TestDeployUSDX(t *testing.T) {
+	chain, accts := soltest.New()
+
+	factory, _, err := DeployFactory(accts[0].Auth, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+
+	oracleAddr, _, _, err := usdx.DeployMockOracle(accts[0].Auth, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+
+	initCode, err := USDXInitCode(oracleAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := Pattern{"88", ""}
+	res, err := Mine(context.Background(), factory, accts[0].Addr, crypto.Keccak256Hash(initCode), p, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("saltOwner", func(t *testing.T) {
+		if _, _, _, err := DeployUSDX(accts[1].Auth, chain, factory, res.Salt, oracleAddr); err != ErrSaltOwner {
+			t.Fatalf("want err: %v, got: %v", ErrSaltOwner, err)
+		}
+
+		// Factory rejects front-run salts.
+		raw := bind.NewBoundContract(factory, abi.ABI{}, chain, chain, chain)
+		if chain.Succeed(raw.RawTransact(accts[1].Auth, append(res.Salt[:], initCode...))) {
+			t.Fatal("factory shouldn't deploy with another account's salt")
+		}
+	})
+
+	t.Run("predictedAddress", func(t *testing.T) {
+		addr, tx, contract, err := DeployUSDX(accts[0].Auth, chain, factory, res.Salt, oracleAddr)
+		if !chain.Succeed(tx, err) {
+			t.Fatal("unable to deploy usdx")
+		}
+		if addr != res.Address {
+			t.Fatalf("want deployed address: %s, got: %s", res.Address.Hex(), addr.Hex())
+		}
+		if !p.Match(addr) {
+			t.Fatalf("deployed address %s doesn't match %s", addr.Hex(), p)
+		}
+
+		if code, err := chain.CodeAt(context.Background(), addr, nil); err != nil {
+			t.Fatal(err)
+		} else if len(code) == 0 {
+			t.Fatalf("no code deployed at %s", addr.Hex())
+		}
+
+		if owner, err := contract.Owner(&bind.CallOpts{}); err != nil {
+			t.Fatal(err)
+		} else if owner != accts[0].Addr {
+			t.Fatalf("want owner: %s, got: %s", accts[0].Addr.Hex(), owner.Hex())
+		}
+
+		if feed, err := contract.UsdPriceFeed(&bind.CallOpts{}); err != nil {
+			t.Fatal(err)
+		} else if feed != oracleAddr {
+			t.Fatalf("want price feed: %s, got: %s", oracleAddr.Hex(), feed.Hex())
+		}
+	})
+
+	t.Run("redeploy", func(t *testing.T) {
+		if _, _, _, err := DeployUSDX(accts[0].Auth, chain, factory, res.Salt, oracleAddr); err == nil {
+			t.Fatal("shouldn't redeploy to existing address")
+		}
+	})
+
+	t.Run("otherSalt", func(t *testing.T) {
+		salt := Salt(accts[0].Addr, 12345)
+		addr, tx, _, err := DeployUSDX(accts[0].Auth, chain, factory, salt, oracleAddr)
+		if !chain.Succeed(tx, err) {
+			t.Fatal("unable to deploy usdx")
+		}
+		if want := Address(factory, salt, initCode); addr != want {
+			t.Fatalf("want deployed address: %s, got: %s", want.Hex(), addr.Hex())
+		}
+	})
+}
@@ -0,0 +1,175 @@
+package deploy
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Pattern matches addresses which begin with Prefix and end with
+// Suffix.  Prefix and Suffix are case insensitive hex strings, and
+// may have an odd number of digits.
+type Pattern struct {
+	Prefix, Suffix string
+}
+
+func (p Pattern) String() string {
+	return fmt.Sprintf("0x%s…%s", p.Prefix, p.Suffix)
+}
+
+// Validate returns an error if p can never match an address.
+func (p Pattern) Validate() error {
+	if len(p.Prefix)+len(p.Suffix) > 2*common.AddressLength {
+		return fmt.Errorf("pattern %s longer than an address", p)
+	}
+	for _, s := range []string{p.Prefix, p.Suffix} {
+		if _, err := hex.DecodeString(strings.Repeat("0", len(s)%2) + s); err != nil {
+			return fmt.Errorf("pattern %s: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// Difficulty returns the expected number of attempts to find a match.
+func (p Pattern) Difficulty() float64 {
+	return math.Pow(16, float64(len(p.Prefix)+len(p.Suffix)))
+}
+
+// nibbles returns the index and value of each hex digit p matches.
+func (p Pattern) nibbles() (idx []int, val []byte) {
+	add := func(s string, start int) {
+		for i, c := range strings.ToLower(s) {
+			b, _ := hex.DecodeString("0" + string(c))
+			idx = append(idx, start+i)
+			val = append(val, b[0])
+		}
+	}
+	add(p.Prefix, 0)
+	add(p.Suffix, 2*common.AddressLength-len(p.Suffix))
+	return idx, val
+}
+
+// Match returns true if addr matches p.
+func (p Pattern) Match(addr common.Address) bool {
+	idx, val := p.nibbles()
+	return matchNibbles(addr[:], idx, val)
+}
+
+func matchNibbles(addr []byte, idx []int, val []byte) bool {
+	for i, n := range idx {
+		b := addr[n/2]
+		if n%2 == 0 {
+			b >>= 4
+		}
+		if b&0xf != val[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// MineResult is a salt which deploys to an address matching a pattern.
+type MineResult struct {
+	Salt     [32]byte
+	Address  common.Address
+	Attempts uint64
+}
+
+// Mine searches for a salt which causes factory to deploy init code
+// with initCodeHash to an address matching p.  Salts begin with
+// deployer (see Salt).  The search runs on workers goroutines
+// (GOMAXPROCS if workers <= 0) until a match is found or ctx is done.
+// If attempts is non-nil, it is atomically incremented as salts are
+// tried, to report progress.
+func Mine(ctx context.Context, factory, deployer common.Address, initCodeHash common.Hash, p Pattern, workers int, attempts *uint64) (MineResult, error) {
+	if err := p.Validate(); err != nil {
+		return MineResult{}, err
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if attempts == nil {
+		attempts = new(uint64)
+	}
+	idx, val := p.nibbles()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg    sync.WaitGroup
+		found = make(chan [32]byte, workers)
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+
+			// buf is 0xff ++ factory ++ salt ++ initCodeHash, where
+			// salt is deployer ++ worker(4) ++ counter(8).
+			var (
+				buf  [1 + 20 + 32 + 32]byte
+				hash [32]byte
+			)
+			buf[0] = 0xff
+			copy(buf[1:], factory[:])
+			copy(buf[21:], deployer[:])
+			binary.BigEndian.PutUint32(buf[41:], uint32(w))
+			copy(buf[53:], initCodeHash[:])
+			salt := buf[21:53]
+			state := crypto.NewKeccakState()
+
+			const batch = 1 << 12
+			for counter := uint64(0); ; counter++ {
+				if counter%batch == 0 {
+					if ctx.Err() != nil {
+						return
+					}
+					if counter > 0 {
+						atomic.AddUint64(attempts, batch)
+					}
+				}
+				binary.BigEndian.PutUint64(buf[45:], counter)
+				state.Reset()
+				state.Write(buf[:])
+				state.Read(hash[:])
+				if matchNibbles(hash[12:], idx, val) {
+					var out [32]byte
+					copy(out[:], salt)
+					atomic.AddUint64(attempts, counter%batch+1)
+					found <- out
+					return
+				}
+			}
+		}(w)
+	}
+
+	go func() {
+		wg.Wait()
+		close(found)
+	}()
+
+	select {
+	case salt, ok := <-found:
+		if !ok {
+			return MineResult{}, ctx.Err()
+		}
+		cancel()
+		return MineResult{
+			Salt:     salt,
+			Address:  crypto.CreateAddress2(factory, salt, initCodeHash[:]),
+			Attempts: atomic.LoadUint64(attempts),
+		}, nil
+	case <-ctx.Done():
+		return MineResult{}, ctx.Err()
+	}
+}
@@ -0,0 +1,364 @@
+// Package devnet runs a local development chain with MockOracle and
+// USDX deployed, for frontends and services which can't embed a
+// simulated backend.
+//
+// A Devnet mines every transaction into its own block as soon as it
+// is sent, and answers calls against any historical block.  Its
+// JSON-RPC server (see Server and Handler) speaks enough of the eth
+// namespace for ethclient, ethers and wallets, and adds a devnet
+// namespace to set the oracle price, mine blocks and warp time.
+package devnet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+// GasLimit is the gas limit of every devnet block.
+const GasLimit = 30000000
+
+// PriceDecimals is the number of decimals of oracle prices.
+const PriceDecimals = 8
+
+var (
+	errUnknownBlock = errors.New("unknown block")
+	errGasLimit     = errors.New("exceeds block gas limit")
+)
+
+// Config configures a new devnet.
+type Config struct {
+	// Accounts are the names of accounts funded at genesis.  The
+	// first account deploys, and owns, MockOracle and USDX.
+	Accounts []string
+	// Balance is the wei each account is funded with.
+	Balance *big.Int
+	// Price is the initial eth/usd oracle price, with
+	// PriceDecimals decimals.
+	Price *big.Int
+}
+
+// DefaultConfig funds owner, alice, bob and carol with 1000 eth each
+// at a price of 2000 usd/eth.
+var DefaultConfig = Config{
+	Accounts: []string{"owner", "alice", "bob", "carol"},
+	Balance:  new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18)),
+	Price:    big.NewInt(2000e8),
+}
+
+// Account is a named devnet account.
+type Account struct {
+	Name string
+	Key  *ecdsa.PrivateKey
+	Addr common.Address
+}
+
+// AccountKey returns the private key of the account called name.
+// Keys are derived from names, so accounts keep their addresses
+// across devnet restarts.
+func AccountKey(name string) *ecdsa.PrivateKey {
+	key, err := crypto.ToECDSA(crypto.Keccak256([]byte("usdx devnet " + name)))
+	if err != nil {
+		panic(err) // keccak output is a valid secp256k1 key
+	}
+	return key
+}
+
+// Devnet is a simulated chain with MockOracle and USDX deployed.  It
+// implements bind.ContractBackend; transactions sent to it are mined
+// immediately.
+type Devnet struct {
+	*backends.SimulatedBackend
+
+	mu       sync.Mutex // serializes block production
+	db       ethdb.Database
+	chainID  *big.Int
+	round    int64 // last oracle round
+	Accounts []Account
+
+	OracleAddr common.Address
+	Oracle     *usdx.MockOracle
+	USDXAddr   common.Address
+	USDX       *usdx.USDX
+}
+
+// New starts a devnet, funds cfg's accounts and deploys MockOracle and
+// USDX.
+func New(cfg Config) (*Devnet, error) {
+	if len(cfg.Accounts) == 0 {
+		return nil, errors.New("devnet requires at least one account")
+	}
+	if cfg.Balance == nil || cfg.Price == nil {
+		return nil, errors.New("devnet requires a balance and price")
+	}
+
+	d := &Devnet{db: rawdb.NewMemoryDatabase()}
+	alloc := make(core.GenesisAlloc)
+	for _, name := range cfg.Accounts {
+		key := AccountKey(name)
+		acct := Account{name, key, crypto.PubkeyToAddress(key.PublicKey)}
+		if _, ok := alloc[acct.Addr]; ok {
+			return nil, fmt.Errorf("duplicate account %q", name)
+		}
+		alloc[acct.Addr] = core.GenesisAccount{Balance: cfg.Balance}
+		d.Accounts = append(d.Accounts, acct)
+	}
+	d.SimulatedBackend = backends.NewSimulatedBackendWithDatabase(d.db, alloc, GasLimit)
+	d.chainID = d.Blockchain().Config().ChainID
+
+	owner, err := d.Transactor(d.Accounts[0].Name)
+	if err != nil {
+		return nil, err
+	}
+	if d.OracleAddr, _, d.Oracle, err = usdx.DeployMockOracle(owner, d); err != nil {
+		return nil, fmt.Errorf("deploying MockOracle: %v", err)
+	}
+	if err := d.SetPrice(cfg.Price); err != nil {
+		return nil, err
+	}
+	if d.USDXAddr, _, d.USDX, err = usdx.DeployUSDX(owner, d, d.OracleAddr); err != nil {
+		return nil, fmt.Errorf("deploying USDX: %v", err)
+	}
+	return d, nil
+}
+
+// ChainID returns the devnet's chain id.
+func (d *Devnet) ChainID() *big.Int {
+	return new(big.Int).Set(d.chainID)
+}
+
+// Account returns the account called name.
+func (d *Devnet) Account(name string) (Account, bool) {
+	for _, a := range d.Accounts {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return Account{}, false
+}
+
+// Transactor returns transact opts which sign as the account called
+// name.
+func (d *Devnet) Transactor(name string) (*bind.TransactOpts, error) {
+	acct, ok := d.Account(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown account %q", name)
+	}
+	return bind.NewKeyedTransactorWithChainID(acct.Key, d.chainID)
+}
+
+// SetPrice starts a new oracle round with price, which has
+// PriceDecimals decimals.  The round is answered in itself, so it's
+// never stale.
+func (d *Devnet) SetPrice(price *big.Int) error {
+	owner, err := d.Transactor(d.Accounts[0].Name)
+	if err != nil {
+		return err
+	}
+	// The lock is held from reading the owner's nonce until the round
+	// is mined, so no other transaction from the owner takes the nonce.
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	nonce, err := d.PendingNonceAt(context.Background(), owner.From)
+	if err != nil {
+		return err
+	}
+	owner.Nonce = new(big.Int).SetUint64(nonce)
+	oracle, err := usdx.NewMockOracleTransactor(d.OracleAddr, lockedBackend{d})
+	if err != nil {
+		return err
+	}
+	d.round++
+	round := big.NewInt(d.round)
+	now := new(big.Int).SetUint64(d.Blockchain().CurrentHeader().Time)
+	if _, err := oracle.SetLastRound(owner, round, price, now, now, round); err != nil {
+		return fmt.Errorf("setting oracle price: %v", err)
+	}
+	return nil
+}
+
+// ParsePrice parses a decimal usd price, such as "2000.5", into an
+// oracle price with PriceDecimals decimals.  Digits beyond
+// PriceDecimals are truncated.
+func ParsePrice(s string) (*big.Int, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || r.Sign() < 0 {
+		return nil, fmt.Errorf("invalid price %q", s)
+	}
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(PriceDecimals), nil)))
+	return new(big.Int).Quo(r.Num(), r.Denom()), nil
+}
+
+// Mine mines n empty blocks, and returns the new head.
+func (d *Devnet) Mine(n uint64) *types.Header {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i := uint64(0); i < n; i++ {
+		d.Commit()
+	}
+	return d.Blockchain().CurrentHeader()
+}
+
+// IncreaseTime mines an empty block whose timestamp is dt later than
+// it would otherwise be, and returns the new head.  Blocks are
+// normally 10 seconds apart.
+func (d *Devnet) IncreaseTime(dt time.Duration) (*types.Header, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.AdjustTime(dt); err != nil {
+		return nil, err
+	}
+	d.Commit()
+	return d.Blockchain().CurrentHeader(), nil
+}
+
+// SendTransaction validates tx, and mines it into a new block.  The
+// simulated backend panics on invalid transactions, so every check it
+// would panic on is made here first.
+func (d *Devnet) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.sendTransaction(ctx, tx)
+}
+
+// lockedBackend sends transactions for a caller already holding the
+// devnet's lock.
+type lockedBackend struct {
+	*Devnet
+}
+
+func (b lockedBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return b.sendTransaction(ctx, tx)
+}
+
+// sendTransaction is SendTransaction, called with d.mu held.
+func (d *Devnet) sendTransaction(ctx context.Context, tx *types.Transaction) (err error) {
+	head := d.Blockchain().CurrentBlock()
+	from, err := types.Sender(types.MakeSigner(d.Blockchain().Config(), head.Number()), tx)
+	if err != nil {
+		return err
+	}
+	statedb, err := d.Blockchain().State()
+	if err != nil {
+		return err
+	}
+	if nonce := statedb.GetNonce(from); tx.Nonce() < nonce {
+		return fmt.Errorf("%w: address %v, tx: %d state: %d", core.ErrNonceTooLow, from.Hex(), tx.Nonce(), nonce)
+	} else if tx.Nonce() > nonce {
+		return fmt.Errorf("%w: address %v, tx: %d state: %d", core.ErrNonceTooHigh, from.Hex(), tx.Nonce(), nonce)
+	}
+	if tx.Gas() > head.GasLimit() {
+		return errGasLimit
+	}
+	intrinsic, err := core.IntrinsicGas(tx.Data(), tx.AccessList(), tx.To() == nil, true, true)
+	if err != nil {
+		return err
+	}
+	if tx.Gas() < intrinsic {
+		return core.ErrIntrinsicGas
+	}
+	if statedb.GetBalance(from).Cmp(tx.Cost()) < 0 {
+		return core.ErrInsufficientFunds
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid transaction: %v", r)
+		}
+	}()
+	if err := d.SimulatedBackend.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	d.Commit()
+	return nil
+}
+
+// CallContract executes call against the state at blockNumber, which
+// may be any block in the chain.  A nil blockNumber is the latest
+// block.
+func (d *Devnet) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	block := d.Blockchain().CurrentBlock()
+	if blockNumber != nil {
+		if block = d.Blockchain().GetBlockByNumber(blockNumber.Uint64()); block == nil {
+			return nil, errUnknownBlock
+		}
+	}
+	return d.callAt(call, block)
+}
+
+// PendingCallContract executes call against the latest block.  Every
+// transaction is mined when sent, so there is no pending state.
+func (d *Devnet) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	return d.callAt(call, d.Blockchain().CurrentBlock())
+}
+
+func (d *Devnet) callAt(call ethereum.CallMsg, block *types.Block) ([]byte, error) {
+	statedb, err := d.Blockchain().StateAt(block.Root())
+	if err != nil {
+		return nil, err
+	}
+	if call.Gas == 0 {
+		call.Gas = block.GasLimit()
+	}
+	if call.GasPrice == nil {
+		call.GasPrice = new(big.Int)
+	}
+	if call.Value == nil {
+		call.Value = new(big.Int)
+	}
+	// The caller is credited with the eth it sends, so calls don't
+	// depend on the caller's balance.
+	statedb.AddBalance(call.From, call.Value)
+
+	msg := types.NewMessage(call.From, call.To, 0, call.Value, call.Gas, call.GasPrice, call.Data, nil, false)
+	blockCtx := core.NewEVMBlockContext(block.Header(), d.Blockchain(), nil)
+	evm := vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), statedb, d.Blockchain().Config(), vm.Config{})
+	res, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(math.MaxUint64))
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Revert()) > 0 {
+		return nil, newRevertError(res.Revert())
+	}
+	return res.Return(), res.Err
+}
+
+// revertError is a reverted call.  It carries the revert data as a
+// JSON-RPC error, as geth does.
+type revertError struct {
+	error
+	data []byte
+}
+
+func newRevertError(data []byte) *revertError {
+	err := errors.New("execution reverted")
+	if reason, uerr := abi.UnpackRevert(data); uerr == nil {
+		err = fmt.Errorf("execution reverted: %v", reason)
+	}
+	return &revertError{err, data}
+}
+
+// ErrorCode is the JSON-RPC error code of reverted calls.
+func (e *revertError) ErrorCode() int { return 3 }
+
+// ErrorData is the hex encoded revert data.
+func (e *revertError) ErrorData() interface{} { return hexutil.Encode(e.data) }
@@ -0,0 +1,374 @@
+package devnet
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/royalfork/usdx/pkg/proof"
+	"github.com/royalfork/usdx/pkg/txdebug"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+func newDevnet(t *testing.T) *Devnet {
+	t.Helper()
+	d, err := New(DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.Close() })
+	return d
+}
+
+func TestNew(t *testing.T) {
+	d := newDevnet(t)
+
+	if code, err := d.CodeAt(context.Background(), d.USDXAddr, nil); err != nil || len(code) == 0 {
+		t.Fatalf("want USDX deployed, got code: %x, err: %v", code, err)
+	}
+	feed, err := d.USDX.UsdPriceFeed(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if feed != d.OracleAddr {
+		t.Errorf("want price feed: %s, got: %s", d.OracleAddr.Hex(), feed.Hex())
+	}
+	round, err := d.Oracle.LatestRoundData(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if round.Answer.Cmp(DefaultConfig.Price) != 0 {
+		t.Errorf("want price: %v, got: %v", DefaultConfig.Price, round.Answer)
+	}
+
+	for _, name := range DefaultConfig.Accounts {
+		acct, ok := d.Account(name)
+		if !ok {
+			t.Fatalf("missing account %s", name)
+		}
+		if want := crypto.PubkeyToAddress(AccountKey(name).PublicKey); acct.Addr != want {
+			t.Errorf("%s: want stable address: %s, got: %s", name, want.Hex(), acct.Addr.Hex())
+		}
+	}
+	alice, _ := d.Account("alice")
+	if bal, err := d.BalanceAt(context.Background(), alice.Addr, nil); err != nil || bal.Cmp(DefaultConfig.Balance) != 0 {
+		t.Errorf("want alice balance: %v, got: %v, err: %v", DefaultConfig.Balance, bal, err)
+	}
+
+	if _, err := New(Config{Accounts: []string{"a", "a"}, Balance: DefaultConfig.Balance, Price: DefaultConfig.Price}); err == nil {
+		t.Error("want duplicate account err")
+	}
+}
+
+func TestSendTransaction(t *testing.T) {
+	d := newDevnet(t)
+	alice, _ := d.Account("alice")
+	head := d.Blockchain().CurrentHeader().Number.Uint64()
+
+	tx, err := types.SignNewTx(alice.Key, types.NewEIP155Signer(d.ChainID()), &types.LegacyTx{
+		Nonce: 1, Gas: 21000, GasPrice: big.NewInt(1), To: &d.USDXAddr, Value: big.NewInt(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SendTransaction(context.Background(), tx); err == nil || !strings.Contains(err.Error(), "nonce too high") {
+		t.Fatalf("want nonce err, got: %v", err)
+	}
+
+	tx, err = types.SignNewTx(alice.Key, types.NewEIP155Signer(d.ChainID()), &types.LegacyTx{
+		Nonce: 0, Gas: 21000, GasPrice: big.NewInt(1), To: &d.USDXAddr, Value: new(big.Int).Mul(DefaultConfig.Balance, big.NewInt(2)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SendTransaction(context.Background(), tx); err == nil {
+		t.Fatal("want insufficient funds err")
+	}
+	if got := d.Blockchain().CurrentHeader().Number.Uint64(); got != head {
+		t.Fatalf("want invalid txs not mined, head moved from %d to %d", head, got)
+	}
+}
+
+func TestSetPrice(t *testing.T) {
+	d := newDevnet(t)
+
+	// Concurrent rounds are all sent from the owner, each with its own
+	// nonce.
+	const n = 8
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			errs <- d.SetPrice(big.NewInt(int64(2000+i) * 1e8))
+		}(i)
+	}
+	for i := 0; i < n; i++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+	round, err := d.Oracle.LatestRoundData(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := big.NewInt(n + 1); round.RoundId.Cmp(want) != 0 {
+		t.Errorf("want round: %v, got: %v", want, round.RoundId)
+	}
+}
+
+func TestRPC(t *testing.T) {
+	d := newDevnet(t)
+	srv := d.Server()
+	defer srv.Stop()
+	hs := httptest.NewServer(Handler(srv))
+	defer hs.Close()
+
+	rc, err := rpc.Dial(hs.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	client := ethclient.NewClient(rc)
+	ctx := context.Background()
+
+	if id, err := client.ChainID(ctx); err != nil || id.Cmp(d.ChainID()) != 0 {
+		t.Fatalf("want chain id: %v, got: %v, err: %v", d.ChainID(), id, err)
+	}
+
+	var info Info
+	if err := rc.Call(&info, "devnet_info"); err != nil {
+		t.Fatal(err)
+	}
+	if info.USDX != d.USDXAddr || info.Oracle != d.OracleAddr || len(info.Accounts) != len(DefaultConfig.Accounts) {
+		t.Fatalf("unexpected info: %+v", info)
+	}
+
+	// Mint through the rpc server, with bindings on ethclient.
+	contract, err := usdx.NewUSDX(d.USDXAddr, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth, err := d.Transactor("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	before, err := client.BlockNumber(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth.Value = big.NewInt(1e18)
+	tx, err := (&usdx.USDXRaw{Contract: contract}).Transfer(auth)
+	auth.Value = nil
+	if err != nil {
+		t.Fatal(err)
+	}
+	rcpt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rcpt.Status != types.ReceiptStatusSuccessful || rcpt.BlockNumber.Uint64() != before+1 {
+		t.Fatalf("want tx mined in block %d, got: %+v", before+1, rcpt)
+	}
+	if got, _, err := client.TransactionByHash(ctx, tx.Hash()); err != nil || got.Hash() != tx.Hash() {
+		t.Fatalf("want tx %s, got: %v, err: %v", tx.Hash().Hex(), got, err)
+	}
+	if block, err := client.BlockByNumber(ctx, rcpt.BlockNumber); err != nil || len(block.Transactions()) != 1 {
+		t.Fatalf("want block with 1 tx, got: %v, err: %v", block, err)
+	}
+
+	want := new(big.Int).Mul(big.NewInt(2000), big.NewInt(1e18)) // 1 eth at 2000 usd/eth
+	if bal, err := contract.BalanceOf(&bind.CallOpts{}, auth.From); err != nil || bal.Cmp(want) != 0 {
+		t.Fatalf("want balance: %v, got: %v, err: %v", want, bal, err)
+	}
+	// Historical calls see state before the mint.
+	if bal, err := contract.BalanceOf(&bind.CallOpts{BlockNumber: new(big.Int).SetUint64(before)}, auth.From); err != nil || bal.Sign() != 0 {
+		t.Fatalf("want balance at block %d: 0, got: %v, err: %v", before, bal, err)
+	}
+
+	// Slots are sent as 32 bytes, with leading zeros.
+	if supply, err := client.StorageAt(ctx, d.USDXAddr, common.BigToHash(big.NewInt(2)), nil); err != nil || new(big.Int).SetBytes(supply).Cmp(want) != 0 {
+		t.Fatalf("want total supply slot: %v, got: %x, err: %v", want, supply, err)
+	}
+
+	it, err := contract.FilterTransfer(&bind.FilterOpts{Start: before}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !it.Next() || it.Event.To != auth.From || it.Event.Value.Cmp(want) != 0 {
+		t.Fatalf("want Transfer log to %s, got: %+v", auth.From.Hex(), it.Event)
+	}
+	it.Close()
+
+	// Reverts surface their reason.
+	if _, err := contract.Unlock(&bind.TransactOpts{From: info.Accounts[2].Address, Signer: auth.Signer}, big.NewInt(1)); err == nil || !strings.Contains(err.Error(), "nothing to redeem") {
+		t.Fatalf("want revert reason, got: %v", err)
+	}
+
+	t.Run("setPrice", func(t *testing.T) {
+		var answer hexutil.Big
+		if err := rc.Call(&answer, "devnet_setPrice", "3000.5"); err != nil {
+			t.Fatal(err)
+		}
+		if want := big.NewInt(3000.5e8); answer.ToInt().Cmp(want) != 0 {
+			t.Fatalf("want answer: %v, got: %v", want, answer.ToInt())
+		}
+		appr, err := contract.Appreciation(&bind.CallOpts{}, auth.From)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := new(big.Int).Mul(big.NewInt(10005), big.NewInt(1e17)); appr.Cmp(want) != 0 {
+			t.Fatalf("want appreciation: %v, got: %v", want, appr)
+		}
+	})
+
+	t.Run("mine", func(t *testing.T) {
+		start, _ := client.BlockNumber(ctx)
+		var n hexutil.Uint64
+		if err := rc.Call(&n, "devnet_mine", 5); err != nil {
+			t.Fatal(err)
+		}
+		if uint64(n) != start+5 {
+			t.Fatalf("want block: %d, got: %d", start+5, n)
+		}
+		if err := rc.Call(&n, "devnet_mine"); err != nil {
+			t.Fatal(err)
+		}
+		if uint64(n) != start+6 {
+			t.Fatalf("want block: %d, got: %d", start+6, n)
+		}
+		if err := rc.Call(&n, "devnet_mine", maxMine+1); err == nil {
+			t.Error("mined more than maxMine blocks")
+		}
+	})
+
+	t.Run("increaseTime", func(t *testing.T) {
+		head, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ts hexutil.Uint64
+		if err := rc.Call(&ts, "devnet_increaseTime", 86400); err != nil {
+			t.Fatal(err)
+		}
+		if uint64(ts) < head.Time+86400 {
+			t.Fatalf("want time >= %d, got: %d", head.Time+86400, ts)
+		}
+	})
+
+	t.Run("getProof", func(t *testing.T) {
+		head, err := client.HeaderByNumber(ctx, rcpt.BlockNumber)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pos, err := proof.Verify(ctx, rc, d.USDXAddr, auth.From, head.Number, head.Root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pos.Locked.Cmp(big.NewInt(1e18)) != 0 || pos.Mint.Cmp(want) != 0 || pos.Balance.Cmp(want) != 0 {
+			t.Fatalf("unexpected position: %+v", pos)
+		}
+		// A proof doesn't verify against another block's root.
+		parent, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(before))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := proof.Verify(ctx, rc, d.USDXAddr, auth.From, head.Number, parent.Root); err == nil {
+			t.Fatal("want error verifying against parent's root")
+		}
+	})
+
+	t.Run("traceTransaction", func(t *testing.T) {
+		// Skip gas estimation, so the failing transfer is mined.
+		opts := *auth
+		opts.GasLimit = 100000
+		tx, err := contract.Transfer(&opts, info.Accounts[2].Address, new(big.Int).Add(want, big.NewInt(1)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rcpt, err := bind.WaitMined(ctx, client, tx); err != nil || rcpt.Status != types.ReceiptStatusFailed {
+			t.Fatalf("want failed tx, got: %+v, err: %v", rcpt, err)
+		}
+
+		res, err := txdebug.DebugRPC(ctx, rc, tx.Hash(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.Failed || !strings.Contains(res.Reason, "exceeds balance") {
+			t.Fatalf("want revert reason, got: %s", res)
+		}
+		if len(res.Reads) == 0 {
+			t.Fatal("want storage reads")
+		}
+		// Tracing over rpc finds what replaying locally does.
+		local, err := txdebug.Debug(d.Blockchain(), tx.Hash(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.String() != local.String() {
+			t.Fatalf("rpc trace:\n%s\ndiffers from local trace:\n%s", res, local)
+		}
+	})
+}
+
+func TestSubscribe(t *testing.T) {
+	d := newDevnet(t)
+	srv := d.Server()
+	defer srv.Stop()
+	hs := httptest.NewServer(Handler(srv))
+	defer hs.Close()
+
+	client, err := ethclient.Dial("ws" + strings.TrimPrefix(hs.URL, "http"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	heads := make(chan *types.Header)
+	sub, err := client.SubscribeNewHead(context.Background(), heads)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+
+	want := d.Mine(1).Number
+	select {
+	case h := <-heads:
+		if h.Number.Cmp(want) != 0 {
+			t.Fatalf("want head: %v, got: %v", want, h.Number)
+		}
+	case err := <-sub.Err():
+		t.Fatal(err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for head")
+	}
+}
+
+func TestParsePrice(t *testing.T) {
+	for s, want := range map[string]int64{
+		"2000":           2000e8,
+		"2000.5":         2000.5e8,
+		"0.000000019":    1,
+		"1234.567890129": 123456789012,
+	} {
+		got, err := ParsePrice(s)
+		if err != nil {
+			t.Errorf("%s: unexpected err: %v", s, err)
+		} else if got.Int64() != want {
+			t.Errorf("%s: want: %d, got: %v", s, want, got)
+		}
+	}
+	for _, s := range []string{"", "-1", "abc"} {
+		if _, err := ParsePrice(s); err == nil {
+			t.Errorf("%q: want err", s)
+		}
+	}
+}
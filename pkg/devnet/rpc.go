@@ -0,0 +1,574 @@
+package devnet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/royalfork/usdx/pkg/proof"
+	"github.com/royalfork/usdx/pkg/txdebug"
+)
+
+// ClientVersion is returned by web3_clientVersion.
+const ClientVersion = "usdx-devnet"
+
+// Server returns a JSON-RPC server for d, with the eth, net, web3,
+// debug and devnet namespaces.
+func (d *Devnet) Server() *rpc.Server {
+	srv := rpc.NewServer()
+	for name, api := range map[string]interface{}{
+		"eth":    &ethAPI{d},
+		"net":    &netAPI{d},
+		"web3":   web3API{},
+		"debug":  &debugAPI{d},
+		"devnet": &devnetAPI{d},
+	} {
+		if err := srv.RegisterName(name, api); err != nil {
+			panic(err) // every api is a valid rpc service
+		}
+	}
+	return srv
+}
+
+// Handler serves srv over HTTP, and over WebSocket for upgrade
+// requests, on the same path.  Requests from any origin are allowed.
+func Handler(srv *rpc.Server) http.Handler {
+	ws := srv.WebsocketHandler([]string{"*"})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			ws.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		if r.Method == http.MethodOptions {
+			w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+			return
+		}
+		srv.ServeHTTP(w, r)
+	})
+}
+
+// ethAPI is the eth namespace.
+type ethAPI struct {
+	d *Devnet
+}
+
+func (api *ethAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(api.d.ChainID())
+}
+
+func (api *ethAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.d.Blockchain().CurrentHeader().Number.Uint64())
+}
+
+func (api *ethAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := api.d.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(price), err
+}
+
+func (api *ethAPI) Accounts() []common.Address {
+	addrs := make([]common.Address, len(api.d.Accounts))
+	for i, a := range api.d.Accounts {
+		addrs[i] = a.Addr
+	}
+	return addrs
+}
+
+// blockNumber resolves bnh to a block number.
+func (api *ethAPI) blockNumber(bnh rpc.BlockNumberOrHash) (*big.Int, error) {
+	bc := api.d.Blockchain()
+	if hash, ok := bnh.Hash(); ok {
+		header := bc.GetHeaderByHash(hash)
+		if header == nil || (bnh.RequireCanonical && bc.GetCanonicalHash(header.Number.Uint64()) != hash) {
+			return nil, errUnknownBlock
+		}
+		return header.Number, nil
+	}
+	n, _ := bnh.Number()
+	return api.number(n)
+}
+
+func (api *ethAPI) number(n rpc.BlockNumber) (*big.Int, error) {
+	head := api.d.Blockchain().CurrentHeader().Number
+	switch {
+	case n == rpc.LatestBlockNumber || n == rpc.PendingBlockNumber:
+		return head, nil
+	case n < 0 || n.Int64() > head.Int64():
+		return nil, errUnknownBlock
+	}
+	return big.NewInt(n.Int64()), nil
+}
+
+func (api *ethAPI) GetBalance(ctx context.Context, addr common.Address, bnh rpc.BlockNumberOrHash) (*hexutil.Big, error) {
+	n, err := api.blockNumber(bnh)
+	if err != nil {
+		return nil, err
+	}
+	bal, err := api.d.BalanceAt(ctx, addr, n)
+	return (*hexutil.Big)(bal), err
+}
+
+func (api *ethAPI) GetCode(ctx context.Context, addr common.Address, bnh rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	n, err := api.blockNumber(bnh)
+	if err != nil {
+		return nil, err
+	}
+	return api.d.CodeAt(ctx, addr, n)
+}
+
+func (api *ethAPI) GetTransactionCount(ctx context.Context, addr common.Address, bnh rpc.BlockNumberOrHash) (hexutil.Uint64, error) {
+	n, err := api.blockNumber(bnh)
+	if err != nil {
+		return 0, err
+	}
+	nonce, err := api.d.NonceAt(ctx, addr, n)
+	return hexutil.Uint64(nonce), err
+}
+
+func (api *ethAPI) GetStorageAt(ctx context.Context, addr common.Address, key string, bnh rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	n, err := api.blockNumber(bnh)
+	if err != nil {
+		return nil, err
+	}
+	k, err := storageKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return api.d.StorageAt(ctx, addr, k, n)
+}
+
+// storageKey parses a storage slot, which clients send either as 32
+// bytes or as a quantity.
+func storageKey(key string) (common.Hash, error) {
+	if b, err := hexutil.Decode(key); err == nil && len(b) <= common.HashLength {
+		return common.BytesToHash(b), nil
+	}
+	k, err := hexutil.DecodeBig(key)
+	if err != nil || k.BitLen() > 256 {
+		return common.Hash{}, fmt.Errorf("invalid storage key %q", key)
+	}
+	return common.BigToHash(k), nil
+}
+
+// GetProof returns the Merkle-Patricia proof of addr's account and
+// storage slots keys.
+func (api *ethAPI) GetProof(addr common.Address, keys []string, bnh rpc.BlockNumberOrHash) (*proof.AccountResult, error) {
+	n, err := api.blockNumber(bnh)
+	if err != nil {
+		return nil, err
+	}
+	bc := api.d.Blockchain()
+	statedb, err := bc.StateAt(bc.GetHeaderByNumber(n.Uint64()).Root)
+	if err != nil {
+		return nil, err
+	}
+	slots := make([]common.Hash, len(keys))
+	for i, key := range keys {
+		if slots[i], err = storageKey(key); err != nil {
+			return nil, err
+		}
+	}
+	return proof.NewAccountResult(statedb, addr, slots)
+}
+
+// callArgs are the arguments of eth_call, eth_estimateGas and
+// eth_sendTransaction.
+type callArgs struct {
+	From     *common.Address `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Nonce    *hexutil.Uint64 `json:"nonce"`
+	Data     *hexutil.Bytes  `json:"data"`
+	Input    *hexutil.Bytes  `json:"input"`
+}
+
+func (args callArgs) msg() ethereum.CallMsg {
+	var msg ethereum.CallMsg
+	if args.From != nil {
+		msg.From = *args.From
+	}
+	msg.To = args.To
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	msg.GasPrice = (*big.Int)(args.GasPrice)
+	msg.Value = (*big.Int)(args.Value)
+	if args.Input != nil {
+		msg.Data = *args.Input
+	} else if args.Data != nil {
+		msg.Data = *args.Data
+	}
+	return msg
+}
+
+func (api *ethAPI) Call(ctx context.Context, args callArgs, bnh *rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	var n *big.Int
+	if bnh != nil {
+		var err error
+		if n, err = api.blockNumber(*bnh); err != nil {
+			return nil, err
+		}
+	}
+	return api.d.CallContract(ctx, args.msg(), n)
+}
+
+func (api *ethAPI) EstimateGas(ctx context.Context, args callArgs) (hexutil.Uint64, error) {
+	gas, err := api.d.EstimateGas(ctx, args.msg())
+	return hexutil.Uint64(gas), err
+}
+
+func (api *ethAPI) SendRawTransaction(ctx context.Context, enc hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(enc); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), api.d.SendTransaction(ctx, tx)
+}
+
+// SendTransaction signs args with the key of a devnet account, and
+// sends it.
+func (api *ethAPI) SendTransaction(ctx context.Context, args callArgs) (common.Hash, error) {
+	if args.From == nil {
+		return common.Hash{}, errors.New("missing from address")
+	}
+	var key *Account
+	for i := range api.d.Accounts {
+		if api.d.Accounts[i].Addr == *args.From {
+			key = &api.d.Accounts[i]
+		}
+	}
+	if key == nil {
+		return common.Hash{}, fmt.Errorf("unknown account %v", args.From.Hex())
+	}
+
+	msg := args.msg()
+	if msg.Value == nil {
+		msg.Value = new(big.Int)
+	}
+	if msg.GasPrice == nil {
+		price, err := api.d.SuggestGasPrice(ctx)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		msg.GasPrice = price
+	}
+	if msg.Gas == 0 {
+		gas, err := api.d.EstimateGas(ctx, msg)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		msg.Gas = gas
+	}
+	var nonce uint64
+	if args.Nonce != nil {
+		nonce = uint64(*args.Nonce)
+	} else {
+		var err error
+		if nonce, err = api.d.PendingNonceAt(ctx, key.Addr); err != nil {
+			return common.Hash{}, err
+		}
+	}
+
+	tx, err := types.SignNewTx(key.Key, types.NewEIP155Signer(api.d.chainID), &types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: msg.GasPrice,
+		Gas:      msg.Gas,
+		To:       msg.To,
+		Value:    msg.Value,
+		Data:     msg.Data,
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), api.d.SendTransaction(ctx, tx)
+}
+
+// rpcTx is the JSON encoding of tx, with its location in the chain.
+func (api *ethAPI) rpcTx(tx *types.Transaction, blockHash common.Hash, blockNumber, index uint64) (map[string]interface{}, error) {
+	fields, err := toFields(tx)
+	if err != nil {
+		return nil, err
+	}
+	signer := types.MakeSigner(api.d.Blockchain().Config(), new(big.Int).SetUint64(blockNumber))
+	from, err := types.Sender(signer, tx)
+	if err != nil {
+		return nil, err
+	}
+	fields["from"] = from
+	fields["blockHash"] = blockHash
+	fields["blockNumber"] = hexutil.Uint64(blockNumber)
+	fields["transactionIndex"] = hexutil.Uint64(index)
+	return fields, nil
+}
+
+func (api *ethAPI) GetTransactionByHash(hash common.Hash) (map[string]interface{}, error) {
+	tx, blockHash, blockNumber, index := rawdb.ReadTransaction(api.d.db, hash)
+	if tx == nil {
+		return nil, nil
+	}
+	return api.rpcTx(tx, blockHash, blockNumber, index)
+}
+
+func (api *ethAPI) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	tx, blockHash, blockNumber, index := rawdb.ReadTransaction(api.d.db, hash)
+	if tx == nil {
+		return nil, nil
+	}
+	receipts := api.d.Blockchain().GetReceiptsByHash(blockHash)
+	if uint64(len(receipts)) <= index {
+		return nil, nil
+	}
+	fields, err := toFields(receipts[index])
+	if err != nil {
+		return nil, err
+	}
+	txFields, err := api.rpcTx(tx, blockHash, blockNumber, index)
+	if err != nil {
+		return nil, err
+	}
+	fields["from"] = txFields["from"]
+	fields["to"] = tx.To()
+	return fields, nil
+}
+
+func (api *ethAPI) rpcBlock(b *types.Block, fullTx bool) (map[string]interface{}, error) {
+	if b == nil {
+		return nil, nil
+	}
+	fields, err := toFields(b.Header())
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]interface{}, len(b.Transactions()))
+	for i, tx := range b.Transactions() {
+		if !fullTx {
+			txs[i] = tx.Hash()
+			continue
+		}
+		if txs[i], err = api.rpcTx(tx, b.Hash(), b.NumberU64(), uint64(i)); err != nil {
+			return nil, err
+		}
+	}
+	fields["transactions"] = txs
+	fields["uncles"] = []common.Hash{}
+	fields["size"] = hexutil.Uint64(b.Size())
+	fields["totalDifficulty"] = (*hexutil.Big)(api.d.Blockchain().GetTd(b.Hash(), b.NumberU64()))
+	return fields, nil
+}
+
+func (api *ethAPI) GetBlockByNumber(n rpc.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	num, err := api.number(n)
+	if err != nil {
+		return nil, nil
+	}
+	return api.rpcBlock(api.d.Blockchain().GetBlockByNumber(num.Uint64()), fullTx)
+}
+
+func (api *ethAPI) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	return api.rpcBlock(api.d.Blockchain().GetBlockByHash(hash), fullTx)
+}
+
+func (api *ethAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]types.Log, error) {
+	logs, err := api.d.FilterLogs(ctx, ethereum.FilterQuery(crit))
+	if logs == nil {
+		logs = []types.Log{}
+	}
+	return logs, err
+}
+
+// NewHeads notifies subscribers of each new block header.
+func (api *ethAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	heads := make(chan *types.Header)
+	sub, err := api.d.SubscribeNewHead(context.Background(), heads)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case h := <-heads:
+				notifier.Notify(rpcSub.ID, h)
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+// Logs notifies subscribers of each new log matching crit.
+func (api *ethAPI) Logs(ctx context.Context, crit filters.FilterCriteria) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	logs := make(chan types.Log)
+	sub, err := api.d.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery(crit), logs)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case l := <-logs:
+				notifier.Notify(rpcSub.ID, &l)
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+// toFields returns the JSON object encoding of v as a map, so fields
+// can be added to it.
+func toFields(v interface{}) (map[string]interface{}, error) {
+	enc, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	return fields, json.Unmarshal(enc, &fields)
+}
+
+// netAPI is the net namespace.
+type netAPI struct {
+	d *Devnet
+}
+
+func (api *netAPI) Version() string {
+	return api.d.chainID.String()
+}
+
+func (api *netAPI) Listening() bool {
+	return true
+}
+
+func (api *netAPI) PeerCount() hexutil.Uint {
+	return 0
+}
+
+// web3API is the web3 namespace.
+type web3API struct{}
+
+func (web3API) ClientVersion() string {
+	return ClientVersion
+}
+
+// debugAPI is the subset of the debug namespace used by debuggers.
+type debugAPI struct {
+	d *Devnet
+}
+
+// TraceTransaction re-executes a mined transaction with geth's struct
+// logger.
+func (api *debugAPI) TraceTransaction(hash common.Hash, cfg *vm.LogConfig) (*txdebug.TraceResult, error) {
+	logger := vm.NewStructLogger(cfg)
+	res, err := txdebug.Replay(api.d.Blockchain(), hash, logger)
+	if err != nil {
+		return nil, err
+	}
+	return txdebug.NewTraceResult(res, logger.StructLogs()), nil
+}
+
+// devnetAPI is the devnet namespace, which administers the devnet.
+type devnetAPI struct {
+	d *Devnet
+}
+
+// Info describes a devnet's deployment.
+type Info struct {
+	ChainID  *hexutil.Big   `json:"chainId"`
+	Oracle   common.Address `json:"oracle"`
+	USDX     common.Address `json:"usdx"`
+	Accounts []AccountInfo  `json:"accounts"`
+}
+
+// AccountInfo describes a named devnet account.
+type AccountInfo struct {
+	Name    string         `json:"name"`
+	Address common.Address `json:"address"`
+}
+
+// Info returns the devnet's contract and account addresses.
+func (api *devnetAPI) Info() Info {
+	info := Info{
+		ChainID: (*hexutil.Big)(api.d.ChainID()),
+		Oracle:  api.d.OracleAddr,
+		USDX:    api.d.USDXAddr,
+	}
+	for _, a := range api.d.Accounts {
+		info.Accounts = append(info.Accounts, AccountInfo{a.Name, a.Addr})
+	}
+	return info
+}
+
+// SetPrice sets the oracle's eth/usd price to the decimal usd price,
+// and returns the oracle answer.
+func (api *devnetAPI) SetPrice(price string) (*hexutil.Big, error) {
+	p, err := ParsePrice(price)
+	if err != nil {
+		return nil, err
+	}
+	if err := api.d.SetPrice(p); err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(p), nil
+}
+
+// maxMine is the most blocks devnet_mine mines at once.  Mining holds
+// the devnet's lock, so one request can't stall every other for long.
+const maxMine = 1000
+
+// Mine mines blocks empty blocks, 1 if omitted, and returns the new
+// block number.
+func (api *devnetAPI) Mine(blocks *uint64) (hexutil.Uint64, error) {
+	n := uint64(1)
+	if blocks != nil {
+		n = *blocks
+	}
+	if n > maxMine {
+		return 0, fmt.Errorf("can't mine more than %d blocks at once", maxMine)
+	}
+	return hexutil.Uint64(api.d.Mine(n).Number.Uint64()), nil
+}
+
+// IncreaseTime mines a block seconds later than it would otherwise
+// be, and returns its timestamp.
+func (api *devnetAPI) IncreaseTime(seconds uint64) (hexutil.Uint64, error) {
+	head, err := api.d.IncreaseTime(time.Duration(seconds) * time.Second)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(head.Time), nil
+}
@@ -0,0 +1,233 @@
+// Package gasreport records the gas used by contract methods in
+// simulated chain receipts, and compares reports against a golden
+// report to catch gas regressions.
+//
+// Reports are stored as text, one entry per line:
+//
+//	<method>  <scenario>  <gas>
+//
+// Lines starting with # are comments.
+package gasreport
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Entry is the gas used by a method in a scenario.
+type Entry struct {
+	Method   string
+	Scenario string
+	Gas      uint64
+}
+
+type key struct {
+	method, scenario string
+}
+
+// Report is a set of gas entries, keyed by method and scenario.  It
+// is safe for concurrent use.
+type Report struct {
+	mu      sync.Mutex
+	entries map[key]uint64
+}
+
+// New returns an empty report.
+func New() *Report {
+	return &Report{entries: make(map[key]uint64)}
+}
+
+// Record sets the gas used by method in scenario.
+func (r *Report) Record(method, scenario string, gas uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[key{method, scenario}] = gas
+}
+
+// RecordTx records the gas used by tx's receipt.  tx must already be
+// mined.
+func (r *Report) RecordTx(b bind.DeployBackend, method, scenario string, tx *types.Transaction) error {
+	receipt, err := b.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		return fmt.Errorf("receipt for %s/%s: %w", method, scenario, err)
+	}
+	r.Record(method, scenario, receipt.GasUsed)
+	return nil
+}
+
+// Gas returns the gas used by method in scenario, and whether it has
+// been recorded.
+func (r *Report) Gas(method, scenario string) (uint64, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	gas, ok := r.entries[key{method, scenario}]
+	return gas, ok
+}
+
+// Entries returns all entries, sorted by method then scenario.
+func (r *Report) Entries() []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]Entry, 0, len(r.entries))
+	for k, gas := range r.entries {
+		out = append(out, Entry{k.method, k.scenario, gas})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Method != out[j].Method {
+			return out[i].Method < out[j].Method
+		}
+		return out[i].Scenario < out[j].Scenario
+	})
+	return out
+}
+
+// WriteTo writes the report in text form to w.
+func (r *Report) WriteTo(w io.Writer) (int64, error) {
+	entries := r.Entries()
+	var (
+		methodW, scenarioW int
+		total              int64
+	)
+	for _, e := range entries {
+		if len(e.Method) > methodW {
+			methodW = len(e.Method)
+		}
+		if len(e.Scenario) > scenarioW {
+			scenarioW = len(e.Scenario)
+		}
+	}
+	for _, e := range entries {
+		n, err := fmt.Fprintf(w, "%-*s  %-*s  %d\n", methodW, e.Method, scenarioW, e.Scenario, e.Gas)
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// Parse reads a text report.
+func Parse(rd io.Reader) (*Report, error) {
+	r := New()
+	s := bufio.NewScanner(rd)
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("line %d: want 3 fields, got %d", line, len(fields))
+		}
+		gas, err := strconv.ParseUint(fields[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		r.Record(fields[0], fields[1], gas)
+	}
+	return r, s.Err()
+}
+
+// ReadFile reads a text report from path.
+func ReadFile(path string) (*Report, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// WriteFile writes the report to path, preceded by header as a
+// comment.
+func (r *Report) WriteFile(path, header string) error {
+	var b strings.Builder
+	for _, line := range strings.Split(header, "\n") {
+		if line != "" {
+			fmt.Fprintf(&b, "# %s\n", line)
+		}
+	}
+	r.WriteTo(&b)
+	return ioutil.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// Change is an entry whose gas differs from the golden report.
+type Change struct {
+	Entry
+	Golden uint64
+}
+
+// Ratio returns the relative change in gas from golden.
+func (c Change) Ratio() float64 {
+	return (float64(c.Gas) - float64(c.Golden)) / float64(c.Golden)
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s/%s: %d -> %d (%+.2f%%)", c.Method, c.Scenario, c.Golden, c.Gas, c.Ratio()*100)
+}
+
+// Diff compares r against golden.  Entries whose gas increased by more
+// than tolerance (a fraction, ie: 0.01 is 1%) are regressions, and
+// entries whose gas decreased by more than tolerance are improvements.
+// Entries in r which aren't in golden are returned as added.
+func (r *Report) Diff(golden *Report, tolerance float64) (regressions, improvements []Change, added []Entry) {
+	for _, e := range r.Entries() {
+		g, ok := golden.Gas(e.Method, e.Scenario)
+		if !ok {
+			added = append(added, e)
+			continue
+		}
+		c := Change{e, g}
+		switch ratio := c.Ratio(); {
+		case g == 0 && e.Gas > 0, ratio > tolerance:
+			regressions = append(regressions, c)
+		case ratio < -tolerance:
+			improvements = append(improvements, c)
+		}
+	}
+	return regressions, improvements, added
+}
+
+// Check compares r against the golden report at path, failing t for
+// each regression beyond tolerance.  If update is true, r is written
+// to path instead; otherwise a missing golden report fails t, so a
+// report that was never committed can't pass unnoticed.
+func Check(t testing.TB, r *Report, path string, tolerance float64, update bool) {
+	t.Helper()
+	golden, err := ReadFile(path)
+	if update {
+		if err := r.WriteFile(path, "Gas used by method and scenario.  Generated by "+t.Name()+"."); err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("wrote gas report: %s", path)
+		return
+	} else if os.IsNotExist(err) {
+		t.Fatalf("no golden gas report %s; rerun with update to write it", path)
+		return
+	} else if err != nil {
+		t.Fatal(err)
+	}
+
+	regressions, improvements, added := r.Diff(golden, tolerance)
+	for _, c := range regressions {
+		t.Errorf("gas regression: %s", c)
+	}
+	for _, c := range improvements {
+		t.Logf("gas improvement: %s (consider updating %s)", c, path)
+	}
+	for _, e := range added {
+		t.Errorf("no golden gas for %s/%s (%d) in %s", e.Method, e.Scenario, e.Gas, path)
+	}
+}
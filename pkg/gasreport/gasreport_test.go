@@ -0,0 +1,132 @@
+package gasreport
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	r := New()
+	r.Record("unlock", "partial", 40000)
+	r.Record("unlock", "full", 30000)
+	r.Record("collectAppreciation", "limit", 50000)
+
+	var buf bytes.Buffer
+	if _, err := r.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "collectAppreciation  limit    50000\n" +
+		"unlock               full     30000\n" +
+		"unlock               partial  40000\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("want report:\n%s\ngot:\n%s", want, got)
+	}
+
+	parsed, err := Parse(strings.NewReader("# comment\n\n" + buf.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gas, ok := parsed.Gas("unlock", "partial"); !ok || gas != 40000 {
+		t.Fatalf("want unlock/partial gas: %d, got: %d (ok=%t)", 40000, gas, ok)
+	}
+	if got := len(parsed.Entries()); got != 3 {
+		t.Fatalf("want %d entries, got: %d", 3, got)
+	}
+}
+
+func TestParseErr(t *testing.T) {
+	for _, in := range []string{"unlock full", "unlock full lots"} {
+		if _, err := Parse(strings.NewReader(in)); err == nil {
+			t.Errorf("want err parsing %q", in)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	golden := New()
+	golden.Record("m", "same", 1000)
+	golden.Record("m", "withinTolerance", 1000)
+	golden.Record("m", "regressed", 1000)
+	golden.Record("m", "improved", 1000)
+	golden.Record("m", "removed", 1000)
+
+	r := New()
+	r.Record("m", "same", 1000)
+	r.Record("m", "withinTolerance", 1009)
+	r.Record("m", "regressed", 1011)
+	r.Record("m", "improved", 900)
+	r.Record("m", "added", 1000)
+
+	regressions, improvements, added := r.Diff(golden, 0.01)
+	if len(regressions) != 1 || regressions[0].Scenario != "regressed" || regressions[0].Golden != 1000 {
+		t.Errorf("unexpected regressions: %v", regressions)
+	}
+	if len(improvements) != 1 || improvements[0].Scenario != "improved" {
+		t.Errorf("unexpected improvements: %v", improvements)
+	}
+	if len(added) != 1 || added[0].Scenario != "added" {
+		t.Errorf("unexpected added: %v", added)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gasreport")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "gas.golden")
+
+	r := New()
+	r.Record("unlock", "full", 30000)
+
+	// missing golden report fails, unless updating
+	ft := &fakeT{TB: t}
+	if Check(ft, r, path, 0, false); !ft.failed {
+		t.Fatal("want missing golden report to fail")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("want no golden report written, got: %v", err)
+	}
+	Check(t, r, path, 0, true)
+	if golden, err := ReadFile(path); err != nil {
+		t.Fatal(err)
+	} else if gas, _ := golden.Gas("unlock", "full"); gas != 30000 {
+		t.Fatalf("want golden gas: %d, got: %d", 30000, gas)
+	}
+
+	// regressions beyond tolerance fail
+	r.Record("unlock", "full", 40000)
+	ft = &fakeT{TB: t}
+	if Check(ft, r, path, 0.1, false); !ft.failed {
+		t.Fatal("want regression to fail")
+	}
+	ft = &fakeT{TB: t}
+	if Check(ft, r, path, 0.5, false); ft.failed {
+		t.Fatal("want regression within tolerance to pass")
+	}
+
+	// update overwrites golden report
+	Check(t, r, path, 0, true)
+	if golden, err := ReadFile(path); err != nil {
+		t.Fatal(err)
+	} else if gas, _ := golden.Gas("unlock", "full"); gas != 40000 {
+		t.Fatalf("want golden gas: %d, got: %d", 40000, gas)
+	}
+}
+
+// fakeT records failures without failing the underlying test.
+type fakeT struct {
+	testing.TB
+	failed bool
+}
+
+func (f *fakeT) Errorf(format string, args ...interface{}) { f.failed = true }
+func (f *fakeT) Fatal(args ...interface{})                 { f.failed = true }
+func (f *fakeT) Fatalf(format string, args ...interface{}) { f.failed = true }
+func (f *fakeT) Logf(format string, args ...interface{})   {}
@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+// feedUnit is 1 in the price feed's 8 decimals.
+var feedUnit = big.NewInt(1e8)
+
+// Collector collects the appreciation of the accounts of a USDX
+// contract which have made it their collector.
+type Collector struct {
+	// Logf, if set, logs collections sent and skipped.
+	Logf func(format string, args ...interface{})
+	// Timeout is the number of blocks a collection may go unmined
+	// before it's resent.  NewCollector sets it to DefaultTimeout.
+	Timeout uint64
+
+	backend  Backend
+	contract common.Address
+	usdx     *usdx.USDX
+	abi      abi.ABI
+	auth     *bind.TransactOpts
+
+	next       uint64                       // next block to read events from
+	thresholds map[common.Address]*big.Int  // of enrolled accounts
+	pending    map[common.Address]pendingTx // unmined collections, by account
+}
+
+// NewCollector returns a collector for the USDX contract at contract,
+// which sends collections from auth.  Accounts enroll by setting auth's
+// address as their collector; enrollments are read from the contract's
+// events from the genesis block.
+func NewCollector(backend Backend, contract common.Address, auth *bind.TransactOpts) (*Collector, error) {
+	c, err := usdx.NewUSDX(contract, backend)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := abi.JSON(strings.NewReader(usdx.USDXABI))
+	if err != nil {
+		return nil, err
+	}
+	return &Collector{
+		Timeout:    DefaultTimeout,
+		backend:    backend,
+		contract:   contract,
+		usdx:       c,
+		abi:        parsed,
+		auth:       auth,
+		thresholds: make(map[common.Address]*big.Int),
+		pending:    make(map[common.Address]pendingTx),
+	}, nil
+}
+
+// Enrolled returns the enrolled accounts, sorted.
+func (c *Collector) Enrolled() []common.Address {
+	addrs := make([]common.Address, 0, len(c.thresholds))
+	for addr := range c.thresholds {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	return addrs
+}
+
+// Step reads enrollments up to the latest block, and sends collections
+// of the enrolled accounts whose appreciation, less the gas of
+// collecting it at the latest rate, is at least their threshold.  It
+// returns the collections sent, which is none if no block has been
+// mined since the last step, the price feed is stale, or collecting is
+// paused, and the collections resent for going unmined.
+func (c *Collector) Step(ctx context.Context) ([]*types.Transaction, error) {
+	header, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	head := header.Number.Uint64()
+	if head < c.next {
+		return nil, nil
+	}
+	if err := c.readEvents(ctx, head); err != nil {
+		return nil, err
+	}
+	c.next = head + 1
+	sent, err := c.checkPending(ctx, head)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	if paused, err := c.usdx.Paused(opts); err != nil {
+		return nil, fmt.Errorf("reading paused: %v", err)
+	} else if paused {
+		c.logf("block %d: collecting is paused", head)
+		return sent, nil
+	}
+	xrate, err := usdx.Rate(opts, c.backend, c.contract)
+	if errors.Is(err, usdx.ErrStalePrice) {
+		c.logf("block %d: %v", head, err)
+		return sent, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	gasPrice := c.auth.GasPrice
+	if gasPrice == nil {
+		if gasPrice, err = c.backend.SuggestGasPrice(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, owner := range c.Enrolled() {
+		if _, ok := c.pending[owner]; ok {
+			continue
+		}
+		threshold := c.thresholds[owner]
+		appr, err := c.usdx.Appreciation(opts, owner)
+		if err != nil {
+			return nil, fmt.Errorf("reading appreciation of %s: %v", owner.Hex(), err)
+		}
+		if appr.Sign() == 0 || appr.Cmp(threshold) < 0 {
+			continue
+		}
+
+		input, err := c.abi.Pack("collectAppreciationFor", owner, new(big.Int))
+		if err != nil {
+			return nil, err
+		}
+		gas, err := c.backend.EstimateGas(ctx, ethereum.CallMsg{From: c.auth.From, To: &c.contract, GasPrice: gasPrice, Data: input})
+		if err != nil {
+			c.logf("block %d: skipping %s: %v", head, owner.Hex(), err)
+			continue
+		}
+		// The gas's cost in usdx, at the rate collected at.
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(gas), gasPrice)
+		cost.Mul(cost, xrate).Quo(cost, feedUnit)
+		if net := new(big.Int).Sub(appr, cost); net.Cmp(threshold) < 0 {
+			c.logf("block %d: skipping %s: appreciation %v less gas %v is under threshold %v", head, owner.Hex(), appr, cost, threshold)
+			continue
+		}
+
+		txOpts := *c.auth
+		txOpts.Context = ctx
+		txOpts.GasPrice = gasPrice
+		txOpts.GasLimit = gas
+		tx, err := c.usdx.CollectAppreciationFor(&txOpts, owner, new(big.Int))
+		if err != nil {
+			c.logf("block %d: skipping %s: %v", head, owner.Hex(), err)
+			continue
+		}
+		c.logf("block %d: collecting %v of %s in %s", head, appr, owner.Hex(), tx.Hash().Hex())
+		c.pending[owner] = pendingTx{tx, head}
+		sent = append(sent, tx)
+	}
+	return sent, nil
+}
+
+// Run steps c at every new block, polling for blocks every interval,
+// until ctx is done or a step fails.
+func (c *Collector) Run(ctx context.Context, interval time.Duration) error {
+	return run(ctx, interval, c.Step)
+}
+
+// readEvents updates the enrolled accounts from CollectorSet events
+// from c.next to head.  An account is enrolled while its latest
+// collector is c's.
+func (c *Collector) readEvents(ctx context.Context, head uint64) error {
+	set, err := c.usdx.FilterCollectorSet(&bind.FilterOpts{Start: c.next, End: &head, Context: ctx}, nil, nil)
+	if err != nil {
+		return fmt.Errorf("reading collectors: %v", err)
+	}
+	for set.Next() {
+		e := set.Event
+		if e.Collector == c.auth.From {
+			c.thresholds[e.Owner] = e.Threshold
+		} else {
+			delete(c.thresholds, e.Owner)
+		}
+	}
+	if err := set.Error(); err != nil {
+		return fmt.Errorf("reading collectors: %v", err)
+	}
+	return nil
+}
+
+// checkPending forgets collections which have been mined, or whose
+// accounts have unenrolled.  Collections unmined for c.Timeout blocks
+// are resent, in nonce order, and returned.
+func (c *Collector) checkPending(ctx context.Context, head uint64) ([]*types.Transaction, error) {
+	var due []common.Address
+	for owner, p := range c.pending {
+		if _, ok := c.thresholds[owner]; !ok {
+			delete(c.pending, owner)
+			continue
+		}
+		rcpt, err := receipt(ctx, c.backend, p.tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		if rcpt != nil {
+			if rcpt.Status != types.ReceiptStatusSuccessful {
+				c.logf("collection %s of %s failed", p.tx.Hash().Hex(), owner.Hex())
+			}
+			delete(c.pending, owner)
+			continue
+		}
+		if head-p.sent >= c.Timeout {
+			due = append(due, owner)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return c.pending[due[i]].tx.Nonce() < c.pending[due[j]].tx.Nonce() })
+
+	var resent []*types.Transaction
+	for _, owner := range due {
+		p := c.pending[owner]
+		tx, err := resend(ctx, c.backend, c.auth, p.tx)
+		if err != nil {
+			c.logf("block %d: unable to resend collection %s of %s: %v", head, p.tx.Hash().Hex(), owner.Hex(), err)
+			continue
+		}
+		if tx == nil {
+			c.logf("block %d: collection %s of %s was replaced", head, p.tx.Hash().Hex(), owner.Hex())
+			delete(c.pending, owner)
+			continue
+		}
+		c.logf("block %d: resending collection %s of %s in %s", head, p.tx.Hash().Hex(), owner.Hex(), tx.Hash().Hex())
+		c.pending[owner] = pendingTx{tx, head}
+		resent = append(resent, tx)
+	}
+	return resent, nil
+}
+
+func (c *Collector) logf(format string, args ...interface{}) {
+	if c.Logf != nil {
+		c.Logf(format, args...)
+	}
+}
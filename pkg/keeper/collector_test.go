@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/royalfork/usdx/pkg/usdxtest"
+)
+
+func TestCollector(t *testing.T) {
+	env := usdxtest.NewEnv(t)
+	env.SetPrice(big.NewInt(2000e8))
+	ctx := context.Background()
+
+	// At 5000gwei, collections cost about half an eth of gas, so about
+	// 1000usdx at 2000usd/eth.
+	auth := *env.Accounts[9].Auth
+	auth.GasPrice = big.NewInt(5e12)
+	c, err := NewCollector(env.Chain, env.USDXAddr, &auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Logf = t.Logf
+
+	enroll := func(acct usdxtest.Account, threshold int64) {
+		t.Helper()
+		collector := auth.From
+		if threshold < 0 {
+			collector = env.Accounts[8].Addr
+		}
+		if !env.Chain.Succeed(env.USDX.SetCollector(acct.Auth, collector, usdxtest.USDX(threshold))) {
+			t.Fatalf("unable to set collector of %s", acct.Addr.Hex())
+		}
+	}
+	// step steps c, mines its collections, checks they succeeded, and
+	// returns them.
+	step := func(wantSent int) []*types.Transaction {
+		t.Helper()
+		sent, err := c.Step(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sent) != wantSent {
+			t.Fatalf("want %d collections sent, got: %d", wantSent, len(sent))
+		}
+		env.Chain.Commit()
+		for _, tx := range sent {
+			rcpt, err := env.Chain.TransactionReceipt(ctx, tx.Hash())
+			if err != nil || rcpt.Status != types.ReceiptStatusSuccessful {
+				t.Fatalf("collection %s failed: %v", tx.Hash().Hex(), err)
+			}
+		}
+		return sent
+	}
+
+	eth10 := new(big.Int).Mul(big.NewInt(10), big.NewInt(1e18))
+	low, high, other, revoked := env.Accounts[1], env.Accounts[2], env.Accounts[3], env.Accounts[4]
+	for _, acct := range []usdxtest.Account{low, high, other, revoked} {
+		env.Mint(acct, eth10) // 20000usdx each
+	}
+	enroll(low, 100)
+	enroll(high, 5000)
+	enroll(other, -1) // another collector's
+	enroll(revoked, 100)
+	if !env.Chain.Succeed(env.USDX.SetCollector(revoked.Auth, common.Address{}, new(big.Int))) {
+		t.Fatal("unable to revoke collector")
+	}
+
+	step(0)
+	if got := c.Enrolled(); len(got) != 2 {
+		t.Fatalf("want 2 enrolled accounts, got: %d", len(got))
+	}
+
+	// 200usdx of appreciation is over low's threshold, but not net of
+	// gas.
+	env.SetPrice(big.NewInt(2020e8))
+	step(0)
+	env.AssertAccount(low.Addr, eth10, usdxtest.USDX(20000))
+
+	// 4000usdx of appreciation is over low's threshold net of gas,
+	// but not high's.
+	env.SetPrice(big.NewInt(2400e8))
+	step(1)
+	env.AssertAccount(low.Addr, eth10, usdxtest.USDX(24000))
+	if bal := env.BalanceOf(low.Addr); bal.Cmp(usdxtest.USDX(24000)) != 0 {
+		t.Errorf("want low's appreciation minted to low, got balance: %v", bal)
+	}
+	if bal := env.BalanceOf(auth.From); bal.Sign() != 0 {
+		t.Errorf("want nothing minted to collector, got: %v", bal)
+	}
+
+	env.SetPrice(big.NewInt(3000e8))
+	step(2)
+	env.AssertAccount(low.Addr, eth10, usdxtest.USDX(30000))
+	env.AssertAccount(high.Addr, eth10, usdxtest.USDX(30000))
+	env.AssertAccount(other.Addr, eth10, usdxtest.USDX(20000))
+	env.AssertAccount(revoked.Addr, eth10, usdxtest.USDX(20000))
+
+	step(0) // no new block
+
+	// Collections which are never mined, here dropped by a rollback,
+	// are resent Timeout blocks later, at the same nonces.
+	c.Timeout = 2
+	env.SetPrice(big.NewInt(4000e8))
+	sent, err := c.Step(ctx)
+	if err != nil || len(sent) != 2 {
+		t.Fatalf("want 2 collections sent, got: %d, err: %v", len(sent), err)
+	}
+	env.Chain.Rollback()
+	env.Chain.Commit()
+	step(0)
+	nonces := map[uint64]bool{sent[0].Nonce(): true, sent[1].Nonce(): true}
+	for _, tx := range step(2) {
+		if !nonces[tx.Nonce()] || tx.GasPrice().Cmp(auth.GasPrice) <= 0 {
+			t.Errorf("want resent at nonce %v, above gas price %v, got: %d, %v", nonces, auth.GasPrice, tx.Nonce(), tx.GasPrice())
+		}
+	}
+	env.AssertAccount(low.Addr, eth10, usdxtest.USDX(40000))
+	env.AssertAccount(high.Addr, eth10, usdxtest.USDX(40000))
+}
@@ -0,0 +1,301 @@
+// Package keeper runs bots which act on USDX accounts' behalf.
+//
+// A Keeper follows the contract's OrderPlaced, OrderCancelled and
+// OrderExecuted events to track open orders, and at each new block
+// sends executeOrder for every order the latest rate triggers.
+// Executions are estimated before they're sent, so an order which would
+// revert, such as a stop-loss whose owner no longer holds usdx, isn't
+// sent; it's retried at later blocks while it remains triggered.  An
+// execution which isn't mined within Timeout blocks is resent at a
+// higher gas price.  Executing an order only spends the keeper's gas:
+// its proceeds always go to the order's owner.
+//
+// A Collector collects the appreciation of accounts which have made it
+// their collector, once an account's appreciation, less the gas of
+// collecting it, reaches the account's threshold.
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+// Backend sends transactions and reads events, such as
+// *ethclient.Client or a simulated backend.
+type Backend interface {
+	bind.ContractBackend
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+// DefaultTimeout is the number of blocks keepers and collectors wait
+// for a transaction to be mined before resending it.
+const DefaultTimeout = 20
+
+// pendingTx is a transaction, sent at block sent, which hasn't been
+// mined.
+type pendingTx struct {
+	tx   *types.Transaction
+	sent uint64
+}
+
+// Keeper executes the triggered orders of a USDX contract.
+type Keeper struct {
+	// Logf, if set, logs executions sent and skipped.
+	Logf func(format string, args ...interface{})
+	// Timeout is the number of blocks an execution may go unmined
+	// before it's resent.  New sets it to DefaultTimeout.
+	Timeout uint64
+
+	backend  Backend
+	contract common.Address
+	usdx     *usdx.USDX
+	auth     *bind.TransactOpts
+
+	next    uint64                 // next block to read events from
+	orders  map[uint64]*usdx.Order // open orders, by id
+	pending map[uint64]pendingTx   // unmined executions, by order id
+}
+
+// New returns a keeper of the USDX contract at contract, which sends
+// executions from auth.  Orders are read from the contract's events
+// from the genesis block.
+func New(backend Backend, contract common.Address, auth *bind.TransactOpts) (*Keeper, error) {
+	c, err := usdx.NewUSDX(contract, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Keeper{
+		Timeout:  DefaultTimeout,
+		backend:  backend,
+		contract: contract,
+		usdx:     c,
+		auth:     auth,
+		orders:   make(map[uint64]*usdx.Order),
+		pending:  make(map[uint64]pendingTx),
+	}, nil
+}
+
+// Orders returns the open orders, by id.
+func (k *Keeper) Orders() []*usdx.Order {
+	orders := make([]*usdx.Order, 0, len(k.orders))
+	for _, o := range k.orders {
+		orders = append(orders, o)
+	}
+	sort.Slice(orders, func(i, j int) bool { return orders[i].ID.Cmp(orders[j].ID) < 0 })
+	return orders
+}
+
+// Step reads orders placed, cancelled and executed up to the latest
+// block, and sends executions of the open orders the latest rate
+// triggers.  It returns the executions sent, which is none if no block
+// has been mined since the last step, or the price feed is stale, and
+// the executions resent for going unmined.
+func (k *Keeper) Step(ctx context.Context) ([]*types.Transaction, error) {
+	header, err := k.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	head := header.Number.Uint64()
+	if head < k.next {
+		return nil, nil
+	}
+	if err := k.readEvents(ctx, head); err != nil {
+		return nil, err
+	}
+	k.next = head + 1
+	sent, err := k.checkPending(ctx, head)
+	if err != nil {
+		return nil, err
+	}
+
+	xrate, err := usdx.Rate(&bind.CallOpts{Context: ctx}, k.backend, k.contract)
+	if errors.Is(err, usdx.ErrStalePrice) {
+		k.logf("block %d: %v", head, err)
+		return sent, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range k.Orders() {
+		id := o.ID.Uint64()
+		if _, ok := k.pending[id]; ok || !o.Triggered(xrate) {
+			continue
+		}
+		opts := *k.auth
+		opts.Context = ctx
+		tx, err := k.usdx.ExecuteOrder(&opts, o.ID)
+		if err != nil {
+			k.logf("block %d: skipping order %d of %s: %v", head, id, o.Owner.Hex(), err)
+			continue
+		}
+		k.logf("block %d: executing order %d of %s in %s", head, id, o.Owner.Hex(), tx.Hash().Hex())
+		k.pending[id] = pendingTx{tx, head}
+		sent = append(sent, tx)
+	}
+	return sent, nil
+}
+
+// Run steps k at every new block, polling for blocks every interval,
+// until ctx is done or a step fails.
+func (k *Keeper) Run(ctx context.Context, interval time.Duration) error {
+	return run(ctx, interval, k.Step)
+}
+
+// run calls step every interval until ctx is done or a step fails.
+func run(ctx context.Context, interval time.Duration, step func(context.Context) ([]*types.Transaction, error)) error {
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+	for {
+		if _, err := step(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-tick.C:
+		}
+	}
+}
+
+// readEvents updates the open orders from events from k.next to head.
+// Order ids aren't reused, so every order's placement is applied
+// before any cancellation or execution.
+func (k *Keeper) readEvents(ctx context.Context, head uint64) error {
+	opts := &bind.FilterOpts{Start: k.next, End: &head, Context: ctx}
+	placed, err := k.usdx.FilterOrderPlaced(opts, nil, nil)
+	if err != nil {
+		return fmt.Errorf("reading placed orders: %v", err)
+	}
+	for placed.Next() {
+		e := placed.Event
+		k.orders[e.Id.Uint64()] = &usdx.Order{ID: e.Id, Owner: e.Owner, Kind: e.Kind, Price: e.Price, Amount: e.Amount}
+	}
+	if err := placed.Error(); err != nil {
+		return fmt.Errorf("reading placed orders: %v", err)
+	}
+
+	cancelled, err := k.usdx.FilterOrderCancelled(opts, nil)
+	if err != nil {
+		return fmt.Errorf("reading cancelled orders: %v", err)
+	}
+	for cancelled.Next() {
+		delete(k.orders, cancelled.Event.Id.Uint64())
+	}
+	if err := cancelled.Error(); err != nil {
+		return fmt.Errorf("reading cancelled orders: %v", err)
+	}
+
+	executed, err := k.usdx.FilterOrderExecuted(opts, nil, nil)
+	if err != nil {
+		return fmt.Errorf("reading executed orders: %v", err)
+	}
+	for executed.Next() {
+		delete(k.orders, executed.Event.Id.Uint64())
+	}
+	if err := executed.Error(); err != nil {
+		return fmt.Errorf("reading executed orders: %v", err)
+	}
+	return nil
+}
+
+// checkPending forgets executions which have been mined, or whose
+// orders have closed, so orders whose executions failed are retried.
+// Executions unmined for k.Timeout blocks are resent, in nonce order,
+// and returned.
+func (k *Keeper) checkPending(ctx context.Context, head uint64) ([]*types.Transaction, error) {
+	var due []uint64
+	for id, p := range k.pending {
+		if _, ok := k.orders[id]; !ok {
+			delete(k.pending, id)
+			continue
+		}
+		rcpt, err := receipt(ctx, k.backend, p.tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		if rcpt != nil {
+			if rcpt.Status != types.ReceiptStatusSuccessful {
+				k.logf("execution %s of order %d failed", p.tx.Hash().Hex(), id)
+			}
+			delete(k.pending, id)
+			continue
+		}
+		if head-p.sent >= k.Timeout {
+			due = append(due, id)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return k.pending[due[i]].tx.Nonce() < k.pending[due[j]].tx.Nonce() })
+
+	var resent []*types.Transaction
+	for _, id := range due {
+		p := k.pending[id]
+		tx, err := resend(ctx, k.backend, k.auth, p.tx)
+		if err != nil {
+			k.logf("block %d: unable to resend execution %s of order %d: %v", head, p.tx.Hash().Hex(), id, err)
+			continue
+		}
+		if tx == nil {
+			k.logf("block %d: execution %s of order %d was replaced", head, p.tx.Hash().Hex(), id)
+			delete(k.pending, id)
+			continue
+		}
+		k.logf("block %d: resending execution %s of order %d in %s", head, p.tx.Hash().Hex(), id, tx.Hash().Hex())
+		k.pending[id] = pendingTx{tx, head}
+		resent = append(resent, tx)
+	}
+	return resent, nil
+}
+
+// resend sends a copy of tx, which hasn't been mined, with the same
+// nonce and a gas price over 10% higher, so nodes accept it in place of
+// tx whether tx is stuck in their pools or was dropped.  It returns nil
+// if another transaction of auth's has been mined at tx's nonce, so tx
+// never will be.
+func resend(ctx context.Context, backend Backend, auth *bind.TransactOpts, tx *types.Transaction) (*types.Transaction, error) {
+	nonce, err := backend.NonceAt(ctx, auth.From, nil)
+	if err != nil {
+		return nil, err
+	}
+	if nonce > tx.Nonce() {
+		return nil, nil
+	}
+	price := new(big.Int).Mul(tx.GasPrice(), big.NewInt(11))
+	price.Quo(price, big.NewInt(10)).Add(price, big.NewInt(1))
+	signed, err := auth.Signer(auth.From, types.NewTransaction(tx.Nonce(), *tx.To(), tx.Value(), tx.Gas(), price, tx.Data()))
+	if err != nil {
+		return nil, err
+	}
+	if err := backend.SendTransaction(ctx, signed); err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+// receipt returns the receipt of tx hash, or nil if it hasn't been
+// mined.
+func receipt(ctx context.Context, backend Backend, hash common.Hash) (*types.Receipt, error) {
+	rcpt, err := backend.TransactionReceipt(ctx, hash)
+	if err == ethereum.NotFound {
+		return nil, nil
+	}
+	return rcpt, err
+}
+
+func (k *Keeper) logf(format string, args ...interface{}) {
+	if k.Logf != nil {
+		k.Logf(format, args...)
+	}
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/royalfork/usdx/pkg/usdx"
+	"github.com/royalfork/usdx/pkg/usdxtest"
+)
+
+func TestKeeper(t *testing.T) {
+	env := usdxtest.NewEnv(t)
+	env.SetPrice(big.NewInt(2000e8))
+	eth := big.NewInt(1e18)
+	ctx := context.Background()
+
+	k, err := New(env.Chain, env.USDXAddr, env.Accounts[9].Auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.Logf = t.Logf
+
+	place := func(acct usdxtest.Account, kind uint8, price int64) {
+		t.Helper()
+		if !env.Chain.Succeed(env.USDX.PlaceOrder(acct.Auth, kind, big.NewInt(price), new(big.Int))) {
+			t.Fatalf("unable to place order of %s", acct.Addr.Hex())
+		}
+	}
+	// step steps k, mines its executions, checks they succeeded, and
+	// returns them.
+	step := func(wantSent int) []*types.Transaction {
+		t.Helper()
+		sent, err := k.Step(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sent) != wantSent {
+			t.Fatalf("want %d executions sent, got: %d", wantSent, len(sent))
+		}
+		env.Chain.Commit()
+		for _, tx := range sent {
+			rcpt, err := env.Chain.TransactionReceipt(ctx, tx.Hash())
+			if err != nil || rcpt.Status != types.ReceiptStatusSuccessful {
+				t.Fatalf("execution %s failed: %v", tx.Hash().Hex(), err)
+			}
+		}
+		return sent
+	}
+
+	stop, profit, empty, cancelled := env.Accounts[1], env.Accounts[2], env.Accounts[3], env.Accounts[4]
+	env.Mint(stop, eth)
+	env.Mint(profit, eth)
+	env.Mint(cancelled, eth)
+	place(stop, usdx.StopLoss, 1500e8)
+	place(profit, usdx.TakeProfit, 2500e8)
+	place(empty, usdx.StopLoss, 1800e8) // has nothing to unlock
+	place(cancelled, usdx.StopLoss, 1000e8)
+	if !env.Chain.Succeed(env.USDX.CancelOrder(cancelled.Auth, big.NewInt(4))) {
+		t.Fatal("unable to cancel order")
+	}
+
+	step(0)
+	if got := len(k.Orders()); got != 3 {
+		t.Fatalf("want 3 open orders, got: %d", got)
+	}
+	step(0) // no new block
+
+	env.SetPrice(big.NewInt(1600e8))
+	step(0) // empty's order is triggered, but can't execute
+
+	env.SetPrice(big.NewInt(1400e8))
+	step(1)
+	env.AssertAccount(stop.Addr, new(big.Int), new(big.Int))
+	if bal := env.BalanceOf(stop.Addr); bal.Sign() != 0 {
+		t.Errorf("want stop's usdx burned, got: %v", bal)
+	}
+	if w, err := env.USDX.Withdrawable(&bind.CallOpts{}, stop.Addr); err != nil || w.Cmp(eth) != 0 {
+		t.Errorf("want withdrawable: %v, got: %v, err: %v", eth, w, err)
+	}
+
+	env.SetPrice(big.NewInt(900e8))
+	step(0) // cancelled's order is gone, and stop's is executed
+	env.AssertAccount(cancelled.Addr, eth, usdxtest.USDX(2000))
+
+	env.SetPrice(big.NewInt(3000e8))
+	step(1)
+	env.AssertAccount(profit.Addr, eth, usdxtest.USDX(3000))
+	if bal := env.BalanceOf(profit.Addr); bal.Cmp(usdxtest.USDX(3000)) != 0 {
+		t.Errorf("want profit's appreciation collected, got balance: %v", bal)
+	}
+
+	env.SetPrice(big.NewInt(3500e8))
+	step(0)
+	if orders := k.Orders(); len(orders) != 1 || orders[0].Owner != empty.Addr {
+		t.Errorf("want only empty's order open, got: %v", orders)
+	}
+
+	// An execution which is never mined, here dropped by a rollback,
+	// is resent Timeout blocks later, at the same nonce.
+	k.Timeout = 2
+	dropped, replaced := env.Accounts[5], env.Accounts[6]
+	env.Mint(dropped, eth)
+	place(dropped, usdx.StopLoss, 4000e8)
+	sent, err := k.Step(ctx)
+	if err != nil || len(sent) != 1 {
+		t.Fatalf("want 1 execution sent, got: %d, err: %v", len(sent), err)
+	}
+	env.Chain.Rollback()
+	env.Chain.Commit()
+	step(0)
+	resent := step(1)
+	if resent[0].Nonce() != sent[0].Nonce() || resent[0].GasPrice().Cmp(sent[0].GasPrice()) <= 0 {
+		t.Errorf("want resent at nonce %d, above gas price %v, got: %d, %v", sent[0].Nonce(), sent[0].GasPrice(), resent[0].Nonce(), resent[0].GasPrice())
+	}
+	env.AssertAccount(dropped.Addr, new(big.Int), new(big.Int))
+
+	// If another transaction is mined at its nonce, it's forgotten,
+	// and the order is executed again.
+	env.Mint(replaced, eth)
+	place(replaced, usdx.StopLoss, 4000e8)
+	if sent, err = k.Step(ctx); err != nil || len(sent) != 1 {
+		t.Fatalf("want 1 execution sent, got: %d, err: %v", len(sent), err)
+	}
+	env.Chain.Rollback()
+	if !env.Chain.Succeed(env.USDX.SetLotSender(env.Accounts[9].Auth, replaced.Addr, true)) {
+		t.Fatal("unable to send replacement")
+	}
+	env.Chain.Commit()
+	if resent := step(1); resent[0].Nonce() == sent[0].Nonce() {
+		t.Errorf("want execution at a new nonce, got: %d", resent[0].Nonce())
+	}
+	env.AssertAccount(replaced.Addr, new(big.Int), new(big.Int))
+}
@@ -0,0 +1,370 @@
+// Package opendata generates public datasets of USDX's state and
+// activity over a block range, such as a day's blocks.
+//
+// A dataset contains every account at the range's last block, the
+// contract's totals and oracle rate, and per UTC day volumes derived
+// from the contract's logs.  Datasets are deterministic: generating the same
+// range of the same chain gives byte identical files, so published
+// datasets can be reproduced and checked.  Schema documents the files.
+package opendata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/royalfork/usdx/pkg/reserves"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+// SchemaVersion is the version of the datasets' schema.  It's
+// incremented whenever a file's fields change.
+const SchemaVersion = 4
+
+// DateFormat is the format of a Day's date.
+const DateFormat = "2006-01-02"
+
+var (
+	transferID = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// lotTransferredID is the topic of LotTransferred events, logged
+	// whenever a lot moves between accounts.
+	lotTransferredID = crypto.Keccak256Hash([]byte("LotTransferred(address,address,uint256,uint256,uint256)"))
+	// collectedID is the topic of Collected events, logged right
+	// after the Transfer of each mint which collects appreciation.
+	collectedID = crypto.Keccak256Hash([]byte("Collected(address,uint256)"))
+	// redeemedID is the topic of Redeemed events, the last of which
+	// is logged right before the Transfer of each redemption's burn.
+	redeemedID = crypto.Keccak256Hash([]byte("Redeemed(address,address,uint256,uint256,uint256,uint256)"))
+	// claimedID is the topic of Claimed events, logged right after
+	// the Transfer of each claim's burn.
+	claimedID = crypto.Keccak256Hash([]byte("Claimed(address,uint256,uint256)"))
+)
+
+// Backend reads chain state and logs at past blocks, such as
+// *ethclient.Client.  Calls must be supported at past blocks.
+type Backend interface {
+	reserves.Backend
+	bind.ContractCaller
+}
+
+// Amount is an integer amount in its token's smallest unit: wei for
+// eth, and 1e-18 usdx for usdx.  It's encoded as a decimal string,
+// since amounts don't fit in JSON numbers.
+type Amount big.Int
+
+func newAmount(x *big.Int) *Amount {
+	return (*Amount)(new(big.Int).Set(x))
+}
+
+// Int returns a as a big.Int.
+func (a *Amount) Int() *big.Int {
+	return (*big.Int)(a)
+}
+
+func (a *Amount) String() string {
+	return a.Int().String()
+}
+
+func (a *Amount) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *Amount) UnmarshalText(text []byte) error {
+	if _, ok := a.Int().SetString(string(text), 10); !ok {
+		return fmt.Errorf("invalid amount %q", text)
+	}
+	return nil
+}
+
+// Summary is the contract's state at a dataset's last block.
+type Summary struct {
+	TotalSupply *Amount        `json:"totalSupply"` // usdx
+	Balance     *Amount        `json:"balance"`     // eth held by the contract
+	Locked      *Amount        `json:"locked"`      // eth locked by accounts
+	Settled     *Amount        `json:"settled"`     // usdx of lots settled by shutdown, until claimed
+	Pool        *Amount        `json:"pool"`        // eth settled for usdx holders
+	Accounts    int            `json:"accounts"`
+	PriceFeed   common.Address `json:"priceFeed"`
+	Rate        *Amount        `json:"rate"` // usd/eth, with 8 decimals
+	RateRound   *Amount        `json:"rateRound"`
+	RateUpdated uint64         `json:"rateUpdated"` // unix time
+}
+
+// Account is an account's position.
+type Account struct {
+	Address common.Address `json:"address"`
+	Locked  *Amount        `json:"locked"` // eth
+	Mint    *Amount        `json:"mint"`   // usdx
+}
+
+// Volume is a count of events, and the usdx they moved.  A lot's usdx
+// is its mint.
+type Volume struct {
+	Count int     `json:"count"`
+	USDX  *Amount `json:"usdx"`
+}
+
+func (v *Volume) add(amt *big.Int) {
+	v.Count++
+	v.USDX.Int().Add(v.USDX.Int(), amt)
+}
+
+// Day is a UTC day's activity within a dataset's range.
+type Day struct {
+	Date        string `json:"date"`
+	Mints       Volume `json:"mints"`
+	Unlocks     Volume `json:"unlocks"`
+	Redemptions Volume `json:"redemptions"`
+	Claims      Volume `json:"claims"` // of the settlement pool, after shutdown
+	Collects    Volume `json:"collects"`
+	Transfers   Volume `json:"transfers"`
+	// Lots moved between accounts, by transferring their position
+	// tokens or accounts.
+	LotTransfers Volume `json:"lotTransfers"`
+}
+
+// Dataset is USDX's state and activity over a block range.
+type Dataset struct {
+	SchemaVersion int            `json:"schemaVersion"`
+	Contract      common.Address `json:"contract"`
+	FromBlock     uint64         `json:"fromBlock"`
+	ToBlock       uint64         `json:"toBlock"`
+	BlockHash     common.Hash    `json:"blockHash"` // of ToBlock
+	BlockTime     uint64         `json:"blockTime"` // of ToBlock, unix time
+	Summary       Summary        `json:"summary"`
+	Accounts      []Account      `json:"accounts"` // sorted by address
+	Days          []Day          `json:"days"`     // every day of the range, in order
+}
+
+// Generate generates the dataset of the USDX contract at contract, for
+// blocks from through to, inclusive.  Accounts are found as
+// reserves.Take finds them, with extra accounts included regardless.
+func Generate(ctx context.Context, backend Backend, contract common.Address, from, to uint64, extra []common.Address) (*Dataset, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid block range %d-%d", from, to)
+	}
+	first, err := header(ctx, backend, from)
+	if err != nil {
+		return nil, err
+	}
+	last, err := header(ctx, backend, to)
+	if err != nil {
+		return nil, err
+	}
+	report, err := reserves.Take(ctx, backend, contract, last.Number, extra)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := &Dataset{
+		SchemaVersion: SchemaVersion,
+		Contract:      contract,
+		FromBlock:     from,
+		ToBlock:       to,
+		BlockHash:     last.Hash(),
+		BlockTime:     last.Time,
+		Accounts:      []Account{},
+	}
+	locked := new(big.Int)
+	for _, p := range report.Proofs {
+		ds.Accounts = append(ds.Accounts, Account{p.Address, newAmount(p.Locked.ToInt()), newAmount(p.Mint.ToInt())})
+		locked.Add(locked, p.Locked.ToInt())
+	}
+	ds.Summary = Summary{
+		TotalSupply: newAmount(report.TotalSupply.ToInt()),
+		Balance:     newAmount(report.Balance.ToInt()),
+		Locked:      (*Amount)(locked),
+		Settled:     newAmount(report.Settled.ToInt()),
+		Pool:        newAmount(report.Pool.ToInt()),
+		Accounts:    len(ds.Accounts),
+	}
+	if err := ds.readRate(ctx, backend, last.Number); err != nil {
+		return nil, err
+	}
+
+	// Every day of the range has a row, even without activity.
+	days := make(map[string]*Day)
+	for t := day(first.Time); !t.After(day(last.Time)); t = t.AddDate(0, 0, 1) {
+		ds.Days = append(ds.Days, Day{
+			Date:         t.Format(DateFormat),
+			Mints:        Volume{USDX: new(Amount)},
+			Unlocks:      Volume{USDX: new(Amount)},
+			Redemptions:  Volume{USDX: new(Amount)},
+			Claims:       Volume{USDX: new(Amount)},
+			Collects:     Volume{USDX: new(Amount)},
+			Transfers:    Volume{USDX: new(Amount)},
+			LotTransfers: Volume{USDX: new(Amount)},
+		})
+	}
+	for i := range ds.Days {
+		days[ds.Days[i].Date] = &ds.Days[i]
+	}
+	if err := ds.readVolumes(ctx, backend, first.Number, last.Number, days); err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+func header(ctx context.Context, backend Backend, number uint64) (*types.Header, error) {
+	h, err := backend.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, err
+	}
+	if h == nil {
+		return nil, fmt.Errorf("block %d not found", number)
+	}
+	return h, nil
+}
+
+// day returns the start of the UTC day of unix time t.
+func day(t uint64) time.Time {
+	return time.Unix(int64(t), 0).UTC().Truncate(24 * time.Hour)
+}
+
+// readRate reads the oracle's latest round at block.
+func (ds *Dataset) readRate(ctx context.Context, backend Backend, block *big.Int) error {
+	opts := &bind.CallOpts{BlockNumber: block, Context: ctx}
+	caller, err := usdx.NewUSDXCaller(ds.Contract, backend)
+	if err != nil {
+		return err
+	}
+	if ds.Summary.PriceFeed, err = caller.UsdPriceFeed(opts); err != nil {
+		return fmt.Errorf("reading price feed: %v", err)
+	}
+	// MockOracle has the feed's latestRoundData.
+	feed, err := usdx.NewMockOracleCaller(ds.Summary.PriceFeed, backend)
+	if err != nil {
+		return err
+	}
+	round, err := feed.LatestRoundData(opts)
+	if err != nil {
+		return fmt.Errorf("reading oracle rate: %v", err)
+	}
+	ds.Summary.Rate = newAmount(round.Answer)
+	ds.Summary.RateRound = newAmount(round.RoundId)
+	ds.Summary.RateUpdated = round.UpdatedAt.Uint64()
+	return nil
+}
+
+// readVolumes adds the volumes of Transfer and LotTransferred logs in
+// blocks from through to to days.  Mints and collects both transfer
+// from the zero address; a mint is a collect if a Collected log follows
+// it.  Unlocks, redemptions and claims all transfer to the zero
+// address; a burn is a redemption if a Redeemed log precedes it, and a
+// claim if a Claimed log follows it.
+func (ds *Dataset) readVolumes(ctx context.Context, backend Backend, from, to *big.Int, days map[string]*Day) error {
+	logs, err := backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: from,
+		ToBlock:   to,
+		Addresses: []common.Address{ds.Contract},
+		Topics:    [][]common.Hash{{transferID, lotTransferredID, collectedID, redeemedID, claimedID}},
+	})
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+
+	times := make(map[uint64]uint64)
+	for i, l := range logs {
+		if (l.Topics[0] != transferID || len(l.Topics) != 3) && l.Topics[0] != lotTransferredID {
+			continue
+		}
+		t, ok := times[l.BlockNumber]
+		if !ok {
+			h, err := header(ctx, backend, l.BlockNumber)
+			if err != nil {
+				return err
+			}
+			t, times[l.BlockNumber] = h.Time, h.Time
+		}
+		d, ok := days[day(t).Format(DateFormat)]
+		if !ok {
+			return fmt.Errorf("log in block %d is outside the range's days", l.BlockNumber)
+		}
+
+		if l.Topics[0] == lotTransferredID {
+			d.LotTransfers.add(new(big.Int).SetBytes(l.Data[32:64]))
+			continue
+		}
+		// adjacent returns whether the log off from l is topic's.
+		adjacent := func(off int, topic common.Hash) bool {
+			j := i + off
+			return j >= 0 && j < len(logs) && logs[j].TxHash == l.TxHash && int(logs[j].Index) == int(l.Index)+off && logs[j].Topics[0] == topic
+		}
+		amt := new(big.Int).SetBytes(l.Data)
+		switch sender, recipient := common.BytesToAddress(l.Topics[1].Bytes()), common.BytesToAddress(l.Topics[2].Bytes()); {
+		case sender == common.Address{}:
+			if adjacent(1, collectedID) {
+				d.Collects.add(amt)
+			} else {
+				d.Mints.add(amt)
+			}
+		case recipient == common.Address{}:
+			switch {
+			case adjacent(-1, redeemedID):
+				d.Redemptions.add(amt)
+			case adjacent(1, claimedID):
+				d.Claims.add(amt)
+			default:
+				d.Unlocks.add(amt)
+			}
+		default:
+			d.Transfers.add(amt)
+		}
+	}
+	return nil
+}
+
+// BlockRange returns the blocks from through to, inclusive, mined on
+// the UTC day of date.  It returns an error if no block was mined on
+// the day, or the day isn't over at the latest block.
+func BlockRange(ctx context.Context, backend Backend, date time.Time) (from, to uint64, err error) {
+	start := uint64(day(uint64(date.Unix())).Unix())
+	end := start + 24*60*60
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	if head.Time < end {
+		return 0, 0, errors.New("day isn't over at the latest block")
+	}
+	// firstAt returns the first block with a time at or after t.
+	firstAt := func(t uint64) (uint64, error) {
+		var err error
+		n := sort.Search(int(head.Number.Uint64()+1), func(i int) bool {
+			if err != nil {
+				return true
+			}
+			var h *types.Header
+			if h, err = header(ctx, backend, uint64(i)); err != nil {
+				return true
+			}
+			return h.Time >= t
+		})
+		return uint64(n), err
+	}
+	if from, err = firstAt(start); err != nil {
+		return 0, 0, err
+	}
+	next, err := firstAt(end)
+	if err != nil {
+		return 0, 0, err
+	}
+	if next == from {
+		return 0, 0, fmt.Errorf("no blocks on %s", date.UTC().Format(DateFormat))
+	}
+	return from, next - 1, nil
+}
@@ -0,0 +1,356 @@
+package opendata
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/royalfork/usdx/pkg/devnet"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+func usdxAmt(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e18))
+}
+
+func TestGenerate(t *testing.T) {
+	d, err := devnet.New(devnet.DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	ctx := context.Background()
+	transactor := func(name string) *bind.TransactOpts {
+		auth, err := d.Transactor(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return auth
+	}
+	alice, bob := transactor("alice"), transactor("bob")
+	carol, _ := d.Account("carol")
+	send := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rcpt, err := d.TransactionReceipt(ctx, tx.Hash()); err != nil || rcpt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("tx failed: %v", err)
+		}
+	}
+	mint := func(auth *bind.TransactOpts) {
+		t.Helper()
+		auth.Value = big.NewInt(1e18)
+		send((&usdx.USDXRaw{Contract: d.USDX}).Transfer(auth))
+		auth.Value = nil
+	}
+	nextDay := func() {
+		t.Helper()
+		if _, err := d.IncreaseTime(24 * time.Hour); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Day 0: alice mints 2000 usdx.
+	mint(alice)
+	nextDay()
+	// Day 1: alice collects 1000 usdx of appreciation, bob mints 3000
+	// usdx, alice transfers 500 usdx to carol, and bob unlocks 1000.
+	if err := d.SetPrice(big.NewInt(3000e8)); err != nil {
+		t.Fatal(err)
+	}
+	send(d.USDX.CollectAppreciation(alice, new(big.Int)))
+	mint(bob)
+	send(d.USDX.Transfer(alice, carol.Addr, usdxAmt(500)))
+	send(d.USDX.Unlock(bob, usdxAmt(1000)))
+	nextDay()
+	// Day 2: bob transfers his account, with its 2000 usdx lot, to
+	// carol.
+	send(d.USDX.SetLotSender(transactor("carol"), bob.From, true))
+	send(d.USDX.TransferAcct(bob, carol.Addr))
+	d.Mine(1)
+	head := d.Blockchain().CurrentHeader()
+	day1 := time.Unix(int64(head.Time), 0).UTC().AddDate(0, 0, -1)
+
+	ds, err := Generate(ctx, d, d.USDXAddr, 0, head.Number.Uint64(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zero := Volume{0, new(Amount)}
+	vol := func(n int, amt int64) Volume { return Volume{n, (*Amount)(usdxAmt(amt))} }
+	wantDays := []Day{
+		{"1970-01-01", vol(1, 2000), zero, zero, zero, zero, zero, zero},
+		{"1970-01-02", vol(1, 3000), vol(1, 1000), zero, zero, vol(1, 1000), vol(1, 500), zero},
+		{"1970-01-03", zero, zero, zero, zero, zero, zero, vol(1, 2000)},
+	}
+	if !reflect.DeepEqual(ds.Days, wantDays) {
+		t.Errorf("want days:\n%+v\ngot:\n%+v", wantDays, ds.Days)
+	}
+	if want := usdxAmt(5000); ds.Summary.TotalSupply.Int().Cmp(want) != 0 {
+		t.Errorf("want total supply: %v, got: %v", want, ds.Summary.TotalSupply)
+	}
+	if ds.Summary.Rate.Int().Cmp(big.NewInt(3000e8)) != 0 || ds.Summary.PriceFeed != d.OracleAddr {
+		t.Errorf("unexpected rate: %+v", ds.Summary)
+	}
+	// carol holds bob's account, and bob has none.
+	if len(ds.Accounts) != 2 || ds.Summary.Accounts != 2 || ds.Accounts[0].Address != carol.Addr && ds.Accounts[1].Address != carol.Addr {
+		t.Errorf("want accounts of alice and carol, got: %+v", ds.Accounts)
+	}
+
+	t.Run("blockRange", func(t *testing.T) {
+		from, to, err := BlockRange(ctx, d, day1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for n, want := range map[uint64]string{from: "1970-01-02", to: "1970-01-02", from - 1: "1970-01-01", to + 1: "1970-01-03"} {
+			h, err := d.HeaderByNumber(ctx, new(big.Int).SetUint64(n))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := time.Unix(int64(h.Time), 0).UTC().Format(DateFormat); got != want {
+				t.Errorf("block %d: want day %s, got: %s", n, want, got)
+			}
+		}
+		if _, _, err := BlockRange(ctx, d, day1.AddDate(0, 0, 1)); err == nil {
+			t.Error("want error for a day which isn't over")
+		}
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		from, to, err := BlockRange(ctx, d, day1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gen := func() map[string][]byte {
+			ds, err := Generate(ctx, d, d.USDXAddr, from, to, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(ds.Days) != 1 || !reflect.DeepEqual(ds.Days[0], wantDays[1]) {
+				t.Fatalf("want day: %+v, got: %+v", wantDays[1], ds.Days)
+			}
+			files, err := ds.Files()
+			if err != nil {
+				t.Fatal(err)
+			}
+			return files
+		}
+		before := gen()
+		// Later activity doesn't change the range's dataset.
+		mint(transactor("carol"))
+		if err := d.SetPrice(big.NewInt(1000e8)); err != nil {
+			t.Fatal(err)
+		}
+		if after := gen(); !reflect.DeepEqual(before, after) {
+			t.Errorf("dataset changed:\n%s\n%s", before["SHA256SUMS"], after["SHA256SUMS"])
+		}
+	})
+
+	t.Run("write", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := ds.Write(dir); err != nil {
+			t.Fatal(err)
+		}
+		sums, err := ioutil.ReadFile(filepath.Join(dir, "SHA256SUMS"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, line := range strings.Split(strings.TrimSpace(string(sums)), "\n") {
+			var sum, name string
+			fmt.Sscanf(line, "%s %s", &sum, &name)
+			data, err := ioutil.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := fmt.Sprintf("%x", sha256.Sum256(data)); got != sum {
+				t.Errorf("%s: want sum %s, got: %s", name, sum, got)
+			}
+		}
+		days, err := ioutil.ReadFile(filepath.Join(dir, "days.csv"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "date,mints,mint_usdx,unlocks,unlock_usdx,redemptions,redemption_usdx,claims,claim_usdx,collects,collect_usdx,transfers,transfer_usdx,lot_transfers,lot_transfer_usdx\n" +
+			"1970-01-01,1,2000000000000000000000,0,0,0,0,0,0,0,0,0,0,0,0\n" +
+			"1970-01-02,1,3000000000000000000000,1,1000000000000000000000,0,0,0,0,1,1000000000000000000000,1,500000000000000000000,0,0\n" +
+			"1970-01-03,0,0,0,0,0,0,0,0,0,0,0,0,1,2000000000000000000000\n"
+		if !bytes.Equal(days, []byte(want)) {
+			t.Errorf("want days.csv:\n%s\ngot:\n%s", want, days)
+		}
+	})
+}
+
+// TestCollects checks that appreciation counts as a collect however
+// it's collected, and whoever sends the transaction.
+func TestCollects(t *testing.T) {
+	d, err := devnet.New(devnet.DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	ctx := context.Background()
+	alice, err := d.Transactor("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keeper, err := d.Transactor("bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	send := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rcpt, err := d.TransactionReceipt(ctx, tx.Hash()); err != nil || rcpt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("tx failed: %v", err)
+		}
+	}
+	alice.Value = big.NewInt(1e18)
+	send((&usdx.USDXRaw{Contract: d.USDX}).Transfer(alice)) // 2000 usdx
+	alice.Value = nil
+	acct, err := d.USDX.Accounts(&bind.CallOpts{}, alice.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each collects the 1000 usdx a 1000 usd/eth rise appreciates
+	// alice's 1 eth lot by.
+	collects := []func(){
+		func() { send(d.USDX.CollectAppreciation(alice, new(big.Int))) },
+		func() { send(d.USDX.CollectLotAppreciation(alice, acct.FirstLot, new(big.Int))) },
+		func() {
+			// alice's take-profit order, executed by a keeper.
+			send(d.USDX.PlaceOrder(alice, usdx.TakeProfit, big.NewInt(1e8), new(big.Int)))
+			id, err := d.USDX.LastOrderId(&bind.CallOpts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			send(d.USDX.ExecuteOrder(keeper, id))
+		},
+		func() {
+			// by alice's collector.
+			send(d.USDX.SetCollector(alice, keeper.From, new(big.Int)))
+			send(d.USDX.CollectAppreciationFor(keeper, alice.From, new(big.Int)))
+		},
+	}
+	for i, collect := range collects {
+		if err := d.SetPrice(big.NewInt(int64(3000+1000*i) * 1e8)); err != nil {
+			t.Fatal(err)
+		}
+		collect()
+	}
+
+	ds, err := Generate(ctx, d, d.USDXAddr, 0, d.Blockchain().CurrentHeader().Number.Uint64(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := int64(len(collects))
+	if len(ds.Days) != 1 {
+		t.Fatalf("want 1 day, got: %+v", ds.Days)
+	}
+	if got, want := ds.Days[0].Collects, (Volume{len(collects), (*Amount)(usdxAmt(1000 * n))}); !reflect.DeepEqual(got, want) {
+		t.Errorf("want collects: %+v, got: %+v", want, got)
+	}
+	if got, want := ds.Days[0].Mints, (Volume{1, (*Amount)(usdxAmt(2000))}); !reflect.DeepEqual(got, want) {
+		t.Errorf("want mints: %+v, got: %+v", want, got)
+	}
+}
+
+// TestBurns checks that unlocks, redemptions and claims, which all
+// burn usdx, are told apart, and that datasets span a shutdown.
+func TestBurns(t *testing.T) {
+	d, err := devnet.New(devnet.DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	ctx := context.Background()
+	opts := &bind.CallOpts{}
+	transactor := func(name string) *bind.TransactOpts {
+		auth, err := d.Transactor(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return auth
+	}
+	owner, alice, bob, carol := transactor("owner"), transactor("alice"), transactor("bob"), transactor("carol")
+	send := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rcpt, err := d.TransactionReceipt(ctx, tx.Hash()); err != nil || rcpt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("tx failed: %v", err)
+		}
+	}
+	mint := func(auth *bind.TransactOpts) {
+		t.Helper()
+		auth.Value = big.NewInt(1e18)
+		send((&usdx.USDXRaw{Contract: d.USDX}).Transfer(auth))
+		auth.Value = nil
+	}
+
+	// alice and bob mint 2000 usdx each, alice unlocks 500, and carol
+	// redeems 500 of alice's.
+	mint(alice)
+	mint(bob)
+	send(d.USDX.Unlock(alice, usdxAmt(500)))
+	send(d.USDX.Transfer(alice, carol.From, usdxAmt(500)))
+	q, err := usdx.QuoteRedeem(opts, d, d.USDXAddr, usdxAmt(500))
+	if err != nil {
+		t.Fatal(err)
+	}
+	send(d.USDX.Redeem(carol, q.USDX, q.IDs, q.ETH))
+	// Then USDX is shut down, and alice and bob claim all their usdx.
+	send(d.USDX.Shutdown(owner, big.NewInt(2000e8)))
+	s, err := usdx.SettlementAt(opts, d, d.USDXAddr, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ids := range s.Batches(10) {
+		send(d.USDX.SettleLots(owner, ids))
+	}
+	// Settled lots' usdx is no account's mint until it's claimed.
+	ds, err := Generate(ctx, d, d.USDXAddr, 0, d.Blockchain().CurrentHeader().Number.Uint64(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum := ds.Summary; sum.Accounts != 0 || sum.Settled.Int().Cmp(usdxAmt(3000)) != 0 || sum.TotalSupply.Int().Cmp(usdxAmt(3000)) != 0 || sum.Pool.Int().Sign() == 0 {
+		t.Errorf("want 3000 usdx settled, and no accounts, got: %+v", sum)
+	}
+	send(d.USDX.Claim(alice, new(big.Int)))
+	send(d.USDX.Claim(bob, new(big.Int)))
+
+	ds, err = Generate(ctx, d, d.USDXAddr, 0, d.Blockchain().CurrentHeader().Number.Uint64(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds.Days) != 1 {
+		t.Fatalf("want 1 day, got: %+v", ds.Days)
+	}
+	vol := func(n int, amt int64) Volume { return Volume{n, (*Amount)(usdxAmt(amt))} }
+	for _, v := range []struct {
+		name      string
+		got, want Volume
+	}{
+		{"mints", ds.Days[0].Mints, vol(2, 4000)},
+		{"unlocks", ds.Days[0].Unlocks, vol(1, 500)},
+		{"redemptions", ds.Days[0].Redemptions, vol(1, 500)},
+		{"claims", ds.Days[0].Claims, vol(2, 3000)},
+	} {
+		if !reflect.DeepEqual(v.got, v.want) {
+			t.Errorf("want %s: %+v, got: %+v", v.name, v.want, v.got)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package opendata
+
+// Schema documents a dataset's files.  It's written to each dataset as
+// README.md.
+const Schema = `# USDX open data, schema version 4
+
+A dataset describes the USDX contract over a range of blocks, usually
+the blocks of one UTC day.  State is read at the range's last block;
+activity is read from the Transfer, LotTransferred, Collected,
+Redeemed and Claimed logs of every block in the range.  Regenerating
+a range of the same chain gives identical files, whose sha256 sums
+are listed in SHA256SUMS.
+
+Amounts are integers in their token's smallest unit: wei (1e-18 eth)
+for eth, and 1e-18 usdx for usdx.  The oracle rate is usd per eth,
+with 8 decimals.  Times are unix seconds.  Addresses and hashes are
+0x prefixed hex.
+
+## summary.csv
+
+One row describing the contract at the range's last block.
+
+| column          | description                                      |
+|-----------------|--------------------------------------------------|
+| schema_version  | version of this schema                           |
+| contract        | USDX contract address                            |
+| from_block      | first block of the range                         |
+| to_block        | last block of the range                          |
+| block_hash      | hash of to_block                                 |
+| block_time      | time of to_block                                 |
+| total_supply    | usdx in circulation                              |
+| balance         | eth held by the contract: locked, withdrawable,  |
+|                 | or in the settlement pool                        |
+| locked          | eth locked by accounts                           |
+| settled         | usdx of lots settled since a shutdown, until     |
+|                 | claimed                                          |
+| settlement_pool | eth settled for usdx holders to claim            |
+| accounts        | number of accounts                               |
+| price_feed      | eth/usd oracle address                           |
+| rate            | oracle's latest answer                           |
+| rate_round      | oracle's latest round                            |
+| rate_updated    | time of the oracle's latest answer               |
+
+## accounts.csv
+
+One row per account with eth locked or usdx minted at the range's last
+block, sorted by address.
+
+| column  | description                                                  |
+|---------|--------------------------------------------------------------|
+| address | account holder                                               |
+| locked  | eth locked                                                   |
+| mint    | usdx minted against the locked eth, including collected      |
+|         | appreciation; the usdx which must be returned to unlock it   |
+
+The sum of mint, plus settled, is total_supply.
+
+## days.csv
+
+One row per UTC day of the range, in order, including days without
+activity.  Only the range's blocks are counted, so the first and last
+days may be partial.
+
+| column            | description                                        |
+|-------------------|----------------------------------------------------|
+| date              | UTC date, YYYY-MM-DD                               |
+| mints             | number of mints (eth sent to the contract)         |
+| mint_usdx         | usdx minted                                        |
+| unlocks           | number of unlocks                                  |
+| unlock_usdx       | usdx burned to unlock eth                          |
+| redemptions       | number of redemptions of usdx for lots' eth        |
+| redemption_usdx   | usdx burned by redemptions                         |
+| claims            | number of claims of settled eth, after shutdown    |
+| claim_usdx        | usdx burned by claims                              |
+| collects          | number of appreciation collections                 |
+| collect_usdx      | usdx minted from appreciation                      |
+| transfers         | number of usdx transfers between holders           |
+| transfer_usdx     | usdx transferred                                   |
+| lot_transfers     | number of lots moved between accounts, by          |
+|                   | transferring their position tokens or accounts     |
+| lot_transfer_usdx | usdx minted against the lots moved                 |
+
+Collections are told from mints by the Collected log the contract
+emits after each, so every way of collecting counts, whoever sent the
+transaction.  Likewise redemptions are told from unlocks by the
+Redeemed log emitted before each, and claims by the Claimed log
+emitted after each.  Unlocks include executed stop-loss orders.
+
+## dataset.json
+
+All of the above in one object:
+
+	{
+	  "schemaVersion": 4,
+	  "contract": "0x...",
+	  "fromBlock": 0, "toBlock": 0, "blockHash": "0x...", "blockTime": 0,
+	  "summary": {
+	    "totalSupply": "0", "balance": "0", "locked": "0",
+	    "settled": "0", "pool": "0", "accounts": 0,
+	    "priceFeed": "0x...", "rate": "0", "rateRound": "0", "rateUpdated": 0
+	  },
+	  "accounts": [{"address": "0x...", "locked": "0", "mint": "0"}],
+	  "days": [{
+	    "date": "YYYY-MM-DD",
+	    "mints": {"count": 0, "usdx": "0"},
+	    "unlocks": {"count": 0, "usdx": "0"},
+	    "redemptions": {"count": 0, "usdx": "0"},
+	    "claims": {"count": 0, "usdx": "0"},
+	    "collects": {"count": 0, "usdx": "0"},
+	    "transfers": {"count": 0, "usdx": "0"},
+	    "lotTransfers": {"count": 0, "usdx": "0"}
+	  }]
+	}
+
+Amounts are decimal strings, since they don't fit in JSON numbers.
+`
@@ -0,0 +1,97 @@
+package opendata
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// Files returns the dataset's files, keyed by name.  SHA256SUMS lists
+// the sha256 of every other file.
+func (ds *Dataset) Files() (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	enc, err := json.MarshalIndent(ds, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	files["dataset.json"] = append(enc, '\n')
+	files["README.md"] = []byte(Schema)
+
+	s := ds.Summary
+	if files["summary.csv"], err = writeCSV(
+		[]string{"schema_version", "contract", "from_block", "to_block", "block_hash", "block_time",
+			"total_supply", "balance", "locked", "settled", "settlement_pool", "accounts", "price_feed", "rate", "rate_round", "rate_updated"},
+		[][]string{{
+			strconv.Itoa(ds.SchemaVersion), ds.Contract.Hex(), formatUint(ds.FromBlock), formatUint(ds.ToBlock), ds.BlockHash.Hex(), formatUint(ds.BlockTime),
+			s.TotalSupply.String(), s.Balance.String(), s.Locked.String(), s.Settled.String(), s.Pool.String(), strconv.Itoa(s.Accounts), s.PriceFeed.Hex(), s.Rate.String(), s.RateRound.String(), formatUint(s.RateUpdated),
+		}},
+	); err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+	for _, a := range ds.Accounts {
+		rows = append(rows, []string{a.Address.Hex(), a.Locked.String(), a.Mint.String()})
+	}
+	if files["accounts.csv"], err = writeCSV([]string{"address", "locked", "mint"}, rows); err != nil {
+		return nil, err
+	}
+
+	rows = nil
+	for _, d := range ds.Days {
+		row := []string{d.Date}
+		for _, v := range []Volume{d.Mints, d.Unlocks, d.Redemptions, d.Claims, d.Collects, d.Transfers, d.LotTransfers} {
+			row = append(row, strconv.Itoa(v.Count), v.USDX.String())
+		}
+		rows = append(rows, row)
+	}
+	if files["days.csv"], err = writeCSV([]string{"date",
+		"mints", "mint_usdx", "unlocks", "unlock_usdx", "redemptions", "redemption_usdx", "claims", "claim_usdx", "collects", "collect_usdx", "transfers", "transfer_usdx",
+		"lot_transfers", "lot_transfer_usdx",
+	}, rows); err != nil {
+		return nil, err
+	}
+
+	var sums bytes.Buffer
+	for _, name := range []string{"README.md", "accounts.csv", "dataset.json", "days.csv", "summary.csv"} {
+		fmt.Fprintf(&sums, "%x  %s\n", sha256.Sum256(files[name]), name)
+	}
+	files["SHA256SUMS"] = sums.Bytes()
+	return files, nil
+}
+
+func formatUint(n uint64) string {
+	return strconv.FormatUint(n, 10)
+}
+
+func writeCSV(header []string, rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(header)
+	w.WriteAll(rows)
+	return buf.Bytes(), w.Error()
+}
+
+// Write writes the dataset's files to dir, which is created if it
+// doesn't exist.
+func (ds *Dataset) Write(dir string) error {
+	files, err := ds.Files()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
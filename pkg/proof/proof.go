@@ -0,0 +1,266 @@
+// Package proof verifies USDX positions with Merkle-Patricia proofs
+// (eth_getProof), so a position can be trusted given only a trusted
+// block's state root, rather than trusting the node serving it.
+//
+// A position is read from USDX's storage:
+//
+//	slot 0: mapping (address => uint256) _balances  (ERC20)
+//	slot 2: uint256 _totalSupply                    (ERC20)
+//	slot 7: mapping (address => account) accounts   (locked, mint)
+//
+// The slot of mapping[key] is keccak256(key . slot), and struct
+// fields occupy consecutive slots from there.
+package proof
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// Storage slots of USDX's variables.
+const (
+	BalancesSlot       = 0
+	TotalSupplySlot    = 2
+	AccountsSlot       = 7
+	SettlementPoolSlot = 19
+	SettledSupplySlot  = 21
+)
+
+// ErrMismatch is returned when a proof is valid, but proves a different
+// value than claimed.
+var ErrMismatch = errors.New("proven value doesn't match")
+
+// MappingSlot returns the storage slot of mapping[key], for a mapping
+// at slot.
+func MappingSlot(key common.Address, slot uint64) common.Hash {
+	return crypto.Keccak256Hash(key.Hash().Bytes(), common.BigToHash(new(big.Int).SetUint64(slot)).Bytes())
+}
+
+// BalanceSlot returns the storage slot of holder's usdx balance.
+func BalanceSlot(holder common.Address) common.Hash {
+	return MappingSlot(holder, BalancesSlot)
+}
+
+// AccountSlots returns the storage slots of holder's locked eth and
+// minted usdx.
+func AccountSlots(holder common.Address) (locked, mint common.Hash) {
+	locked = MappingSlot(holder, AccountsSlot)
+	mint = common.BigToHash(new(big.Int).Add(locked.Big(), big.NewInt(1)))
+	return locked, mint
+}
+
+// AccountResult is the result of eth_getProof.
+type AccountResult struct {
+	Address      common.Address  `json:"address"`
+	AccountProof []hexutil.Bytes `json:"accountProof"`
+	Balance      *hexutil.Big    `json:"balance"`
+	CodeHash     common.Hash     `json:"codeHash"`
+	Nonce        hexutil.Uint64  `json:"nonce"`
+	StorageHash  common.Hash     `json:"storageHash"`
+	StorageProof []StorageResult `json:"storageProof"`
+}
+
+// StorageResult is the proof of a storage slot's value.
+type StorageResult struct {
+	Key   string          `json:"key"`
+	Value *hexutil.Big    `json:"value"`
+	Proof []hexutil.Bytes `json:"proof"`
+}
+
+// NewAccountResult proves addr's account and storage slots keys in
+// statedb, as eth_getProof does.
+func NewAccountResult(statedb *state.StateDB, addr common.Address, keys []common.Hash) (*AccountResult, error) {
+	accountProof, err := statedb.GetProof(addr)
+	if err != nil {
+		return nil, err
+	}
+	res := &AccountResult{
+		Address:      addr,
+		AccountProof: toBytes(accountProof),
+		Balance:      (*hexutil.Big)(statedb.GetBalance(addr)),
+		CodeHash:     statedb.GetCodeHash(addr),
+		Nonce:        hexutil.Uint64(statedb.GetNonce(addr)),
+		StorageHash:  types.EmptyRootHash,
+	}
+	if tr := statedb.StorageTrie(addr); tr != nil {
+		res.StorageHash = tr.Hash()
+	}
+	if res.CodeHash == (common.Hash{}) {
+		// Accounts which don't exist have no code.
+		res.CodeHash = crypto.Keccak256Hash(nil)
+	}
+	for _, key := range keys {
+		proof, err := statedb.GetStorageProof(addr, key)
+		if err != nil {
+			return nil, err
+		}
+		res.StorageProof = append(res.StorageProof, StorageResult{
+			Key:   key.Hex(),
+			Value: (*hexutil.Big)(statedb.GetState(addr, key).Big()),
+			Proof: toBytes(proof),
+		})
+	}
+	return res, nil
+}
+
+func toBytes(proof [][]byte) []hexutil.Bytes {
+	out := make([]hexutil.Bytes, len(proof))
+	for i, node := range proof {
+		out[i] = node
+	}
+	return out
+}
+
+// GetProof calls eth_getProof for addr's account and storage slots
+// keys at block, or the latest block if block is nil.
+func GetProof(ctx context.Context, client *rpc.Client, addr common.Address, keys []common.Hash, block *big.Int) (*AccountResult, error) {
+	num := "latest"
+	if block != nil {
+		num = hexutil.EncodeBig(block)
+	}
+	hexKeys := make([]string, len(keys))
+	for i, k := range keys {
+		hexKeys[i] = k.Hex()
+	}
+	var res AccountResult
+	if err := client.CallContext(ctx, &res, "eth_getProof", addr, hexKeys, num); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// proofDB returns a database of a proof's nodes, keyed by hash.
+func proofDB(proof []hexutil.Bytes) *memorydb.Database {
+	db := memorydb.New()
+	for _, node := range proof {
+		db.Put(crypto.Keccak256(node), node)
+	}
+	return db
+}
+
+// VerifyAccount verifies res's account against a state root.  It
+// doesn't verify res's storage proofs.
+func VerifyAccount(root common.Hash, res *AccountResult) error {
+	val, err := trie.VerifyProof(root, crypto.Keccak256(res.Address.Bytes()), proofDB(res.AccountProof))
+	if err != nil {
+		return fmt.Errorf("account %s: %v", res.Address.Hex(), err)
+	}
+	want := state.Account{
+		Nonce:    uint64(res.Nonce),
+		Balance:  res.Balance.ToInt(),
+		Root:     res.StorageHash,
+		CodeHash: res.CodeHash.Bytes(),
+	}
+	if val == nil {
+		// The account doesn't exist, so must be empty.
+		if want.Nonce != 0 || want.Balance.Sign() != 0 || want.Root != types.EmptyRootHash || res.CodeHash != crypto.Keccak256Hash(nil) {
+			return fmt.Errorf("account %s: %w", res.Address.Hex(), ErrMismatch)
+		}
+		return nil
+	}
+	enc, err := rlp.EncodeToBytes(&want)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(val, enc) {
+		return fmt.Errorf("account %s: %w", res.Address.Hex(), ErrMismatch)
+	}
+	return nil
+}
+
+// VerifyStorage verifies a storage proof against an account's storage
+// root, and returns the proven value.
+func VerifyStorage(storageRoot common.Hash, sr StorageResult) (*big.Int, error) {
+	key := common.HexToHash(sr.Key)
+	val, err := trie.VerifyProof(storageRoot, crypto.Keccak256(key.Bytes()), proofDB(sr.Proof))
+	if err != nil {
+		return nil, fmt.Errorf("slot %s: %v", key.Hex(), err)
+	}
+	proven := new(big.Int)
+	if val != nil {
+		// Values are stored rlp encoded, with leading zeros trimmed.
+		var content []byte
+		if err := rlp.DecodeBytes(val, &content); err != nil {
+			return nil, fmt.Errorf("slot %s: %v", key.Hex(), err)
+		}
+		proven.SetBytes(content)
+	}
+	if sr.Value == nil || proven.Cmp(sr.Value.ToInt()) != 0 {
+		return nil, fmt.Errorf("slot %s: %w", key.Hex(), ErrMismatch)
+	}
+	return proven, nil
+}
+
+// Position is a holder's USDX position, proven against a state root.
+type Position struct {
+	Holder    common.Address
+	StateRoot common.Hash
+	CodeHash  common.Hash // of the USDX contract
+	Locked    *big.Int    // eth
+	Mint      *big.Int    // usdx
+	Balance   *big.Int    // usdx
+}
+
+// Verify fetches and verifies the proof of holder's position in the
+// USDX contract at contract, at block, which has the trusted state
+// root.  The returned position's CodeHash should be checked, to ensure
+// the contract is USDX.
+func Verify(ctx context.Context, client *rpc.Client, contract, holder common.Address, block *big.Int, root common.Hash) (*Position, error) {
+	locked, mint := AccountSlots(holder)
+	keys := []common.Hash{locked, mint, BalanceSlot(holder)}
+	res, err := GetProof(ctx, client, contract, keys, block)
+	if err != nil {
+		return nil, err
+	}
+	return VerifyPosition(root, contract, holder, res)
+}
+
+// VerifyPosition verifies res, the proof of holder's position in the
+// USDX contract at contract, against root.
+func VerifyPosition(root common.Hash, contract, holder common.Address, res *AccountResult) (*Position, error) {
+	if res.Address != contract {
+		return nil, fmt.Errorf("proof of %s, want %s", res.Address.Hex(), contract.Hex())
+	}
+	if err := VerifyAccount(root, res); err != nil {
+		return nil, err
+	}
+
+	locked, mint := AccountSlots(holder)
+	vals := make(map[common.Hash]*big.Int)
+	for _, sr := range res.StorageProof {
+		v, err := VerifyStorage(res.StorageHash, sr)
+		if err != nil {
+			return nil, err
+		}
+		vals[common.HexToHash(sr.Key)] = v
+	}
+	pos := &Position{Holder: holder, StateRoot: root, CodeHash: res.CodeHash}
+	for _, f := range []struct {
+		slot common.Hash
+		val  **big.Int
+	}{
+		{locked, &pos.Locked},
+		{mint, &pos.Mint},
+		{BalanceSlot(holder), &pos.Balance},
+	} {
+		v, ok := vals[f.slot]
+		if !ok {
+			return nil, fmt.Errorf("no proof of slot %s", f.slot.Hex())
+		}
+		*f.val = v
+	}
+	return pos, nil
+}
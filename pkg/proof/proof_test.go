@@ -0,0 +1,154 @@
+package proof
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/royalfork/soltest"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+func TestVerifyPosition(t *testing.T) {
+	chain, accts := soltest.New()
+	oracleAddr, _, oracle, err := usdx.DeployMockOracle(accts[0].Auth, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+	addr, _, contract, err := usdx.DeployUSDX(accts[0].Auth, chain, oracleAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+	zero := new(big.Int)
+	if !chain.Succeed(oracle.SetLastRound(accts[0].Auth, zero, big.NewInt(2000e8), zero, zero, zero)) {
+		t.Fatal("unable to set price")
+	}
+	before := chain.Blockchain().CurrentBlock().Root()
+
+	holder := accts[1]
+	holder.Auth.Value = big.NewInt(1e18)
+	if !chain.Succeed((&usdx.USDXRaw{Contract: contract}).Transfer(holder.Auth)) {
+		t.Fatal("unable to mint")
+	}
+	holder.Auth.Value = nil
+	if !chain.Succeed(contract.Transfer(holder.Auth, accts[2].Addr, big.NewInt(5e17))) {
+		t.Fatal("unable to transfer")
+	}
+	root := chain.Blockchain().CurrentBlock().Root()
+
+	acct, err := contract.Accounts(&bind.CallOpts{}, holder.Addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bal, err := contract.BalanceOf(&bind.CallOpts{}, holder.Addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Slots agree with the contract's getters.
+	ctx := context.Background()
+	locked, mint := AccountSlots(holder.Addr)
+	for _, s := range []struct {
+		slot common.Hash
+		want *big.Int
+	}{
+		{locked, acct.Locked},
+		{mint, acct.Mint},
+		{BalanceSlot(holder.Addr), bal},
+	} {
+		got, err := chain.StorageAt(ctx, addr, s.slot, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if new(big.Int).SetBytes(got).Cmp(s.want) != 0 {
+			t.Fatalf("slot %s: want %v, got: %x", s.slot.Hex(), s.want, got)
+		}
+	}
+
+	prove := func(t *testing.T, root common.Hash, holder common.Address) *AccountResult {
+		t.Helper()
+		statedb, err := chain.Blockchain().StateAt(root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		locked, mint := AccountSlots(holder)
+		res, err := NewAccountResult(statedb, addr, []common.Hash{locked, mint, BalanceSlot(holder)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		pos, err := VerifyPosition(root, addr, holder.Addr, prove(t, root, holder.Addr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pos.Locked.Cmp(acct.Locked) != 0 || pos.Mint.Cmp(acct.Mint) != 0 || pos.Balance.Cmp(bal) != 0 {
+			t.Fatalf("want position: %v %v %v, got: %+v", acct.Locked, acct.Mint, bal, pos)
+		}
+		code, err := chain.CodeAt(ctx, addr, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := crypto.Keccak256Hash(code); pos.CodeHash != want {
+			t.Fatalf("want code hash: %s, got: %s", want.Hex(), pos.CodeHash.Hex())
+		}
+	})
+
+	t.Run("absent", func(t *testing.T) {
+		// Slots never written are proven to be zero.
+		pos, err := VerifyPosition(root, addr, accts[3].Addr, prove(t, root, accts[3].Addr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pos.Locked.Sign() != 0 || pos.Mint.Sign() != 0 || pos.Balance.Sign() != 0 {
+			t.Fatalf("want empty position, got: %+v", pos)
+		}
+	})
+
+	t.Run("historical", func(t *testing.T) {
+		pos, err := VerifyPosition(before, addr, holder.Addr, prove(t, before, holder.Addr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pos.Mint.Sign() != 0 {
+			t.Fatalf("want no mint before minting, got: %+v", pos)
+		}
+	})
+
+	t.Run("wrongRoot", func(t *testing.T) {
+		if _, err := VerifyPosition(before, addr, holder.Addr, prove(t, root, holder.Addr)); err == nil {
+			t.Fatal("want error verifying against another block's root")
+		}
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		for name, tamper := range map[string]func(*AccountResult){
+			"value": func(res *AccountResult) {
+				res.StorageProof[0].Value = (*hexutil.Big)(new(big.Int).Add(acct.Locked, big.NewInt(1)))
+			},
+			"storageHash": func(res *AccountResult) {
+				res.StorageHash = common.Hash{1}
+			},
+			"balance": func(res *AccountResult) {
+				res.Balance = (*hexutil.Big)(big.NewInt(1))
+			},
+		} {
+			res := prove(t, root, holder.Addr)
+			tamper(res)
+			if _, err := VerifyPosition(root, addr, holder.Addr, res); err == nil {
+				t.Errorf("%s: want error", name)
+			} else if name != "storageHash" && !errors.Is(err, ErrMismatch) {
+				t.Errorf("%s: want err: %v, got: %v", name, ErrMismatch, err)
+			}
+		}
+	})
+}
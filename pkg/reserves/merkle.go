@@ -0,0 +1,101 @@
+package reserves
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Hashes are domain separated by a prefix byte, so a leaf can't be
+// passed off as an interior node, or either as a commitment.
+const (
+	leafPrefix   = 0x00
+	nodePrefix   = 0x01
+	commitPrefix = 0x02
+)
+
+var errProofLength = errors.New("wrong number of siblings")
+
+// leafHash is keccak256(0x00 . address . uint256 locked . uint256 mint).
+func leafHash(a *Account) common.Hash {
+	return crypto.Keccak256Hash(
+		[]byte{leafPrefix},
+		a.Address.Bytes(),
+		common.BigToHash(a.Locked.ToInt()).Bytes(),
+		common.BigToHash(a.Mint.ToInt()).Bytes(),
+	)
+}
+
+func nodeHash(left, right common.Hash) common.Hash {
+	return crypto.Keccak256Hash([]byte{nodePrefix}, left.Bytes(), right.Bytes())
+}
+
+// tree is a binary Merkle tree.  A level with an odd number of nodes
+// promotes its last node to the next level unhashed, rather than
+// pairing it with itself, so no two leaf sets share a root.
+type tree [][]common.Hash // levels, leaves first
+
+func newTree(leaves []common.Hash) tree {
+	t := tree{leaves}
+	for level := leaves; len(level) > 1; {
+		next := make([]common.Hash, 0, (len(level)+1)/2)
+		for i := 0; i+1 < len(level); i += 2 {
+			next = append(next, nodeHash(level[i], level[i+1]))
+		}
+		if len(level)%2 == 1 {
+			next = append(next, level[len(level)-1])
+		}
+		t = append(t, next)
+		level = next
+	}
+	return t
+}
+
+// root is the tree's root, or the zero hash for a tree without leaves.
+func (t tree) root() common.Hash {
+	top := t[len(t)-1]
+	if len(top) == 0 {
+		return common.Hash{}
+	}
+	return top[0]
+}
+
+// proof returns the siblings of leaf i, from the leaves up.
+func (t tree) proof(i int) []common.Hash {
+	var siblings []common.Hash
+	for _, level := range t[:len(t)-1] {
+		if j := i ^ 1; j < len(level) {
+			siblings = append(siblings, level[j])
+		}
+		i /= 2
+	}
+	return siblings
+}
+
+// proofRoot returns the root of a tree of n leaves whose leaf i is
+// leaf, with siblings.
+func proofRoot(leaf common.Hash, i, n uint64, siblings []common.Hash) (common.Hash, error) {
+	if i >= n {
+		return common.Hash{}, errors.New("index out of range")
+	}
+	h := leaf
+	for ; n > 1; n = (n + 1) / 2 {
+		if j := i ^ 1; j < n {
+			if len(siblings) == 0 {
+				return common.Hash{}, errProofLength
+			}
+			if i%2 == 0 {
+				h = nodeHash(h, siblings[0])
+			} else {
+				h = nodeHash(siblings[0], h)
+			}
+			siblings = siblings[1:]
+		}
+		i /= 2
+	}
+	if len(siblings) != 0 {
+		return common.Hash{}, errProofLength
+	}
+	return h, nil
+}
@@ -0,0 +1,350 @@
+// Package reserves publishes proof-of-reserves reports, so USDX
+// holders can verify the system's books.
+//
+// A report snapshots every account's locked eth and minted usdx at a
+// block, and commits to them in a Merkle tree.  The tree's root is
+// committed to together with the contract's total supply, eth balance,
+// and settlement state:
+//
+//	leaf = keccak256(0x00 . address . uint256 locked . uint256 mint)
+//	node = keccak256(0x01 . left . right)
+//	root = keccak256(0x02 . contract . uint64 block . blockHash .
+//	                 uint256 totalSupply . uint256 balance .
+//	                 uint256 settled . uint256 pool .
+//	                 uint64 accounts . accountsRoot)
+//
+// Leaves are sorted by address.  Once the root is published, a holder
+// needs only their inclusion proof to check their position is counted,
+// and anyone with the full report can check that accounts, and lots
+// settled since a shutdown, sum to the total supply, and that the
+// contract holds the eth they lock and the settlement pool.
+package reserves
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/royalfork/usdx/pkg/proof"
+)
+
+var (
+	// ErrIncomplete is returned when accounts' mints, and the
+	// settled supply, don't sum to the total supply, so accounts are
+	// missing from a snapshot.
+	ErrIncomplete = errors.New("accounts don't sum to total supply")
+	// ErrInvalidProof is returned when an inclusion proof doesn't
+	// prove an account is in a report.
+	ErrInvalidProof = errors.New("invalid inclusion proof")
+)
+
+var (
+	transferID = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// lotTransferredID is the topic of LotTransferred events, logged
+	// whenever a lot moves between accounts.
+	lotTransferredID = crypto.Keccak256Hash([]byte("LotTransferred(address,address,uint256,uint256,uint256)"))
+)
+
+// Backend reads chain state at past blocks, such as *ethclient.Client
+// or a simulated backend.
+type Backend interface {
+	ethereum.ChainStateReader
+	ethereum.LogFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Account is a USDX account's position.
+type Account struct {
+	Address common.Address `json:"address"`
+	Locked  *hexutil.Big   `json:"locked"` // eth
+	Mint    *hexutil.Big   `json:"mint"`   // usdx
+}
+
+// Commitment is what a report's root commits to.
+type Commitment struct {
+	Contract     common.Address `json:"contract"`
+	Block        uint64         `json:"block"`
+	BlockHash    common.Hash    `json:"blockHash"`
+	TotalSupply  *hexutil.Big   `json:"totalSupply"` // usdx
+	Balance      *hexutil.Big   `json:"balance"`     // eth held by the contract
+	Settled      *hexutil.Big   `json:"settled"`     // usdx of lots settled by shutdown, until claimed
+	Pool         *hexutil.Big   `json:"pool"`        // eth settled for usdx holders
+	Accounts     uint64         `json:"accounts"`
+	AccountsRoot common.Hash    `json:"accountsRoot"`
+}
+
+// Root returns the hash committing to c.
+func (c *Commitment) Root() common.Hash {
+	var block, accounts [8]byte
+	binary.BigEndian.PutUint64(block[:], c.Block)
+	binary.BigEndian.PutUint64(accounts[:], c.Accounts)
+	return crypto.Keccak256Hash(
+		[]byte{commitPrefix},
+		c.Contract.Bytes(),
+		block[:],
+		c.BlockHash.Bytes(),
+		common.BigToHash(c.TotalSupply.ToInt()).Bytes(),
+		common.BigToHash(c.Balance.ToInt()).Bytes(),
+		common.BigToHash(c.Settled.ToInt()).Bytes(),
+		common.BigToHash(c.Pool.ToInt()).Bytes(),
+		accounts[:],
+		c.AccountsRoot.Bytes(),
+	)
+}
+
+// VerifyProof verifies that p's account is in c.
+func (c *Commitment) VerifyProof(p *Proof) error {
+	if p.Locked == nil || p.Mint == nil {
+		return fmt.Errorf("%s: missing position", p.Address.Hex())
+	}
+	root, err := proofRoot(leafHash(&p.Account), p.Index, c.Accounts, p.Siblings)
+	if err != nil {
+		return fmt.Errorf("%s: %w: %v", p.Address.Hex(), ErrInvalidProof, err)
+	}
+	if root != c.AccountsRoot {
+		return fmt.Errorf("%s: %w", p.Address.Hex(), ErrInvalidProof)
+	}
+	return nil
+}
+
+// Proof is an account, and the proof of its inclusion in a report.
+type Proof struct {
+	Account
+	Index    uint64        `json:"index"`
+	Siblings []common.Hash `json:"siblings"`
+}
+
+// Report is a proof-of-reserves report.
+type Report struct {
+	Commitment
+	Root   common.Hash `json:"root"`
+	Proofs []Proof     `json:"proofs"` // sorted by address
+}
+
+// New returns the report of accounts, which needn't be sorted, at
+// block, with the settled supply and settlement pool.
+func New(contract common.Address, block *types.Header, totalSupply, balance, settled, pool *big.Int, accounts []Account) *Report {
+	accounts = append([]Account(nil), accounts...)
+	sort.Slice(accounts, func(i, j int) bool {
+		return bytes.Compare(accounts[i].Address[:], accounts[j].Address[:]) < 0
+	})
+	leaves := make([]common.Hash, len(accounts))
+	for i := range accounts {
+		leaves[i] = leafHash(&accounts[i])
+	}
+	t := newTree(leaves)
+
+	r := &Report{Commitment: Commitment{
+		Contract:     contract,
+		Block:        block.Number.Uint64(),
+		BlockHash:    block.Hash(),
+		TotalSupply:  (*hexutil.Big)(totalSupply),
+		Balance:      (*hexutil.Big)(balance),
+		Settled:      (*hexutil.Big)(settled),
+		Pool:         (*hexutil.Big)(pool),
+		Accounts:     uint64(len(accounts)),
+		AccountsRoot: t.root(),
+	}}
+	r.Root = r.Commitment.Root()
+	for i, a := range accounts {
+		r.Proofs = append(r.Proofs, Proof{a, uint64(i), t.proof(i)})
+	}
+	return r
+}
+
+// Proof returns addr's proof, if addr has an account in r.
+func (r *Report) Proof(addr common.Address) (*Proof, bool) {
+	i := sort.Search(len(r.Proofs), func(i int) bool {
+		return bytes.Compare(r.Proofs[i].Address[:], addr[:]) >= 0
+	})
+	if i == len(r.Proofs) || r.Proofs[i].Address != addr {
+		return nil, false
+	}
+	return &r.Proofs[i], true
+}
+
+// Verify checks that r is consistent: its root commits to its totals
+// and every account, accounts' mints and the settled supply sum to the
+// total supply, and the contract holds at least the eth accounts lock
+// and the settlement pool.  It doesn't check r against the chain; see
+// CheckChain.
+func (r *Report) Verify() error {
+	if r.TotalSupply == nil || r.Balance == nil || r.Settled == nil || r.Pool == nil {
+		return errors.New("missing totals")
+	}
+	if root := r.Commitment.Root(); root != r.Root {
+		return fmt.Errorf("root %s doesn't commit to report, want %s", r.Root.Hex(), root.Hex())
+	}
+	if uint64(len(r.Proofs)) != r.Accounts {
+		return fmt.Errorf("report has %d accounts, committed to %d", len(r.Proofs), r.Accounts)
+	}
+	locked, mint := new(big.Int), new(big.Int)
+	for i := range r.Proofs {
+		p := &r.Proofs[i]
+		if p.Index != uint64(i) {
+			return fmt.Errorf("%s: index %d, want %d", p.Address.Hex(), p.Index, i)
+		}
+		if i > 0 && bytes.Compare(r.Proofs[i-1].Address[:], p.Address[:]) >= 0 {
+			return fmt.Errorf("%s: accounts not sorted by address", p.Address.Hex())
+		}
+		if err := r.VerifyProof(p); err != nil {
+			return err
+		}
+		locked.Add(locked, p.Locked.ToInt())
+		mint.Add(mint, p.Mint.ToInt())
+	}
+	if mint.Add(mint, r.Settled.ToInt()).Cmp(r.TotalSupply.ToInt()) != 0 {
+		return fmt.Errorf("%w: mints and settled supply sum to %v, total supply is %v", ErrIncomplete, mint, r.TotalSupply.ToInt())
+	}
+	if locked.Add(locked, r.Pool.ToInt()).Cmp(r.Balance.ToInt()) > 0 {
+		return fmt.Errorf("accounts lock, and the settlement pool holds, %v wei, contract holds %v", locked, r.Balance.ToInt())
+	}
+	return nil
+}
+
+// Take snapshots every account of the USDX contract at contract, at
+// block, or the latest block if block is nil.  Accounts are found from
+// the Transfer events of mints, and the LotTransferred events of lots
+// moved between accounts, so every account with a lot is found; extra
+// accounts may be passed to include them regardless.  ErrIncomplete is
+// returned if any account is missing.  After a shutdown, settled lots'
+// usdx is counted in the settled supply until it's claimed.
+func Take(ctx context.Context, backend Backend, contract common.Address, block *big.Int, extra []common.Address) (*Report, error) {
+	header, err := backend.HeaderByNumber(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, fmt.Errorf("block %v not found", block)
+	}
+	number := header.Number
+
+	logs, err := backend.FilterLogs(ctx, ethereum.FilterQuery{
+		ToBlock:   number,
+		Addresses: []common.Address{contract},
+		Topics:    [][]common.Hash{{transferID, lotTransferredID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	candidates := make(map[common.Address]bool)
+	for _, addr := range extra {
+		candidates[addr] = true
+	}
+	for _, l := range logs {
+		// Both have from and to topics; LotTransferred's third is
+		// the lot id.
+		for _, topic := range l.Topics[1:3] {
+			candidates[common.BytesToAddress(topic.Bytes())] = true
+		}
+	}
+	delete(candidates, common.Address{})
+
+	storageAt := func(slot common.Hash) (*big.Int, error) {
+		val, err := backend.StorageAt(ctx, contract, slot, number)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetBytes(val), nil
+	}
+	var accounts []Account
+	mints := new(big.Int)
+	for addr := range candidates {
+		lockedSlot, mintSlot := proof.AccountSlots(addr)
+		locked, err := storageAt(lockedSlot)
+		if err != nil {
+			return nil, err
+		}
+		mint, err := storageAt(mintSlot)
+		if err != nil {
+			return nil, err
+		}
+		if locked.Sign() == 0 && mint.Sign() == 0 {
+			continue
+		}
+		accounts = append(accounts, Account{addr, (*hexutil.Big)(locked), (*hexutil.Big)(mint)})
+		mints.Add(mints, mint)
+	}
+
+	supply, err := storageAt(common.BigToHash(big.NewInt(proof.TotalSupplySlot)))
+	if err != nil {
+		return nil, err
+	}
+	settled, err := storageAt(common.BigToHash(big.NewInt(proof.SettledSupplySlot)))
+	if err != nil {
+		return nil, err
+	}
+	pool, err := storageAt(common.BigToHash(big.NewInt(proof.SettlementPoolSlot)))
+	if err != nil {
+		return nil, err
+	}
+	if new(big.Int).Add(mints, settled).Cmp(supply) != 0 {
+		return nil, fmt.Errorf("%w: found accounts mint %v, settled supply is %v, total supply is %v", ErrIncomplete, mints, settled, supply)
+	}
+	balance, err := backend.BalanceAt(ctx, contract, number)
+	if err != nil {
+		return nil, err
+	}
+	return New(contract, header, supply, balance, settled, pool, accounts), nil
+}
+
+// CheckChain checks c's block, totals, and accounts against the chain.
+func CheckChain(ctx context.Context, backend Backend, c *Commitment, accounts ...Account) error {
+	number := new(big.Int).SetUint64(c.Block)
+	header, err := backend.HeaderByNumber(ctx, number)
+	if err != nil {
+		return err
+	}
+	if header == nil || header.Hash() != c.BlockHash {
+		return fmt.Errorf("block %d isn't %s", c.Block, c.BlockHash.Hex())
+	}
+	check := func(what string, slot common.Hash, want *hexutil.Big) error {
+		val, err := backend.StorageAt(ctx, c.Contract, slot, number)
+		if err != nil {
+			return err
+		}
+		if got := new(big.Int).SetBytes(val); want == nil || got.Cmp(want.ToInt()) != 0 {
+			return fmt.Errorf("%s is %v on chain, report has %v", what, got, want)
+		}
+		return nil
+	}
+	for _, v := range []struct {
+		what string
+		slot int64
+		want *hexutil.Big
+	}{
+		{"total supply", proof.TotalSupplySlot, c.TotalSupply},
+		{"settled supply", proof.SettledSupplySlot, c.Settled},
+		{"settlement pool", proof.SettlementPoolSlot, c.Pool},
+	} {
+		if err := check(v.what, common.BigToHash(big.NewInt(v.slot)), v.want); err != nil {
+			return err
+		}
+	}
+	balance, err := backend.BalanceAt(ctx, c.Contract, number)
+	if err != nil {
+		return err
+	}
+	if c.Balance == nil || balance.Cmp(c.Balance.ToInt()) != 0 {
+		return fmt.Errorf("balance is %v on chain, report has %v", balance, c.Balance)
+	}
+	for _, a := range accounts {
+		lockedSlot, mintSlot := proof.AccountSlots(a.Address)
+		if err := check(a.Address.Hex()+" locked", lockedSlot, a.Locked); err != nil {
+			return err
+		}
+		if err := check(a.Address.Hex()+" mint", mintSlot, a.Mint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
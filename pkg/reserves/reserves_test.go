@@ -0,0 +1,258 @@
+package reserves
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/royalfork/usdx/pkg/usdx"
+	"github.com/royalfork/usdx/pkg/usdxtest"
+)
+
+func TestTree(t *testing.T) {
+	for n := 0; n < 10; n++ {
+		leaves := make([]common.Hash, n)
+		for i := range leaves {
+			leaves[i] = common.BigToHash(big.NewInt(int64(i + 1)))
+		}
+		tr := newTree(leaves)
+		for i, leaf := range leaves {
+			root, err := proofRoot(leaf, uint64(i), uint64(n), tr.proof(i))
+			if err != nil || root != tr.root() {
+				t.Errorf("%d leaves: leaf %d proves %s, err: %v, want %s", n, i, root.Hex(), err, tr.root().Hex())
+			}
+			if n > 1 {
+				if root, err := proofRoot(leaf, uint64((i+1)%n), uint64(n), tr.proof(i)); err == nil && root == tr.root() {
+					t.Errorf("%d leaves: leaf %d proven at wrong index", n, i)
+				}
+			}
+		}
+	}
+	// Promoting an odd node, rather than pairing it with itself, keeps
+	// a duplicated last leaf from sharing the root.
+	a, b, c := common.Hash{1}, common.Hash{2}, common.Hash{3}
+	if newTree([]common.Hash{a, b, c}).root() == newTree([]common.Hash{a, b, c, c}).root() {
+		t.Error("duplicated leaf shares root")
+	}
+}
+
+func TestReport(t *testing.T) {
+	env := usdxtest.NewEnv(t)
+	env.SetPrice(big.NewInt(2000e8))
+	ctx := context.Background()
+	accts := env.Accounts
+
+	env.Mint(accts[1], big.NewInt(1e18))
+	env.Mint(accts[2], big.NewInt(2e18))
+	env.Mint(accts[3], big.NewInt(3e17))
+	// accts[4] holds usdx, but has no account.
+	if !env.Chain.Succeed(env.USDX.Transfer(accts[1].Auth, accts[4].Addr, usdxtest.USDX(100))) {
+		t.Fatal("unable to transfer")
+	}
+	// accts[5] receives accts[2]'s account, and accts[6] accts[3]'s
+	// lot.
+	for _, allow := range [][2]usdxtest.Account{{accts[5], accts[2]}, {accts[6], accts[3]}} {
+		if !env.Chain.Succeed(env.USDX.SetLotSender(allow[0].Auth, allow[1].Addr, true)) {
+			t.Fatal("unable to allow lot sender")
+		}
+	}
+	if !env.Chain.Succeed(env.USDX.TransferAcct(accts[2].Auth, accts[5].Addr)) {
+		t.Fatal("unable to transfer account")
+	}
+	// accts[6] receives accts[3]'s lot, as a position token.
+	positionsAddr, err := env.USDX.Positions(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	positions, err := usdx.NewUSDXPositions(positionsAddr, env.Chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acct3, err := env.USDX.Accounts(&bind.CallOpts{}, accts[3].Addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !env.Chain.Succeed(positions.TransferFrom(accts[3].Auth, accts[3].Addr, accts[6].Addr, acct3.FirstLot)) {
+		t.Fatal("unable to transfer position")
+	}
+	head := env.Chain.Blockchain().CurrentBlock().Number()
+
+	// Lots moved either way are found from their LotTransferred
+	// events.
+	r, err := Take(ctx, env.Chain, env.USDXAddr, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if r.Accounts != 3 {
+		t.Fatalf("want 3 accounts, got: %d", r.Accounts)
+	}
+	if want := usdxtest.USDX(2000 + 4000 + 600); r.TotalSupply.ToInt().Cmp(want) != 0 {
+		t.Fatalf("want total supply: %v, got: %v", want, r.TotalSupply)
+	}
+	if want := big.NewInt(33e17); r.Balance.ToInt().Cmp(want) != 0 {
+		t.Fatalf("want balance: %v, got: %v", want, r.Balance)
+	}
+	for _, a := range []usdxtest.Account{accts[1], accts[5], accts[6]} {
+		p, ok := r.Proof(a.Addr)
+		if !ok {
+			t.Fatalf("no proof of %s", a.Addr.Hex())
+		}
+		acct, err := env.USDX.Accounts(&bind.CallOpts{}, a.Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Locked.ToInt().Cmp(acct.Locked) != 0 || p.Mint.ToInt().Cmp(acct.Mint) != 0 {
+			t.Errorf("%s: want %v/%v, got: %v/%v", a.Addr.Hex(), acct.Locked, acct.Mint, p.Locked, p.Mint)
+		}
+	}
+	for _, a := range []usdxtest.Account{accts[2], accts[3], accts[4]} {
+		if _, ok := r.Proof(a.Addr); ok {
+			t.Errorf("%s has no account, but is in report", a.Addr.Hex())
+		}
+	}
+	var accounts []Account
+	for _, p := range r.Proofs {
+		accounts = append(accounts, p.Account)
+	}
+	if err := CheckChain(ctx, env.Chain, &r.Commitment, accounts...); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("json", func(t *testing.T) {
+		enc, err := json.Marshal(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var dec Report
+		if err := json.Unmarshal(enc, &dec); err != nil {
+			t.Fatal(err)
+		}
+		if err := dec.Verify(); err != nil {
+			t.Fatal(err)
+		}
+		if dec.Root != r.Root {
+			t.Fatalf("want root: %s, got: %s", r.Root.Hex(), dec.Root.Hex())
+		}
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		// decode copies r, so tampering doesn't affect other tests.
+		decode := func() *Report {
+			enc, _ := json.Marshal(r)
+			var dec Report
+			json.Unmarshal(enc, &dec)
+			return &dec
+		}
+
+		tr := decode()
+		tr.Proofs[0].Mint = (*hexutil.Big)(new(big.Int).Add(tr.Proofs[0].Mint.ToInt(), big.NewInt(1)))
+		if err := tr.VerifyProof(&tr.Proofs[0]); !errors.Is(err, ErrInvalidProof) {
+			t.Errorf("inflated mint: want err: %v, got: %v", ErrInvalidProof, err)
+		}
+
+		tr = decode()
+		tr.TotalSupply = (*hexutil.Big)(big.NewInt(1))
+		if err := tr.Verify(); err == nil {
+			t.Error("changed total supply: want error")
+		}
+
+		// A consistent report omitting an account doesn't sum to the
+		// total supply.
+		var partial []Account
+		for _, p := range r.Proofs[1:] {
+			partial = append(partial, p.Account)
+		}
+		header := env.Chain.Blockchain().GetHeaderByNumber(r.Block)
+		if err := New(r.Contract, header, r.TotalSupply.ToInt(), r.Balance.ToInt(), r.Settled.ToInt(), r.Pool.ToInt(), partial).Verify(); !errors.Is(err, ErrIncomplete) {
+			t.Errorf("omitted account: want err: %v, got: %v", ErrIncomplete, err)
+		}
+
+		tr = decode()
+		tr.Proofs[0].Locked = (*hexutil.Big)(big.NewInt(1))
+		if err := CheckChain(ctx, env.Chain, &tr.Commitment, tr.Proofs[0].Account); err == nil {
+			t.Error("changed account: want error checking chain")
+		}
+	})
+
+	t.Run("historical", func(t *testing.T) {
+		e := env.Isolate(t)
+		e.Mint(e.Accounts[7], big.NewInt(1e18))
+
+		old, err := Take(ctx, e.Chain, e.USDXAddr, head, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if old.Root != r.Root {
+			t.Fatalf("want root: %s, got: %s", r.Root.Hex(), old.Root.Hex())
+		}
+		if err := CheckChain(ctx, e.Chain, &r.Commitment); err != nil {
+			t.Fatal(err)
+		}
+		latest, err := Take(ctx, e.Chain, e.USDXAddr, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if latest.Accounts != 4 || latest.Root == r.Root {
+			t.Fatalf("want new report with 4 accounts, got: %d accounts, root %s", latest.Accounts, latest.Root.Hex())
+		}
+	})
+
+	t.Run("shutdown", func(t *testing.T) {
+		e := env.Isolate(t)
+		opts := &bind.CallOpts{}
+		// take takes and checks a report, which must have n accounts
+		// and settled usdx.
+		take := func(t *testing.T, n uint64, settled *big.Int) {
+			t.Helper()
+			r, err := Take(ctx, e.Chain, e.USDXAddr, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := r.Verify(); err != nil {
+				t.Fatal(err)
+			}
+			if err := CheckChain(ctx, e.Chain, &r.Commitment); err != nil {
+				t.Fatal(err)
+			}
+			if r.Accounts != n || r.Settled.ToInt().Cmp(settled) != 0 {
+				t.Fatalf("want %d accounts, %v settled, got: %d, %v", n, settled, r.Accounts, r.Settled)
+			}
+			if pool, _ := e.USDX.SettlementPool(opts); r.Pool.ToInt().Cmp(pool) != 0 {
+				t.Fatalf("want pool: %v, got: %v", pool, r.Pool)
+			}
+		}
+
+		if !e.Chain.Succeed(e.USDX.Shutdown(e.Accounts[0].Auth, big.NewInt(2000e8))) {
+			t.Fatal("unable to shut down")
+		}
+		s, err := usdx.SettlementAt(opts, e.Chain, e.USDXAddr, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Settled lots' usdx is counted until it's claimed.
+		settled := new(big.Int)
+		for i, ids := range s.Batches(1) {
+			l, err := e.USDX.Lots(opts, ids[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !e.Chain.Succeed(e.USDX.SettleLots(e.Accounts[0].Auth, ids)) {
+				t.Fatal("unable to settle lot")
+			}
+			settled.Add(settled, l.Mint)
+			take(t, uint64(len(s.Lots)-i-1), settled)
+		}
+		if !e.Chain.Succeed(e.USDX.Claim(e.Accounts[4].Auth, new(big.Int))) {
+			t.Fatal("unable to claim")
+		}
+		take(t, 0, settled.Sub(settled, usdxtest.USDX(100)))
+	})
+}
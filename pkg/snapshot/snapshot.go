@@ -0,0 +1,71 @@
+// Package snapshot reverts simulated chains to earlier states, so
+// tests can share one deployment instead of redeploying contracts.
+//
+//	snap := snapshot.Take(chain)
+//	... send transactions ...
+//	if err := snap.Revert(); err != nil { ... }
+//
+// Reverting rewinds the chain's head to the block the snapshot was
+// taken at, and discards pending transactions.  A snapshot can be
+// reverted to any number of times, until a snapshot taken before it is
+// reverted.
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+// ErrInvalidated is returned when reverting to a snapshot whose block
+// has been removed from the chain, by reverting to an earlier
+// snapshot.
+var ErrInvalidated = errors.New("snapshot invalidated by an earlier revert")
+
+// Backend is a simulated chain, such as *backends.SimulatedBackend or a
+// type embedding one.
+type Backend interface {
+	Blockchain() *core.BlockChain
+	Rollback()
+}
+
+// Snapshot is a chain's state at a block.
+type Snapshot struct {
+	b      Backend
+	number uint64
+	hash   common.Hash
+}
+
+// Take snapshots b's latest block.  Pending transactions aren't part of
+// the snapshot.
+func Take(b Backend) *Snapshot {
+	head := b.Blockchain().CurrentBlock()
+	return &Snapshot{b: b, number: head.NumberU64(), hash: head.Hash()}
+}
+
+// Number is the block number the snapshot was taken at.
+func (s *Snapshot) Number() uint64 {
+	return s.number
+}
+
+// Revert rewinds the chain to the snapshot's block.  Simulated chains
+// write the state of every block to their database, so the snapshot's
+// state is never pruned.
+func (s *Snapshot) Revert() error {
+	bc := s.b.Blockchain()
+	if bc.GetCanonicalHash(s.number) != s.hash {
+		return ErrInvalidated
+	}
+	if bc.CurrentBlock().NumberU64() > s.number {
+		if err := bc.SetHead(s.number); err != nil {
+			return err
+		}
+	}
+	if head := bc.CurrentBlock(); head.Hash() != s.hash {
+		return fmt.Errorf("reverted to block %d, want %d", head.NumberU64(), s.number)
+	}
+	s.b.Rollback()
+	return nil
+}
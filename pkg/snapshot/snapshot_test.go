@@ -0,0 +1,96 @@
+package snapshot
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestSnapshot(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	chain := backends.NewSimulatedBackend(core.GenesisAlloc{from: {Balance: big.NewInt(1e18)}}, 10000000)
+	defer chain.Close()
+	ctx := context.Background()
+
+	send := func(t *testing.T, wei int64) {
+		t.Helper()
+		nonce, err := chain.PendingNonceAt(ctx, from)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx, err := types.SignNewTx(key, types.NewEIP155Signer(big.NewInt(1337)), &types.LegacyTx{
+			Nonce: nonce, Gas: 21000, GasPrice: new(big.Int), To: &to, Value: big.NewInt(wei),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := chain.SendTransaction(ctx, tx); err != nil {
+			t.Fatal(err)
+		}
+		chain.Commit()
+	}
+	balance := func(t *testing.T, want int64) {
+		t.Helper()
+		if bal, err := chain.BalanceAt(ctx, to, nil); err != nil || bal.Int64() != want {
+			t.Fatalf("want balance: %d, got: %v, err: %v", want, bal, err)
+		}
+	}
+
+	send(t, 1)
+	base := Take(chain)
+
+	for i := 0; i < 3; i++ {
+		send(t, 10)
+		send(t, 10)
+		balance(t, 21)
+		if err := base.Revert(); err != nil {
+			t.Fatal(err)
+		}
+		balance(t, 1)
+		if got := chain.Blockchain().CurrentBlock().NumberU64(); got != base.Number() {
+			t.Fatalf("want head: %d, got: %d", base.Number(), got)
+		}
+	}
+
+	t.Run("nested", func(t *testing.T) {
+		send(t, 2)
+		inner := Take(chain)
+		send(t, 4)
+		if err := inner.Revert(); err != nil {
+			t.Fatal(err)
+		}
+		balance(t, 3)
+		if err := base.Revert(); err != nil {
+			t.Fatal(err)
+		}
+		balance(t, 1)
+
+		// inner's block was removed by reverting to base.
+		send(t, 8)
+		if err := inner.Revert(); err != ErrInvalidated {
+			t.Fatalf("want err: %v, got: %v", ErrInvalidated, err)
+		}
+	})
+
+	t.Run("pruned", func(t *testing.T) {
+		if err := base.Revert(); err != nil {
+			t.Fatal(err)
+		}
+		// Mine past the number of states a full node keeps in memory.
+		for i := 0; i < 200; i++ {
+			send(t, 1)
+		}
+		if err := base.Revert(); err != nil {
+			t.Fatal(err)
+		}
+		balance(t, 1)
+	})
+}
@@ -0,0 +1,217 @@
+package srcmap
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Source is a solidity source file.
+type Source struct {
+	Path string
+	Text []byte
+
+	lines []int // offset of each line's first byte
+}
+
+func newSource(path string, text []byte) *Source {
+	s := &Source{Path: path, Text: text, lines: []int{0}}
+	for i, b := range text {
+		if b == '\n' && i+1 < len(text) {
+			s.lines = append(s.lines, i+1)
+		}
+	}
+	return s
+}
+
+// Line returns the 1 based line number of the byte at offset.
+func (s *Source) Line(offset int) int {
+	return sort.Search(len(s.lines), func(i int) bool { return s.lines[i] > offset })
+}
+
+// Lines returns the number of lines in s.
+func (s *Source) Lines() int {
+	if len(s.Text) == 0 {
+		return 0
+	}
+	return len(s.lines)
+}
+
+// LineText returns the text of line n, without its line ending.
+func (s *Source) LineText(n int) string {
+	if n < 1 || n > s.Lines() {
+		return ""
+	}
+	end := len(s.Text)
+	if n < len(s.lines) {
+		end = s.lines[n]
+	}
+	return strings.TrimRight(string(s.Text[s.lines[n-1]:end]), "\r\n")
+}
+
+// Contract is a compiled contract.  Abstract contracts and interfaces
+// have no code.
+type Contract struct {
+	Name          string // as named by solc, <path>:<name>
+	Bin           []byte // init code
+	BinRuntime    []byte
+	SrcMap        []SourceRange
+	SrcMapRuntime []SourceRange
+
+	index, indexRuntime map[uint64]int // pc => instruction index
+}
+
+// Program is the output of a solc compilation.
+type Program struct {
+	Sources   []*Source // indexed by SourceRange.File
+	Contracts []*Contract
+}
+
+// combined is solc's --combined-json output.
+type combined struct {
+	Contracts map[string]struct {
+		Bin           string `json:"bin"`
+		BinRuntime    string `json:"bin-runtime"`
+		SrcMap        string `json:"srcmap"`
+		SrcMapRuntime string `json:"srcmap-runtime"`
+	} `json:"contracts"`
+	SourceList []string `json:"sourceList"`
+}
+
+// LoadCombined loads the output of:
+//
+//	solc --combined-json bin,bin-runtime,srcmap,srcmap-runtime ...
+//
+// Source paths are relative to dir, the directory solc was run in.
+func LoadCombined(path, dir string) (*Program, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var out combined
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if len(out.SourceList) == 0 {
+		return nil, fmt.Errorf("%s: no sourceList", path)
+	}
+
+	p := new(Program)
+	for _, name := range out.SourceList {
+		srcPath := name
+		if !filepath.IsAbs(srcPath) {
+			srcPath = filepath.Join(dir, srcPath)
+		}
+		text, err := ioutil.ReadFile(srcPath)
+		if err != nil {
+			return nil, err
+		}
+		p.Sources = append(p.Sources, newSource(srcPath, text))
+	}
+
+	for name, c := range out.Contracts {
+		contract := &Contract{Name: name}
+		for _, f := range []struct {
+			field string
+			hex   string
+			code  *[]byte
+		}{
+			{"bin", c.Bin, &contract.Bin},
+			{"bin-runtime", c.BinRuntime, &contract.BinRuntime},
+		} {
+			if strings.Contains(f.hex, "__") {
+				return nil, fmt.Errorf("%s: %s has unlinked libraries", name, f.field)
+			}
+			if *f.code, err = hex.DecodeString(f.hex); err != nil {
+				return nil, fmt.Errorf("%s: %s: %v", name, f.field, err)
+			}
+		}
+		if contract.SrcMap, err = Parse(c.SrcMap); err != nil {
+			return nil, fmt.Errorf("%s: srcmap: %v", name, err)
+		}
+		if contract.SrcMapRuntime, err = Parse(c.SrcMapRuntime); err != nil {
+			return nil, fmt.Errorf("%s: srcmap-runtime: %v", name, err)
+		}
+		contract.index = instructionIndex(contract.Bin)
+		contract.indexRuntime = instructionIndex(contract.BinRuntime)
+		p.Contracts = append(p.Contracts, contract)
+	}
+	sort.Slice(p.Contracts, func(i, j int) bool { return p.Contracts[i].Name < p.Contracts[j].Name })
+	return p, nil
+}
+
+func instructionIndex(code []byte) map[uint64]int {
+	index := make(map[uint64]int)
+	for i, pc := range Instructions(code) {
+		index[pc] = i
+	}
+	return index
+}
+
+// Code returns c's runtime or init code, and its source map.
+func (c *Contract) Code(runtime bool) ([]byte, []SourceRange) {
+	if runtime {
+		return c.BinRuntime, c.SrcMapRuntime
+	}
+	return c.Bin, c.SrcMap
+}
+
+// Locate returns the source range of the instruction at pc in c's
+// runtime or init code.  It returns false if pc isn't an instruction
+// covered by the source map.
+func (c *Contract) Locate(runtime bool, pc uint64) (SourceRange, bool) {
+	index, srcmap := c.index, c.SrcMap
+	if runtime {
+		index, srcmap = c.indexRuntime, c.SrcMapRuntime
+	}
+	i, ok := index[pc]
+	if !ok || i >= len(srcmap) {
+		return SourceRange{}, false
+	}
+	return srcmap[i], true
+}
+
+// Source returns the source r is within, or nil if r isn't within a
+// source, such as compiler generated code.
+func (p *Program) Source(r SourceRange) *Source {
+	if r.File < 0 || r.File >= len(p.Sources) {
+		return nil
+	}
+	return p.Sources[r.File]
+}
+
+// Match returns the contract which code is the runtime or init code
+// of, and whether code is runtime code.  It returns nil if code wasn't
+// compiled by p.
+func (p *Program) Match(code []byte) (*Contract, bool) {
+	for _, c := range p.Contracts {
+		if len(c.BinRuntime) > 0 && matchRuntime(code, c.BinRuntime) {
+			return c, true
+		}
+		// Init code is followed by constructor arguments.
+		if len(c.Bin) > 0 && bytes.HasPrefix(code, c.Bin) {
+			return c, false
+		}
+	}
+	return nil, false
+}
+
+// matchRuntime returns whether code was deployed from bin.  solc
+// leaves immutable variables zeroed in bin, so deployed code may only
+// differ where bin has zero bytes.
+func matchRuntime(code, bin []byte) bool {
+	if len(code) != len(bin) {
+		return false
+	}
+	for i := range code {
+		if code[i] != bin[i] && bin[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
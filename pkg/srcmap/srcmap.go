@@ -0,0 +1,79 @@
+// Package srcmap maps contract program counters to solidity sources,
+// using the source maps of solc's combined json output.
+package srcmap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// SourceRange is a source map entry: the source range an instruction
+// was compiled from.
+type SourceRange struct {
+	Start  int  // byte offset into the source
+	Length int  // length in bytes
+	File   int  // index into the compiler's source list, -1 if none
+	Jump   byte // 'i' into a function, 'o' out of a function, or '-'
+}
+
+// Parse parses solc's compressed source map, which has one
+// entry per instruction:
+//
+//	s:l:f:j;s:l:f:j;...
+//
+// Empty or missing fields repeat the previous entry's field.  Fields
+// after j (such as the modifier depth) are ignored.
+func Parse(srcmap string) ([]SourceRange, error) {
+	if srcmap == "" {
+		return nil, nil
+	}
+	entries := strings.Split(srcmap, ";")
+	ranges := make([]SourceRange, len(entries))
+	prev := SourceRange{File: -1, Jump: '-'}
+	for i, entry := range entries {
+		r := prev
+		for j, field := range strings.Split(entry, ":") {
+			if field == "" {
+				continue
+			}
+			var err error
+			switch j {
+			case 0:
+				r.Start, err = strconv.Atoi(field)
+			case 1:
+				r.Length, err = strconv.Atoi(field)
+			case 2:
+				r.File, err = strconv.Atoi(field)
+			case 3:
+				if len(field) != 1 || !strings.Contains("io-", field) {
+					err = fmt.Errorf("invalid jump %q", field)
+				}
+				r.Jump = field[0]
+			}
+			if err != nil {
+				return nil, fmt.Errorf("source map entry %d: %v", i, err)
+			}
+		}
+		ranges[i] = r
+		prev = r
+	}
+	return ranges, nil
+}
+
+// Instructions returns the program counter of each instruction in
+// code.  Trailing data (such as solc's metadata) is decoded as
+// instructions too, which is harmless since source maps don't cover
+// it.
+func Instructions(code []byte) []uint64 {
+	var pcs []uint64
+	for pc := 0; pc < len(code); pc++ {
+		pcs = append(pcs, uint64(pc))
+		if op := vm.OpCode(code[pc]); op.IsPush() {
+			pc += int(op - vm.PUSH1 + 1)
+		}
+	}
+	return pcs
+}
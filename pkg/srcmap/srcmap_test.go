@@ -0,0 +1,103 @@
+package srcmap
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	got, err := Parse("1:2:0:-;:9;;-1:-1:-1:i;4::1:o:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []SourceRange{
+		{1, 2, 0, '-'},
+		{1, 9, 0, '-'},
+		{1, 9, 0, '-'},
+		{-1, -1, -1, 'i'},
+		{4, -1, 1, 'o'},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want: %v, got: %v", want, got)
+	}
+
+	for _, srcmap := range []string{"a:1:0", "1:1:0:x"} {
+		if _, err := Parse(srcmap); err == nil {
+			t.Errorf("%q: want error", srcmap)
+		}
+	}
+}
+
+func TestInstructions(t *testing.T) {
+	// PUSH1 1, PUSH2 2, ADD, PUSH32 (truncated)
+	got := Instructions([]byte{0x60, 1, 0x61, 0, 2, 0x01, 0x7f, 0})
+	if want := []uint64{0, 2, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("want: %v, got: %v", want, got)
+	}
+}
+
+func TestLoadCombined(t *testing.T) {
+	dir := t.TempDir()
+	src := "a;\nbb;\r\nccc;"
+	if err := ioutil.WriteFile(filepath.Join(dir, "a.sol"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// PUSH1 0, DUP1, STOP
+	combined := `{
+		"contracts": {"a.sol:A": {"bin": "6000", "bin-runtime": "60008000", "srcmap": "0:2:0", "srcmap-runtime": "3:3:0;11:4;-1:0:-1"}},
+		"sourceList": ["a.sol"]
+	}`
+	path := filepath.Join(dir, "combined.json")
+	if err := ioutil.WriteFile(path, []byte(combined), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p, err := LoadCombined(path, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, runtime := p.Match([]byte{0x60, 0, 0x80, 0})
+	if c == nil || c.Name != "a.sol:A" || !runtime {
+		t.Fatalf("runtime code didn't match: %v, %v", c, runtime)
+	}
+	// Init code followed by constructor arguments.
+	if c, runtime := p.Match([]byte{0x60, 0, 1, 2}); c == nil || runtime {
+		t.Fatalf("init code didn't match: %v, %v", c, runtime)
+	}
+	// Zero bytes may be immutables set by the constructor.
+	if c, runtime := p.Match([]byte{0x60, 7, 0x80, 0}); c == nil || !runtime {
+		t.Fatalf("runtime code with immutable didn't match: %v, %v", c, runtime)
+	}
+	if c, _ := p.Match([]byte{0x61, 0, 0x80, 0}); c != nil {
+		t.Fatalf("unexpected match: %v", c.Name)
+	}
+
+	for _, tt := range []struct {
+		pc   uint64
+		line int
+		text string
+	}{
+		{0, 2, "bb;"},
+		{2, 3, "ccc;"},
+	} {
+		r, ok := c.Locate(true, tt.pc)
+		if !ok {
+			t.Fatalf("pc %d not located", tt.pc)
+		}
+		src := p.Source(r)
+		if line := src.Line(r.Start); line != tt.line || src.LineText(line) != tt.text {
+			t.Errorf("pc %d: want line %d %q, got: %d %q", tt.pc, tt.line, tt.text, line, src.LineText(line))
+		}
+	}
+	if r, ok := c.Locate(true, 3); !ok || p.Source(r) != nil {
+		t.Errorf("pc 3: want compiler generated source range, got: %v, %v", r, ok)
+	}
+	if _, ok := c.Locate(true, 1); ok {
+		t.Errorf("pc 1 is push data, not an instruction")
+	}
+	if n := p.Sources[0].Lines(); n != 3 {
+		t.Errorf("want 3 lines, got: %d", n)
+	}
+}
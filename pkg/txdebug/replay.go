@@ -0,0 +1,151 @@
+package txdebug
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/royalfork/usdx/pkg/srcmap"
+)
+
+// ErrNotFound is returned when a transaction isn't mined.
+var ErrNotFound = errors.New("transaction not found")
+
+// Replay re-executes the mined transaction hash on the state it was
+// executed on, tracing it with tracer.
+func Replay(bc *core.BlockChain, hash common.Hash, tracer vm.Tracer) (*core.ExecutionResult, error) {
+	return replay(bc, hash, func(*state.StateDB, types.Message) vm.Tracer { return tracer })
+}
+
+// replay re-executes transaction hash, tracing it with the tracer
+// returned by newTracer, which is passed the state before the
+// transaction.
+func replay(bc *core.BlockChain, hash common.Hash, newTracer func(*state.StateDB, types.Message) vm.Tracer) (*core.ExecutionResult, error) {
+	lookup := bc.GetTransactionLookup(hash)
+	if lookup == nil {
+		return nil, ErrNotFound
+	}
+	block := bc.GetBlockByHash(lookup.BlockHash)
+	if block == nil {
+		return nil, ErrNotFound
+	}
+	parent := bc.GetBlockByHash(block.ParentHash())
+	if parent == nil {
+		return nil, fmt.Errorf("missing parent of block %d", block.NumberU64())
+	}
+	statedb, err := bc.StateAt(parent.Root())
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := bc.Config()
+	signer := types.MakeSigner(cfg, block.Number())
+	blockCtx := core.NewEVMBlockContext(block.Header(), bc, nil)
+	for i, tx := range block.Transactions() {
+		msg, err := tx.AsMessage(signer)
+		if err != nil {
+			return nil, err
+		}
+		statedb.Prepare(tx.Hash(), block.Hash(), i)
+		var vmCfg vm.Config
+		if uint64(i) == lookup.Index {
+			vmCfg = vm.Config{Debug: true, Tracer: newTracer(statedb, msg)}
+		}
+		evm := vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), statedb, cfg, vmCfg)
+		res, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(tx.Gas()))
+		if err != nil {
+			return nil, fmt.Errorf("tx %d of block %d: %w", i, block.NumberU64(), err)
+		}
+		if uint64(i) == lookup.Index {
+			return res, nil
+		}
+		statedb.Finalise(cfg.IsEIP158(block.Number()))
+	}
+	return nil, ErrNotFound
+}
+
+// Debug re-executes the mined transaction hash, and explains its
+// execution.  prog may be nil.
+func Debug(bc *core.BlockChain, hash common.Hash, prog *srcmap.Program) (*Result, error) {
+	var a *analyzer
+	res, err := replay(bc, hash, func(statedb *state.StateDB, msg types.Message) vm.Tracer {
+		addr, code := crypto.CreateAddress(msg.From(), msg.Nonce()), msg.Data()
+		if to := msg.To(); to != nil {
+			addr, code = *to, statedb.GetCode(*to)
+		}
+		a = newAnalyzer(prog, hash, addr, code, statedb.GetCode)
+		return &tracer{a: a}
+	})
+	if err != nil {
+		return nil, err
+	}
+	var failure string
+	if res.Err != nil {
+		failure = res.Err.Error()
+	}
+	return a.finish(failure, res.ReturnData), nil
+}
+
+// tracer feeds the instructions executed by the evm to an analyzer.
+type tracer struct {
+	a *analyzer
+}
+
+func (t *tracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	return nil
+}
+
+func (t *tracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, rData []byte, contract *vm.Contract, depth int, err error) error {
+	t.a.step(step{
+		depth: depth,
+		pc:    pc,
+		op:    op,
+		stack: func(n int) *big.Int {
+			return stack.Back(n).ToBig()
+		},
+		memory: func(offset, size *big.Int) []byte {
+			return memorySlice(memory.Data(), offset, size)
+		},
+		sload: func(addr common.Address, slot common.Hash) common.Hash {
+			return env.StateDB.GetState(addr, slot)
+		},
+	})
+	// Errors found before an instruction executes, such as running
+	// out of gas, are passed to CaptureState.
+	if err != nil {
+		t.a.fault(op, err.Error())
+	}
+	return nil
+}
+
+func (t *tracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	// Reverts were recorded when REVERT executed.
+	if err != vm.ErrExecutionReverted {
+		t.a.fault(op, err.Error())
+	}
+	return nil
+}
+
+func (t *tracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	return nil
+}
+
+// memorySlice returns a copy of size bytes of mem from offset.  Bytes
+// beyond mem are zero.
+func memorySlice(mem []byte, offset, size *big.Int) []byte {
+	if !size.IsUint64() || size.Uint64() > uint64(len(mem)) || !offset.IsUint64() {
+		return nil
+	}
+	out := make([]byte, size.Uint64())
+	if off := offset.Uint64(); off < uint64(len(mem)) {
+		copy(out, mem[off:])
+	}
+	return out
+}
@@ -0,0 +1,194 @@
+package txdebug
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/royalfork/usdx/pkg/srcmap"
+)
+
+// TraceResult is the result of debug_traceTransaction with geth's
+// default struct logger.
+type TraceResult struct {
+	Gas         uint64      `json:"gas"`
+	Failed      bool        `json:"failed"`
+	ReturnValue string      `json:"returnValue"`
+	StructLogs  []StructLog `json:"structLogs"`
+}
+
+// StructLog is an executed instruction.  Words are hex encoded.
+type StructLog struct {
+	PC      uint64            `json:"pc"`
+	Op      string            `json:"op"`
+	Gas     uint64            `json:"gas"`
+	GasCost uint64            `json:"gasCost"`
+	Depth   int               `json:"depth"`
+	Error   json.RawMessage   `json:"error,omitempty"`
+	Stack   []string          `json:"stack"`
+	Memory  []string          `json:"memory,omitempty"`
+	Storage map[string]string `json:"storage,omitempty"`
+}
+
+// NewTraceResult formats the logs of a struct logger which traced an
+// execution with result res, as geth does.
+func NewTraceResult(res *core.ExecutionResult, logs []vm.StructLog) *TraceResult {
+	ret := res.Return()
+	if len(res.Revert()) > 0 {
+		ret = res.Revert()
+	}
+	out := &TraceResult{
+		Gas:         res.UsedGas,
+		Failed:      res.Failed(),
+		ReturnValue: hex.EncodeToString(ret),
+		StructLogs:  make([]StructLog, len(logs)),
+	}
+	for i, l := range logs {
+		sl := StructLog{PC: l.Pc, Op: l.Op.String(), Gas: l.Gas, GasCost: l.GasCost, Depth: l.Depth}
+		if l.Err != nil {
+			sl.Error, _ = json.Marshal(l.Err.Error())
+		}
+		for _, v := range l.Stack {
+			sl.Stack = append(sl.Stack, fmt.Sprintf("%x", math.PaddedBigBytes(v, 32)))
+		}
+		for j := 0; j+32 <= len(l.Memory); j += 32 {
+			sl.Memory = append(sl.Memory, fmt.Sprintf("%x", l.Memory[j:j+32]))
+		}
+		if l.Storage != nil {
+			sl.Storage = make(map[string]string)
+			for k, v := range l.Storage {
+				sl.Storage[fmt.Sprintf("%x", k)] = fmt.Sprintf("%x", v)
+			}
+		}
+		out.StructLogs[i] = sl
+	}
+	return out
+}
+
+// DebugRPC traces the mined transaction hash with
+// debug_traceTransaction, and explains its execution.  prog may be
+// nil.
+func DebugRPC(ctx context.Context, client *rpc.Client, hash common.Hash, prog *srcmap.Program) (*Result, error) {
+	ec := ethclient.NewClient(client)
+	tx, _, err := ec.TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	rcpt, err := ec.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	var trace TraceResult
+	cfg := map[string]interface{}{"disableStorage": false, "disableMemory": false, "disableStack": false}
+	if err := client.CallContext(ctx, &trace, "debug_traceTransaction", hash, cfg); err != nil {
+		return nil, err
+	}
+
+	// Code is read after the transaction's block, so contracts
+	// created by earlier transactions in the block are found.
+	var codeErr error
+	codes := make(map[common.Address][]byte)
+	codeAt := func(addr common.Address) []byte {
+		code, ok := codes[addr]
+		if !ok {
+			var err error
+			if code, err = ec.CodeAt(ctx, addr, rcpt.BlockNumber); err != nil && codeErr == nil {
+				codeErr = err
+			}
+			codes[addr] = code
+		}
+		return code
+	}
+
+	addr, code := rcpt.ContractAddress, tx.Data()
+	if to := tx.To(); to != nil {
+		addr, code = *to, codeAt(*to)
+	}
+	a := newAnalyzer(prog, hash, addr, code, codeAt)
+	for i, l := range trace.StructLogs {
+		var (
+			stack   = make([]*big.Int, len(l.Stack))
+			storage = make(map[common.Hash]common.Hash)
+			mem     []byte
+		)
+		for j, w := range l.Stack {
+			if stack[j], err = parseWord(w); err != nil {
+				return nil, fmt.Errorf("struct log %d: %v", i, err)
+			}
+		}
+		for _, w := range l.Memory {
+			b, err := hex.DecodeString(strings.TrimPrefix(w, "0x"))
+			if err != nil {
+				return nil, fmt.Errorf("struct log %d: %v", i, err)
+			}
+			mem = append(mem, b...)
+		}
+		for k, v := range l.Storage {
+			storage[common.HexToHash(k)] = common.HexToHash(v)
+		}
+
+		op := vm.StringToOp(l.Op)
+		a.step(step{
+			depth: l.Depth,
+			pc:    l.PC,
+			op:    op,
+			stack: func(n int) *big.Int {
+				if n >= len(stack) {
+					return new(big.Int)
+				}
+				return stack[len(stack)-1-n]
+			},
+			memory: func(offset, size *big.Int) []byte {
+				return memorySlice(mem, offset, size)
+			},
+			sload: func(_ common.Address, slot common.Hash) common.Hash {
+				return storage[slot]
+			},
+		})
+		if len(l.Error) > 0 && string(l.Error) != "null" && op != vm.REVERT {
+			msg := "error"
+			json.Unmarshal(l.Error, &msg)
+			a.fault(op, msg)
+		}
+	}
+	if codeErr != nil {
+		return nil, codeErr
+	}
+
+	ret, err := hex.DecodeString(strings.TrimPrefix(trace.ReturnValue, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("returnValue: %v", err)
+	}
+	var failure string
+	if trace.Failed {
+		failure = "execution failed"
+		for _, f := range a.res.Failures {
+			if f.Depth == 1 {
+				failure = f.Err
+			}
+		}
+	}
+	return a.finish(failure, ret), nil
+}
+
+func parseWord(w string) (*big.Int, error) {
+	w = strings.TrimPrefix(w, "0x")
+	if w == "" {
+		return new(big.Int), nil
+	}
+	v, ok := new(big.Int).SetString(w, 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid word %q", w)
+	}
+	return v, nil
+}
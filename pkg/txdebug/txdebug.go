@@ -0,0 +1,338 @@
+// Package txdebug explains why a transaction failed.  It re-executes
+// the transaction, and reports the revert reason, the solidity source
+// line and call stack of each revert, and the storage read along the
+// way.
+//
+// Debug replays a transaction mined by a simulated chain, which is
+// useful when a test's transaction unexpectedly fails:
+//
+//	if !chain.Succeed(tx, err) {
+//		res, _ := txdebug.Debug(chain.Blockchain(), tx.Hash(), prog)
+//		t.Fatal(res)
+//	}
+//
+// DebugRPC does the same for a transaction mined by a node which
+// supports debug_traceTransaction.
+//
+// Source lines are found using a srcmap.Program; without one (or for
+// contracts the program didn't compile), only program counters are
+// reported.
+package txdebug
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/royalfork/usdx/pkg/srcmap"
+)
+
+// Result is the execution of a transaction.
+type Result struct {
+	Hash       common.Hash
+	Failed     bool
+	Err        string // error which failed the transaction
+	ReturnData []byte
+	Reason     string // decoded ReturnData of a failed transaction
+
+	// Failures are the reverts and errors of every call frame, in
+	// order of execution.  The last failure at depth 1 failed the
+	// transaction; earlier failures at greater depths may have been
+	// caught.
+	Failures []Failure
+	Reads    []StorageRead // first read of each slot
+}
+
+// Failure is a call frame which reverted or failed with an error.
+type Failure struct {
+	Depth  int
+	Op     vm.OpCode
+	Err    string
+	Reason string  // decoded revert data
+	Stack  []Frame // outermost first
+}
+
+// Frame is a call frame at the time of a failure.
+type Frame struct {
+	Address  common.Address // zero for contracts being created
+	Contract string         // solc name, or empty if unknown
+	PC       uint64
+	Location Location   // of PC
+	Calls    []Location // internal function call sites, outermost first
+}
+
+// Location is a source line.  The zero Location is an unknown line.
+type Location struct {
+	File string
+	Line int
+	Text string
+}
+
+func (l Location) String() string {
+	if l.File == "" {
+		return "?"
+	}
+	return fmt.Sprintf("%s:%d", l.File, l.Line)
+}
+
+// StorageRead is a storage slot read by SLOAD.
+type StorageRead struct {
+	Address common.Address
+	Slot    common.Hash
+	Value   common.Hash
+}
+
+var (
+	errorSel = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSel = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	// panics are the descriptions of solidity's Panic(uint256) codes.
+	panics = map[uint64]string{
+		0x01: "assertion failed",
+		0x11: "arithmetic overflow or underflow",
+		0x12: "division or modulo by zero",
+		0x21: "invalid enum value",
+		0x22: "invalid storage byte array",
+		0x31: "pop of empty array",
+		0x32: "array index out of bounds",
+		0x41: "out of memory",
+		0x51: "call to zero function",
+	}
+)
+
+// Reason decodes revert data: the message of Error(string), the
+// description of Panic(uint256), or hex of any other data.
+func Reason(data []byte) string {
+	switch {
+	case len(data) == 0:
+		return ""
+	case bytes.HasPrefix(data, errorSel):
+		typ, _ := abi.NewType("string", "", nil)
+		if vals, err := (abi.Arguments{{Type: typ}}).Unpack(data[4:]); err == nil {
+			return vals[0].(string)
+		}
+	case bytes.HasPrefix(data, panicSel) && len(data) == 36:
+		code := new(big.Int).SetBytes(data[4:])
+		if desc, ok := panics[code.Uint64()]; ok && code.IsUint64() {
+			return fmt.Sprintf("panic: %s (0x%x)", desc, code)
+		}
+		return fmt.Sprintf("panic: 0x%x", code)
+	}
+	return fmt.Sprintf("0x%x", data)
+}
+
+// Write writes a description of r to w.
+func (r *Result) Write(w io.Writer) error {
+	var b strings.Builder
+	switch {
+	case !r.Failed:
+		fmt.Fprintf(&b, "tx %s succeeded\n", r.Hash.Hex())
+	case r.Reason != "":
+		fmt.Fprintf(&b, "tx %s failed: %s: %s\n", r.Hash.Hex(), r.Err, r.Reason)
+	default:
+		fmt.Fprintf(&b, "tx %s failed: %s\n", r.Hash.Hex(), r.Err)
+	}
+	for _, f := range r.Failures {
+		fmt.Fprintf(&b, "\n%s at depth %d", f.Op, f.Depth)
+		if f.Reason != "" {
+			fmt.Fprintf(&b, ": %s", f.Reason)
+		} else if f.Err != "" {
+			fmt.Fprintf(&b, ": %s", f.Err)
+		}
+		b.WriteString("\n")
+		prefix := "at"
+		for i := len(f.Stack) - 1; i >= 0; i-- {
+			fr := f.Stack[i]
+			name := fr.Contract
+			if name == "" {
+				name = "unknown"
+			}
+			fmt.Fprintf(&b, "    %-11s %s (%s %s pc %d)\n", prefix, formatLocation(fr.Location), name, fr.Address.Hex(), fr.PC)
+			prefix = "called from"
+			for j := len(fr.Calls) - 1; j >= 0; j-- {
+				fmt.Fprintf(&b, "    %-11s %s\n", prefix, formatLocation(fr.Calls[j]))
+			}
+		}
+	}
+	if len(r.Reads) > 0 {
+		b.WriteString("\nstorage reads:\n")
+		for _, s := range r.Reads {
+			fmt.Fprintf(&b, "    %s %s = %s\n", s.Address.Hex(), s.Slot.Hex(), s.Value.Hex())
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (r *Result) String() string {
+	var b strings.Builder
+	r.Write(&b)
+	return b.String()
+}
+
+func formatLocation(l Location) string {
+	if l.File == "" {
+		return "?"
+	}
+	return fmt.Sprintf("%s  %s", l, strings.TrimSpace(l.Text))
+}
+
+// frame is a call frame being executed.
+type frame struct {
+	addr     common.Address // of the code
+	storage  common.Address // whose storage is used
+	contract *srcmap.Contract
+	runtime  bool
+	pc       uint64
+	calls    []Location
+}
+
+// step is an executed instruction.  Its accessors are only valid
+// until the next step.
+type step struct {
+	depth int
+	pc    uint64
+	op    vm.OpCode
+
+	// stack returns the nth item from the top of the stack.
+	stack func(n int) *big.Int
+	// memory returns a copy of size bytes of memory from offset.
+	memory func(offset, size *big.Int) []byte
+	// sload returns the current value of the storage slot read by an
+	// SLOAD.
+	sload func(addr common.Address, slot common.Hash) common.Hash
+}
+
+// analyzer tracks the call stack of a transaction from its executed
+// instructions, and records failures and storage reads.
+type analyzer struct {
+	prog   *srcmap.Program
+	code   func(addr common.Address) []byte
+	res    *Result
+	frames []*frame
+	next   *frame // frame entered by the last call, if it has code
+	read   map[[2]common.Hash]bool
+}
+
+// newAnalyzer returns an analyzer of a transaction which executes code
+// at addr.
+func newAnalyzer(prog *srcmap.Program, hash common.Hash, addr common.Address, code []byte, codeAt func(common.Address) []byte) *analyzer {
+	a := &analyzer{
+		prog: prog,
+		code: codeAt,
+		res:  &Result{Hash: hash},
+		read: make(map[[2]common.Hash]bool),
+	}
+	a.frames = []*frame{a.newFrame(addr, addr, code)}
+	return a
+}
+
+func (a *analyzer) newFrame(addr, storage common.Address, code []byte) *frame {
+	f := &frame{addr: addr, storage: storage}
+	if a.prog != nil {
+		f.contract, f.runtime = a.prog.Match(code)
+	}
+	return f
+}
+
+// locate returns the source range of pc in f's code.
+func (a *analyzer) locate(f *frame, pc uint64) (srcmap.SourceRange, Location, bool) {
+	if f.contract == nil {
+		return srcmap.SourceRange{}, Location{}, false
+	}
+	r, ok := f.contract.Locate(f.runtime, pc)
+	if !ok {
+		return r, Location{}, false
+	}
+	src := a.prog.Source(r)
+	if src == nil {
+		return r, Location{}, true
+	}
+	line := src.Line(r.Start)
+	return r, Location{File: src.Path, Line: line, Text: src.LineText(line)}, true
+}
+
+func (a *analyzer) step(s step) {
+	switch {
+	case s.depth > len(a.frames):
+		f := a.next
+		if f == nil {
+			f = &frame{}
+		}
+		a.frames = append(a.frames, f)
+	case s.depth < len(a.frames) && s.depth > 0:
+		a.frames = a.frames[:s.depth]
+	}
+	a.next = nil
+	f := a.frames[len(a.frames)-1]
+	f.pc = s.pc
+
+	switch s.op {
+	case vm.JUMP:
+		// solc marks jumps into and out of internal functions.
+		if r, loc, ok := a.locate(f, s.pc); ok {
+			switch r.Jump {
+			case 'i':
+				f.calls = append(f.calls, loc)
+			case 'o':
+				if len(f.calls) > 0 {
+					f.calls = f.calls[:len(f.calls)-1]
+				}
+			}
+		}
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		to := common.BigToAddress(s.stack(1))
+		storage := to
+		if s.op == vm.CALLCODE || s.op == vm.DELEGATECALL {
+			storage = f.storage
+		}
+		a.next = a.newFrame(to, storage, a.code(to))
+	case vm.CREATE, vm.CREATE2:
+		a.next = a.newFrame(common.Address{}, common.Address{}, s.memory(s.stack(1), s.stack(2)))
+	case vm.SLOAD:
+		slot := common.BigToHash(s.stack(0))
+		key := [2]common.Hash{f.storage.Hash(), slot}
+		if !a.read[key] {
+			a.read[key] = true
+			a.res.Reads = append(a.res.Reads, StorageRead{f.storage, slot, s.sload(f.storage, slot)})
+		}
+	case vm.REVERT:
+		data := s.memory(s.stack(0), s.stack(1))
+		a.fail(s.op, vm.ErrExecutionReverted.Error(), Reason(data))
+	}
+}
+
+// fault records an error, other than a revert, of the current frame.
+func (a *analyzer) fault(op vm.OpCode, err string) {
+	a.fail(op, err, "")
+}
+
+func (a *analyzer) fail(op vm.OpCode, err, reason string) {
+	fail := Failure{Depth: len(a.frames), Op: op, Err: err, Reason: reason}
+	for _, f := range a.frames {
+		fr := Frame{Address: f.addr, PC: f.pc, Calls: append([]Location(nil), f.calls...)}
+		if f.contract != nil {
+			fr.Contract = f.contract.Name
+		}
+		_, fr.Location, _ = a.locate(f, f.pc)
+		fail.Stack = append(fail.Stack, fr)
+	}
+	a.res.Failures = append(a.res.Failures, fail)
+}
+
+// finish sets the outcome of the transaction.
+func (a *analyzer) finish(err string, ret []byte) *Result {
+	a.res.Failed = err != ""
+	a.res.Err = err
+	a.res.ReturnData = ret
+	if a.res.Failed {
+		a.res.Reason = Reason(ret)
+	}
+	return a.res
+}
@@ -0,0 +1,185 @@
+package txdebug
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/royalfork/usdx/pkg/srcmap"
+)
+
+func TestReason(t *testing.T) {
+	for _, tt := range []struct {
+		data string
+		want string
+	}{
+		{"", ""},
+		{"08c379a0" + // Error("nope")
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000004" +
+			"6e6f706500000000000000000000000000000000000000000000000000000000", "nope"},
+		{"4e487b71" + "0000000000000000000000000000000000000000000000000000000000000011", "panic: arithmetic overflow or underflow (0x11)"},
+		{"4e487b71" + "00000000000000000000000000000000000000000000000000000000000000ff", "panic: 0xff"},
+		{"deadbeef", "0xdeadbeef"},
+	} {
+		data, _ := hex.DecodeString(tt.data)
+		if got := Reason(data); got != tt.want {
+			t.Errorf("%s: want: %q, got: %q", tt.data, tt.want, got)
+		}
+	}
+}
+
+// src is the source of the contracts at addrA and addrB, whose code
+// is assembled by hand.  A calls B from an internal function, and
+// bubbles up B's revert.
+const src = `contract A {
+  function f() { helper(); }
+  function helper() { b.call("");
+    bubble(); }
+}
+contract B {
+  function g() { x; revert("nope"); }
+}
+`
+
+var (
+	addrA = common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	addrB = common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+
+	codeA = "6004" + "56" + "fe" + "5b" + // jump to helper
+		"6000600060006000600073" + addrB.Hex()[2:] + "5af150" + // call b
+		"3d60006000" + "3e" + "3d6000fd" // revert with b's revert data
+	codeB = "600754" + "50" + // sload 7
+		"6064601060003960646000fd" + // revert with Error("nope") appended to code
+		"08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"6e6f706500000000000000000000000000000000000000000000000000000000"
+)
+
+// srcMap returns n source map entries for the first occurrence of s
+// in src.
+func srcMap(s string, jump byte, n int) []string {
+	e := []string{fmt.Sprintf("%d:%d:0:%c", strings.Index(src, s), len(s), jump)}
+	for i := 1; i < n; i++ {
+		e = append(e, "")
+	}
+	return e
+}
+
+func TestDebug(t *testing.T) {
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "a.sol"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var mapA, mapB []string
+	mapA = append(mapA, srcMap("helper();", '-', 1)...)
+	mapA = append(mapA, srcMap("helper();", 'i', 1)...)
+	mapA = append(mapA, "-1:-1:-1:-")
+	mapA = append(mapA, srcMap(`b.call("")`, '-', 10)...)
+	mapA = append(mapA, srcMap("bubble();", '-', 7)...)
+	mapB = append(mapB, srcMap("x;", '-', 3)...)
+	mapB = append(mapB, srcMap(`revert("nope");`, '-', 7)...)
+	combined := fmt.Sprintf(`{
+		"contracts": {
+			"a.sol:A": {"bin": "", "bin-runtime": %q, "srcmap": "", "srcmap-runtime": %q},
+			"a.sol:B": {"bin": "", "bin-runtime": %q, "srcmap": "", "srcmap-runtime": %q}
+		},
+		"sourceList": ["a.sol"]
+	}`, codeA, strings.Join(mapA, ";"), codeB, strings.Join(mapB, ";"))
+	if err := ioutil.WriteFile(filepath.Join(dir, "combined.json"), []byte(combined), 0644); err != nil {
+		t.Fatal(err)
+	}
+	prog, err := srcmap.LoadCombined(filepath.Join(dir, "combined.json"), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	a, _ := hex.DecodeString(codeA)
+	b, _ := hex.DecodeString(codeB)
+	slot, val := common.BigToHash(big.NewInt(7)), common.BigToHash(big.NewInt(42))
+	chain := backends.NewSimulatedBackend(core.GenesisAlloc{
+		from:  {Balance: big.NewInt(1e18)},
+		addrA: {Code: a, Balance: new(big.Int)},
+		addrB: {Code: b, Balance: new(big.Int), Storage: map[common.Hash]common.Hash{slot: val}},
+	}, 10000000)
+	defer chain.Close()
+
+	tx, err := types.SignNewTx(key, types.NewEIP155Signer(big.NewInt(1337)), &types.LegacyTx{
+		Gas: 100000, GasPrice: new(big.Int), To: &addrA,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.SendTransaction(context.Background(), tx); err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+
+	res, err := Debug(chain.Blockchain(), tx.Hash(), prog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Failed || res.Err != "execution reverted" || res.Reason != "nope" {
+		t.Fatalf("unexpected result: failed: %v, err: %q, reason: %q", res.Failed, res.Err, res.Reason)
+	}
+
+	file := filepath.Join(dir, "a.sol")
+	helper := Location{file, 2, "  function f() { helper(); }"}
+	call := Location{file, 3, `  function helper() { b.call("");`}
+	want := []Failure{
+		{Depth: 2, Op: 0xfd, Err: "execution reverted", Reason: "nope", Stack: []Frame{
+			{addrA, "a.sol:A", 37, call, []Location{helper}},
+			{addrB, "a.sol:B", 15, Location{file, 7, `  function g() { x; revert("nope"); }`}, nil},
+		}},
+		{Depth: 1, Op: 0xfd, Err: "execution reverted", Reason: "nope", Stack: []Frame{
+			{addrA, "a.sol:A", 48, Location{file, 4, "    bubble(); }"}, []Location{helper}},
+		}},
+	}
+	if !reflect.DeepEqual(res.Failures, want) {
+		t.Errorf("want failures:\n%+v\ngot:\n%+v", want, res.Failures)
+	}
+	if want := []StorageRead{{addrB, slot, val}}; !reflect.DeepEqual(res.Reads, want) {
+		t.Errorf("want reads: %v, got: %v", want, res.Reads)
+	}
+
+	out := res.String()
+	for _, s := range []string{
+		"failed: execution reverted: nope",
+		"REVERT at depth 2: nope",
+		`a.sol:7  function g() { x; revert("nope"); } (a.sol:B ` + addrB.Hex() + " pc 15)",
+		`called from ` + file + `:3  function helper() { b.call("");`,
+		"called from " + file + ":2  function f() { helper(); }",
+		addrB.Hex() + " " + slot.Hex() + " = " + val.Hex(),
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output doesn't contain %q:\n%s", s, out)
+		}
+	}
+
+	// Without a program, only program counters are known.
+	res, err = Debug(chain.Blockchain(), tx.Hash(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.Failures[0].Stack[1]; got.Address != addrB || got.PC != 15 || got.Location != (Location{}) {
+		t.Errorf("unexpected frame: %+v", got)
+	}
+
+	if _, err := Debug(chain.Blockchain(), common.Hash{1}, prog); err != ErrNotFound {
+		t.Errorf("want err: %v, got: %v", ErrNotFound, err)
+	}
+}
@@ -0,0 +1,85 @@
+// Like the bindings, compiled with solc 0.8.2, so the bytecode in
+// combined.json matches USDXBin.
+//
+//go:generate solc --optimize --combined-json bin,bin-runtime,srcmap,srcmap-runtime --overwrite -o testdata ../../sol/Usdx.sol ../../sol/MockOracle.sol
+package usdx_test
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/royalfork/usdx/pkg/coverage"
+	"github.com/royalfork/usdx/pkg/srcmap"
+	"github.com/royalfork/usdx/pkg/usdxtest"
+)
+
+var (
+	solCover     = flag.String("sol.cover", "", "write lcov coverage of sol sources executed by tests to this file")
+	solCoverHTML = flag.String("sol.coverhtml", "", "write html coverage of sol sources executed by tests to this file")
+	// testdata/combined.json is generated with the bindings, and
+	// optimized as abigen --sol is, so its code matches USDXBin.
+	solCombined = flag.String("sol.combined", "testdata/combined.json", "solc combined json output used to map coverage to sol sources")
+)
+
+// collector records instructions executed by test chains when
+// -sol.cover or -sol.coverhtml is set.
+var collector *coverage.Collector
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	var prog *srcmap.Program
+	if *solCover != "" || *solCoverHTML != "" {
+		var err error
+		if prog, err = srcmap.LoadCombined(*solCombined, "."); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to load %s (run go generate): %v\n", *solCombined, err)
+			os.Exit(2)
+		}
+		collector = coverage.NewCollector()
+	}
+
+	code := m.Run()
+	if collector != nil {
+		if err := writeCoverage(collector.Report(prog)); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to write coverage: %v\n", err)
+			code = 1
+		}
+	}
+	os.Exit(code)
+}
+
+func writeCoverage(report *coverage.Report) error {
+	for _, out := range []struct {
+		path  string
+		write func(*os.File) error
+	}{
+		{*solCover, func(f *os.File) error { return report.WriteLCOV(f) }},
+		{*solCoverHTML, func(f *os.File) error { return report.WriteHTML(f) }},
+	} {
+		if out.path == "" {
+			continue
+		}
+		f, err := os.Create(out.path)
+		if err != nil {
+			return err
+		}
+		if err := out.write(f); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cover records coverage of transactions committed to chain, if
+// coverage is enabled.  Pass it to usdxtest.NewEnv, which attaches it
+// before deploying contracts.
+func cover(chain *usdxtest.Chain) {
+	if collector != nil {
+		collector.Attach(chain.Blockchain())
+	}
+}
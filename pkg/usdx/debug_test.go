@@ -0,0 +1,51 @@
+package usdx_test
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/royalfork/usdx/pkg/srcmap"
+	"github.com/royalfork/usdx/pkg/txdebug"
+)
+
+var (
+	progOnce sync.Once
+	prog     *srcmap.Program
+)
+
+// testProgram returns the program in -sol.combined, or nil if it
+// hasn't been generated.
+func testProgram() *srcmap.Program {
+	progOnce.Do(func() {
+		prog, _ = srcmap.LoadCombined(*solCombined, ".")
+	})
+	return prog
+}
+
+// explain resends a transaction which unexpectedly failed, and returns
+// why it failed.  Gas isn't estimated, so the transaction is mined
+// even though it reverts; unless auth sets a gas limit, it may use the
+// whole block, so it doesn't run out of gas before reaching its revert.
+func explain(chain testChain, auth *bind.TransactOpts, send func(*bind.TransactOpts) (*types.Transaction, error)) string {
+	bc, ok := chain.(interface{ Blockchain() *core.BlockChain })
+	if !ok {
+		return "chain can't be debugged"
+	}
+	opts := *auth
+	if opts.GasLimit == 0 {
+		opts.GasLimit = bc.Blockchain().CurrentBlock().GasLimit()
+	}
+	tx, err := send(&opts)
+	if err != nil {
+		return fmt.Sprintf("unable to resend tx: %v", err)
+	}
+	chain.Commit()
+	res, err := txdebug.Debug(bc.Blockchain(), tx.Hash(), testProgram())
+	if err != nil {
+		return fmt.Sprintf("unable to debug tx: %v", err)
+	}
+	return res.String()
+}
@@ -0,0 +1,349 @@
+//go:build go1.18
+// +build go1.18
+
+package usdx_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/royalfork/usdx/pkg/usdx"
+	"github.com/royalfork/usdx/pkg/usdxtest"
+)
+
+// fuzzEnv is a deployed chain shared by all iterations of a fuzz
+// target within a single fuzzing process.  Each iteration isolates
+// itself, so it starts from the state newFuzzEnv left, whatever
+// iterations ran before it.
+type fuzzEnv struct {
+	env      *usdxtest.Env
+	chain    testChain
+	accts    []usdxtest.Account
+	oracle   *MockOracle
+	contract *USDX
+	addr     common.Address
+	maxWei   *big.Int // largest amount accts[1] may mint with
+}
+
+func newFuzzEnv(f *testing.F) *fuzzEnv {
+	e := usdxtest.NewEnv(f, cover)
+	chain, accts, oracle, addr, contract := e.Chain, e.Accounts, e.Oracle, e.USDXAddr, e.USDX
+
+	bal, err := chain.BalanceAt(context.Background(), accts[1].Addr, nil)
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	return &fuzzEnv{
+		env:      e,
+		chain:    chain,
+		accts:    accts,
+		oracle:   oracle,
+		contract: contract,
+		addr:     addr,
+		maxWei:   bal.Div(bal, big.NewInt(1e6)),
+	}
+}
+
+// position is the on-chain state which the reference model operates on.
+type position struct {
+	locked, mint, withdrawable, balance, supply *big.Int
+	lots                                        []lotPos
+}
+
+// lotPos is a lot's locked eth and minted usdx.
+type lotPos struct {
+	locked, mint *big.Int
+}
+
+func (env *fuzzEnv) position(t *testing.T, addr common.Address) position {
+	t.Helper()
+	opts := &bind.CallOpts{}
+	acct, err := env.contract.Accounts(opts, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wd, err := env.contract.Withdrawable(opts, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bal, err := env.contract.BalanceOf(opts, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	supply, err := env.contract.TotalSupply(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lots []lotPos
+	for id := acct.FirstLot; id.Sign() != 0; {
+		l, err := env.contract.Lots(opts, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lots = append(lots, lotPos{l.Locked, l.Mint})
+		id = l.Next
+	}
+	return position{acct.Locked, acct.Mint, wd, bal, supply, lots}
+}
+
+func (p position) equal(o position) bool {
+	return p.locked.Cmp(o.locked) == 0 &&
+		p.mint.Cmp(o.mint) == 0 &&
+		p.withdrawable.Cmp(o.withdrawable) == 0 &&
+		p.balance.Cmp(o.balance) == 0 &&
+		p.supply.Cmp(o.supply) == 0 &&
+		p.lotsEqual(o)
+}
+
+func (p position) lotsEqual(o position) bool {
+	if len(p.lots) != len(o.lots) {
+		return false
+	}
+	for i, l := range p.lots {
+		if l.locked.Cmp(o.lots[i].locked) != 0 || l.mint.Cmp(o.lots[i].mint) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isolate reverts the chain when t completes, and returns the accounts
+// for t to send from.
+func (env *fuzzEnv) isolate(t *testing.T) []usdxtest.Account {
+	t.Helper()
+	return env.env.Isolate(t).Accounts
+}
+
+func (env *fuzzEnv) setRate(t *testing.T, r *big.Int) {
+	t.Helper()
+	if !env.chain.Succeed(env.oracle.SetLastRound(env.accts[0].Auth, zero, r, zero, zero, zero)) {
+		t.Fatalf("unable to set oracle round: rate=%v", r)
+	}
+}
+
+func (env *fuzzEnv) mint(acct usdxtest.Account, wei *big.Int) bool {
+	acct.Auth.Value = wei
+	defer func() { acct.Auth.Value = nil }()
+	return env.chain.Succeed((&USDXRaw{Contract: env.contract}).Transfer(acct.Auth))
+}
+
+// fuzzRate converts a fuzzer chosen rate into a non-negative int256.
+func fuzzRate(r uint64) *big.Int {
+	return new(big.Int).SetUint64(r >> 1)
+}
+
+// fuzzAmount converts fuzzer chosen bytes into an amount <= max.
+func fuzzAmount(b []byte, max *big.Int) *big.Int {
+	amt := new(big.Int).SetBytes(b)
+	if amt.Cmp(max) > 0 {
+		amt.Mod(amt, new(big.Int).Add(max, big.NewInt(1)))
+	}
+	return amt
+}
+
+// modelWeiToUSDX is the reference implementation of weiToUSDX.
+func modelWeiToUSDX(wei, r *big.Int) *big.Int {
+	out := new(big.Int).Mul(wei, r)
+	return out.Div(out, rate)
+}
+
+// modelMint returns the expected position after minting with wei.
+func modelMint(pre position, wei, r *big.Int) position {
+	toMint := modelWeiToUSDX(wei, r)
+	lots := pre.lots
+	if wei.Sign() > 0 {
+		lots = append(append([]lotPos(nil), pre.lots...), lotPos{wei, toMint})
+	}
+	return position{
+		locked:       new(big.Int).Add(pre.locked, wei),
+		mint:         new(big.Int).Add(pre.mint, toMint),
+		withdrawable: pre.withdrawable,
+		balance:      new(big.Int).Add(pre.balance, toMint),
+		supply:       new(big.Int).Add(pre.supply, toMint),
+		lots:         lots,
+	}
+}
+
+// modelUnlock returns the expected position after unlocking amt from
+// lots oldest first, and whether unlock should succeed.
+func modelUnlock(pre position, amt *big.Int) (position, bool) {
+	if pre.locked.Sign() == 0 {
+		return pre, false
+	}
+	burn := new(big.Int).Set(pre.mint)
+	if amt.Sign() != 0 && amt.Cmp(burn) < 0 {
+		burn.Set(amt)
+	}
+	if pre.balance.Cmp(burn) < 0 {
+		burn.Set(pre.balance)
+	}
+	if burn.Sign() == 0 {
+		return pre, false
+	}
+
+	unlockAmt := new(big.Int)
+	var lots []lotPos
+	left := new(big.Int).Set(burn)
+	for i, l := range pre.lots {
+		if left.Sign() == 0 {
+			lots = append(lots, pre.lots[i:]...)
+			break
+		}
+		if left.Cmp(l.mint) >= 0 {
+			// lot is removed
+			unlockAmt.Add(unlockAmt, l.locked)
+			left.Sub(left, l.mint)
+			continue
+		}
+		amt := new(big.Int).Mul(l.locked, left)
+		amt.Div(amt, l.mint)
+		unlockAmt.Add(unlockAmt, amt)
+		lots = append(lots, lotPos{new(big.Int).Sub(l.locked, amt), new(big.Int).Sub(l.mint, left)})
+		left.SetUint64(0)
+	}
+
+	post := position{
+		locked:       new(big.Int).Sub(pre.locked, unlockAmt),
+		mint:         new(big.Int).Sub(pre.mint, burn),
+		withdrawable: new(big.Int).Add(pre.withdrawable, unlockAmt),
+		balance:      new(big.Int).Sub(pre.balance, burn),
+		supply:       new(big.Int).Sub(pre.supply, burn),
+		lots:         lots,
+	}
+	return post, true
+}
+
+// modelCollect returns the expected position after collecting up to
+// limit of appreciation at rate r, from lots oldest first.
+func modelCollect(pre position, limit, r *big.Int) position {
+	appr := new(big.Int)
+	var lots []lotPos
+	for _, l := range pre.lots {
+		a := new(big.Int).Sub(modelWeiToUSDX(l.locked, r), l.mint)
+		if a.Sign() < 0 {
+			a.SetUint64(0)
+		}
+		if limit.Sign() > 0 {
+			if left := new(big.Int).Sub(limit, appr); left.Cmp(a) < 0 {
+				a = left
+			}
+		}
+		appr.Add(appr, a)
+		lots = append(lots, lotPos{l.locked, new(big.Int).Add(l.mint, a)})
+	}
+	return position{
+		locked:       pre.locked,
+		mint:         new(big.Int).Add(pre.mint, appr),
+		withdrawable: pre.withdrawable,
+		balance:      new(big.Int).Add(pre.balance, appr),
+		supply:       new(big.Int).Add(pre.supply, appr),
+		lots:         lots,
+	}
+}
+
+func FuzzMint(f *testing.F) {
+	env := newFuzzEnv(f)
+
+	f.Add([]byte{}, uint64(1000e8))
+	f.Add([]byte{0x01}, uint64(1))
+	f.Add(big.NewInt(99999999).Bytes(), uint64(2)) // mints 0 usdx
+	f.Add(eth.Bytes(), uint64(1<<63))
+
+	f.Fuzz(func(t *testing.T, weiB []byte, rateU uint64) {
+		acct := env.isolate(t)[1]
+		wei, r := fuzzAmount(weiB, env.maxWei), fuzzRate(rateU)
+		env.setRate(t, r)
+
+		pre := env.position(t, acct.Addr)
+		if !env.mint(acct, wei) {
+			t.Fatalf("unable to mint: wei=%v, rate=%v", wei, r)
+		}
+		if want, got := modelMint(pre, wei, r), env.position(t, acct.Addr); !want.equal(got) {
+			t.Fatalf("mint(wei=%v, rate=%v): want %+v, got %+v", wei, r, want, got)
+		}
+	})
+}
+
+func FuzzUnlock(f *testing.F) {
+	env := newFuzzEnv(f)
+
+	f.Add(eth.Bytes(), uint64(1000e8), []byte{}, []byte{})
+	f.Add(eth.Bytes(), uint64(1000e8), []byte{}, bigint(500, usdx).Bytes())
+	f.Add(big.NewInt(3).Bytes(), uint64(7e8), []byte{}, []byte{0x01})
+	f.Add(big.NewInt(1).Bytes(), uint64(1), []byte{}, []byte{}) // dust
+
+	f.Fuzz(func(t *testing.T, weiB []byte, rateU uint64, awayB, amtB []byte) {
+		accts := env.isolate(t)
+		acct, other := accts[1], accts[2]
+		wei, r := fuzzAmount(weiB, env.maxWei), fuzzRate(rateU)
+		env.setRate(t, r)
+		if !env.mint(acct, wei) {
+			t.Fatalf("unable to mint: wei=%v, rate=%v", wei, r)
+		}
+
+		// Move some usdx away, so balance may be less than mint.
+		bal, err := env.contract.BalanceOf(&bind.CallOpts{}, acct.Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if away := fuzzAmount(awayB, bal); away.Sign() > 0 {
+			if !env.chain.Succeed(env.contract.Transfer(acct.Auth, other.Addr, away)) {
+				t.Fatalf("unable to transfer %v usdx", away)
+			}
+		}
+
+		amt := fuzzAmount(amtB, math.MaxBig256)
+		pre := env.position(t, acct.Addr)
+		want, ok := modelUnlock(pre, amt)
+		if got := env.chain.Succeed(env.contract.Unlock(acct.Auth, amt)); got != ok {
+			var why string
+			if ok {
+				why = explain(env.chain, acct.Auth, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+					return env.contract.Unlock(opts, amt)
+				})
+			}
+			t.Fatalf("unlock(%v) from %+v: want success: %t, got: %t\n%s", amt, pre, ok, got, why)
+		}
+		if got := env.position(t, acct.Addr); !want.equal(got) {
+			t.Fatalf("unlock(%v) from %+v: want %+v, got %+v", amt, pre, want, got)
+		}
+	})
+}
+
+func FuzzAppreciation(f *testing.F) {
+	env := newFuzzEnv(f)
+
+	f.Add(eth.Bytes(), uint64(100e8), uint64(150e8), []byte{})
+	f.Add(eth.Bytes(), uint64(100e8), uint64(90e8), []byte{})
+	f.Add(eth.Bytes(), uint64(100e8), uint64(300e8), bigint(25, usdx).Bytes())
+	f.Add(big.NewInt(1).Bytes(), uint64(1), uint64(1<<63), []byte{})
+
+	f.Fuzz(func(t *testing.T, weiB []byte, rate1, rate2 uint64, limitB []byte) {
+		acct := env.isolate(t)[1]
+		wei := fuzzAmount(weiB, env.maxWei)
+		env.setRate(t, fuzzRate(rate1))
+		if !env.mint(acct, wei) {
+			t.Fatalf("unable to mint: wei=%v, rate=%v", wei, fuzzRate(rate1))
+		}
+
+		r := fuzzRate(rate2)
+		env.setRate(t, r)
+
+		limit := fuzzAmount(limitB, math.MaxBig256)
+		pre := env.position(t, acct.Addr)
+		if !env.chain.Succeed(env.contract.CollectAppreciation(acct.Auth, limit)) {
+			t.Fatalf("collectAppreciation(%v) from %+v failed\n%s", limit, pre, explain(env.chain, acct.Auth, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return env.contract.CollectAppreciation(opts, limit)
+			}))
+		}
+		if want, got := modelCollect(pre, limit, r), env.position(t, acct.Addr); !want.equal(got) {
+			t.Fatalf("collectAppreciation(%v) at rate %v from %+v: want %+v, got %+v", limit, r, pre, want, got)
+		}
+	})
+}
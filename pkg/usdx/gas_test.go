@@ -0,0 +1,190 @@
+package usdx_test
+
+import (
+	"context"
+	"flag"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/royalfork/usdx/pkg/gasreport"
+	. "github.com/royalfork/usdx/pkg/usdx"
+	"github.com/royalfork/usdx/pkg/usdxtest"
+)
+
+var (
+	gasUpdate    = flag.Bool("gas.update", false, "rewrite testdata/gas.golden with gas used by TestGas")
+	gasTolerance = flag.Float64("gas.tolerance", 0.01, "fraction by which gas used may exceed testdata/gas.golden")
+)
+
+// TestGas records gas used by each state changing method across
+// representative scenarios, and fails if gas used regresses from
+// testdata/gas.golden by more than -gas.tolerance.
+func TestGas(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contractAddr, contract := env.Chain, env.Accounts, env.Oracle, env.USDXAddr, env.USDX
+	opts := &bind.CallOpts{}
+
+	setRate := func(r int64) {
+		t.Helper()
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(r, rate), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+	}
+
+	report := gasreport.New()
+	record := func(method, scenario string, tx *types.Transaction, err error) {
+		t.Helper()
+		if !chain.Succeed(tx, err) {
+			t.Fatalf("%s/%s failed", method, scenario)
+		}
+		if err := report.RecordTx(chain, method, scenario, tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mint := func(acct usdxtest.Account, scenario string) {
+		t.Helper()
+		acct.Auth.Value = big.NewInt(params.Ether)
+		tx, err := (&USDXRaw{Contract: contract}).Transfer(acct.Auth)
+		acct.Auth.Value = nil
+		record("receive", scenario, tx, err)
+	}
+	// paid sends a transaction from acct with 1 ether.
+	paid := func(acct usdxtest.Account, send func(*bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+		acct.Auth.Value = big.NewInt(params.Ether)
+		defer func() { acct.Auth.Value = nil }()
+		return send(acct.Auth)
+	}
+
+	setRate(1000)
+	mint(accts[1], "newAccount")
+	mint(accts[1], "existingAccount")
+	setRate(1100)
+	mint(accts[1], "newLot")
+	setRate(1000)
+
+	tx, err := contract.Transfer(accts[1].Auth, accts[2].Addr, bigint(100, usdx))
+	record("transfer", "newHolder", tx, err)
+	tx, err = contract.Transfer(accts[1].Auth, accts[2].Addr, bigint(100, usdx))
+	record("transfer", "existingHolder", tx, err)
+
+	tx, err = contract.CollectAppreciation(accts[1].Auth, zero)
+	record("collectAppreciation", "none", tx, err)
+	setRate(1500)
+	tx, err = contract.CollectAppreciation(accts[1].Auth, bigint(100, usdx))
+	record("collectAppreciation", "limit", tx, err)
+	tx, err = contract.CollectAppreciation(accts[1].Auth, zero)
+	record("collectAppreciation", "all", tx, err)
+
+	tx, err = contract.TransferAcct(accts[1].Auth, accts[2].Addr)
+	record("transferAcct", "newAccount", tx, err)
+
+	tx, err = contract.Unlock(accts[2].Auth, bigint(100, usdx))
+	record("unlock", "partial", tx, err)
+	if !chain.Succeed(contract.Transfer(accts[1].Auth, accts[2].Addr, bigint(2800, usdx))) {
+		t.Fatal("unable to transfer")
+	}
+	tx, err = contract.Unlock(accts[2].Auth, zero)
+	record("unlock", "full", tx, err)
+
+	tx, err = contract.Withdraw(accts[2].Auth)
+	record("withdraw", "eoa", tx, err)
+
+	head, err := chain.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadline := new(big.Int).SetUint64(head.Time + 3600)
+	tx, err = paid(accts[3], func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.Mint(auth, zero, deadline)
+	})
+	record("mint", "newAccount", tx, err)
+	if !chain.Succeed(contract.SetLotSender(accts[4].Auth, accts[3].Addr, true)) {
+		t.Fatal("unable to set lot sender")
+	}
+	tx, err = paid(accts[3], func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.MintTo(auth, accts[4].Addr, zero, deadline)
+	})
+	record("mintTo", "newAccount", tx, err)
+	tx, err = paid(accts[3], func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.MintFor(auth, accts[4].Addr)
+	})
+	record("mintFor", "existingAccount", tx, err)
+
+	acct, err := contract.Accounts(opts, accts[3].Addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err = contract.UnlockLot(accts[3].Auth, acct.FirstLot, bigint(100, usdx))
+	record("unlockLot", "partial", tx, err)
+
+	q, err := QuoteRedeem(opts, chain, contractAddr, bigint(100, usdx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err = contract.Redeem(accts[3].Auth, q.USDX, q.IDs, zero)
+	record("redeem", "partial", tx, err)
+
+	execute := func(kind uint8, price, amount *big.Int, scenario string) {
+		t.Helper()
+		if !chain.Succeed(contract.PlaceOrder(accts[4].Auth, kind, price, amount)) {
+			t.Fatal("unable to place order")
+		}
+		id, err := contract.LastOrderId(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx, err := contract.ExecuteOrder(accts[5].Auth, id)
+		record("executeOrder", scenario, tx, err)
+	}
+	execute(StopLoss, bigint(2000, rate), bigint(100, usdx), "stopLoss")
+	setRate(1600)
+	execute(TakeProfit, bigint(1600, rate), zero, "takeProfit")
+
+	// More lots, so settling a batch of them is recorded.
+	for _, acct := range accts[5:8] {
+		if !chain.Succeed(paid(acct, (&USDXRaw{Contract: contract}).Transfer)) {
+			t.Fatal("unable to mint")
+		}
+	}
+	// Far enough past genesis that the feed's update can be days ago.
+	if err := chain.AdjustTime(7 * 24 * time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+	head, err = chain.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	updatedAt := new(big.Int).SetUint64(head.Time - uint64(4*24*time.Hour/time.Second))
+	if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(1600, rate), zero, updatedAt, zero)) {
+		t.Fatal("unable to set oracle round")
+	}
+	tx, err = contract.ShutdownStale(accts[5].Auth)
+	record("shutdownStale", "staleFeed", tx, err)
+
+	s, err := SettlementAt(opts, chain, contractAddr, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err = contract.SettleLots(accts[5].Auth, []*big.Int{s.Lots[0].ID})
+	record("settleLots", "oneLot", tx, err)
+	var rest []*big.Int
+	for _, l := range s.Lots[1:] {
+		rest = append(rest, l.ID)
+	}
+	tx, err = contract.SettleLots(accts[5].Auth, rest)
+	record("settleLots", "batch", tx, err)
+
+	tx, err = contract.Claim(accts[3].Auth, bigint(100, usdx))
+	record("claim", "partial", tx, err)
+	tx, err = contract.Claim(accts[3].Auth, zero)
+	record("claim", "all", tx, err)
+
+	gasreport.Check(t, report, filepath.Join("testdata", "gas.golden"), *gasTolerance, *gasUpdate)
+}
@@ -0,0 +1,579 @@
+package usdx_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/royalfork/usdx/pkg/snapshot"
+	. "github.com/royalfork/usdx/pkg/usdx"
+	"github.com/royalfork/usdx/pkg/usdxtest"
+)
+
+var (
+	invRuns  = flag.Int("invariant.runs", 20, "number of random call sequences checked by TestInvariants")
+	invSteps = flag.Int("invariant.steps", 60, "number of calls in each TestInvariants sequence")
+	invSeed  = flag.Int64("invariant.seed", 0, "TestInvariants seed (0 uses the current time)")
+)
+
+// reentrantWalletBin is the init code of a smart contract wallet
+// which behaves like walletBin, with 2 additions:
+//   - 32 bytes of calldata arms the wallet with a 4 byte function
+//     selector (right aligned).
+//   - When armed, receiving eth disarms the wallet and calls the
+//     selector on msg.sender (with a zero uint256 argument),
+//     ignoring the result.
+const reentrantWalletBin = "0x606a80600b6000396000f3" +
+	"3615603d57366020146035573660409003806040600037600060008260006020" +
+	"356000355af16033573d600060003e3d6000fd5b005b600035600155005b3460" +
+	"0054016000556001548015606857600060015560e01b60005260006000602460" +
+	"006000335af150005b00"
+
+// numActors is the number of actors in an invEnv: the reentrant
+// wallet, and an EOA for each of accounts 1-3.
+const numActors = 4
+
+type opKind int
+
+const (
+	opMint opKind = iota
+	opUnlock
+	opWithdraw
+	opCollect
+	opTransferAcct
+	opTransfer
+	opSetPrice
+	opArm
+	opRedeem
+	numOps
+)
+
+// op is a single step of an invariant test sequence.  Actors are
+// referenced by index so sequences can be replayed after a revert.
+type op struct {
+	kind     opKind
+	from, to int
+	amt      *big.Int // wei, usdx, limit or rate, depending on kind
+	method   string   // reentered method for opArm
+}
+
+func (o op) String() string {
+	switch o.kind {
+	case opMint:
+		return fmt.Sprintf("actor%d.mint(value=%v)", o.from, o.amt)
+	case opUnlock:
+		return fmt.Sprintf("actor%d.unlock(%v)", o.from, o.amt)
+	case opWithdraw:
+		return fmt.Sprintf("actor%d.withdraw()", o.from)
+	case opCollect:
+		return fmt.Sprintf("actor%d.collectAppreciation(%v)", o.from, o.amt)
+	case opTransferAcct:
+		return fmt.Sprintf("actor%d.transferAcct(actor%d)", o.from, o.to)
+	case opTransfer:
+		return fmt.Sprintf("actor%d.transfer(actor%d, %v)", o.from, o.to, o.amt)
+	case opSetPrice:
+		return fmt.Sprintf("oracle.setPrice(%v)", o.amt)
+	case opArm:
+		return fmt.Sprintf("actor%d.arm(%s)", o.from, o.method)
+	case opRedeem:
+		return fmt.Sprintf("actor%d.redeem(%v)", o.from, o.amt)
+	}
+	return "unknown"
+}
+
+// actor sends transactions to the usdx contract.  Actors are either
+// EOAs, or a reentrant wallet which is funded by an outside account.
+type actor struct {
+	addr     common.Address
+	send     func(value *big.Int, data []byte) bool
+	external bool // deposits are paid by an account other than addr
+	wallet   *bind.BoundContract
+
+	initBal   *big.Int
+	deposited *big.Int
+	basis     *big.Int // eth locked by, or transferred to, this actor
+}
+
+// testChain is the subset of usdxtest's chain used by invariant tests.
+type testChain interface {
+	bind.ContractBackend
+	Commit()
+	Succeed(*types.Transaction, error) bool
+	BalanceAt(ctx context.Context, addr common.Address, block *big.Int) (*big.Int, error)
+}
+
+// invEnv is a deployed chain which sequences are run against.  Each
+// sequence starts from base, the chain's state after setup.
+type invEnv struct {
+	chain     testChain
+	owner     usdxtest.Account
+	oracle    *MockOracle
+	contract  *USDX
+	positions *USDXPositionsCaller
+	addr      common.Address
+	abi       abi.ABI
+	actors    []*actor
+	base      *snapshot.Snapshot
+}
+
+func newInvEnv(t *testing.T) *invEnv {
+	t.Helper()
+	e := usdxtest.NewEnv(t, cover)
+	chain, accts, oracle, addr, contract := e.Chain, e.Accounts, e.Oracle, e.USDXAddr, e.USDX
+
+	if !chain.Succeed(oracle.SetLastRound(accts[0].Auth, zero, bigint(1000, rate), zero, zero, zero)) {
+		t.Fatal("unable to set oracle round")
+	}
+
+	positionsAddr, err := contract.Positions(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	positions, err := NewUSDXPositionsCaller(positionsAddr, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(USDXABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	env := &invEnv{
+		chain:     chain,
+		owner:     accts[0],
+		oracle:    oracle,
+		contract:  contract,
+		positions: positions,
+		addr:      addr,
+		abi:       parsed,
+	}
+
+	// Wallet actor is driven by the owner account.
+	walletAddr, _, wallet, err := bind.DeployContract(accts[0].Auth, abi.ABI{}, common.FromHex(reentrantWalletBin), chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+	env.actors = append(env.actors, &actor{
+		addr:     walletAddr,
+		external: true,
+		wallet:   wallet,
+		send: func(value *big.Int, data []byte) bool {
+			if value == nil {
+				value = zero
+			}
+			accts[0].Auth.Value = value
+			defer func() { accts[0].Auth.Value = nil }()
+			return chain.Succeed(wallet.RawTransact(accts[0].Auth, walletCall(addr, value, data)))
+		},
+	})
+
+	raw := bind.NewBoundContract(addr, abi.ABI{}, chain, chain, chain)
+	for i := 1; i < numActors; i++ {
+		acct := accts[i]
+		env.actors = append(env.actors, &actor{
+			addr: acct.Addr,
+			send: func(value *big.Int, data []byte) bool {
+				acct.Auth.Value = value
+				defer func() { acct.Auth.Value = nil }()
+				return chain.Succeed(raw.RawTransact(acct.Auth, data))
+			},
+		})
+	}
+
+	// Actors accept lots from every sender, so transferAcct may merge
+	// their accounts.
+	for _, a := range env.actors {
+		if !a.send(nil, env.pack("setLotSender", common.Address{}, true)) {
+			t.Fatal("unable to allow lot senders")
+		}
+	}
+	for _, a := range env.actors {
+		bal, err := chain.BalanceAt(context.Background(), a.addr, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.initBal = bal
+	}
+	env.base = e.Snapshot()
+	return env
+}
+
+// reset reverts the chain to base, and clears actors' bookkeeping.
+func (env *invEnv) reset() error {
+	if err := env.base.Revert(); err != nil {
+		return err
+	}
+	for _, a := range env.actors {
+		a.deposited = new(big.Int)
+		a.basis = new(big.Int)
+	}
+	return nil
+}
+
+func (env *invEnv) pack(method string, args ...interface{}) []byte {
+	data, err := env.abi.Pack(method, args...)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// apply executes o, and returns an error if a call which must
+// succeed fails.  Calls which are expected to revert are ignored.
+func (env *invEnv) apply(o op) error {
+	if o.kind == opSetPrice {
+		if !env.chain.Succeed(env.oracle.SetLastRound(env.owner.Auth, zero, o.amt, zero, zero, zero)) {
+			return fmt.Errorf("unable to set oracle price")
+		}
+		return nil
+	}
+
+	from := env.actors[o.from]
+	switch o.kind {
+	case opMint:
+		if from.send(o.amt, nil) {
+			from.deposited.Add(from.deposited, o.amt)
+			from.basis.Add(from.basis, o.amt)
+		}
+	case opUnlock:
+		from.send(nil, env.pack("unlock", o.amt))
+	case opWithdraw:
+		wd, err := env.contract.Withdrawable(&bind.CallOpts{}, from.addr)
+		if err != nil {
+			return err
+		}
+		if !from.send(nil, env.pack("withdraw")) && wd.Sign() > 0 {
+			return fmt.Errorf("withdraw of %v failed", wd)
+		}
+	case opCollect:
+		from.send(nil, env.pack("collectAppreciation", o.amt))
+	case opTransferAcct:
+		to := env.actors[o.to]
+		acct, err := env.contract.Accounts(&bind.CallOpts{}, from.addr)
+		if err != nil {
+			return err
+		}
+		if from.send(nil, env.pack("transferAcct", to.addr)) {
+			from.basis.Sub(from.basis, acct.Locked)
+			to.basis.Add(to.basis, acct.Locked)
+		}
+	case opTransfer:
+		from.send(nil, env.pack("transfer", env.actors[o.to].addr, o.amt))
+	case opArm:
+		if from.wallet == nil {
+			return nil
+		}
+		sel := common.LeftPadBytes(env.abi.Methods[o.method].ID, 32)
+		if !env.chain.Succeed(from.wallet.RawTransact(env.owner.Auth, sel)) {
+			return fmt.Errorf("unable to arm wallet")
+		}
+	case opRedeem:
+		bal, err := env.contract.BalanceOf(&bind.CallOpts{}, from.addr)
+		if err != nil {
+			return err
+		}
+		amt := o.amt
+		if amt.Cmp(bal) > 0 {
+			amt = bal
+		}
+		if amt.Sign() == 0 {
+			return nil
+		}
+		q, err := QuoteRedeem(&bind.CallOpts{}, env.chain, env.addr, amt)
+		if err == ErrNoRedemption {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		before, err := env.holdings()
+		if err != nil {
+			return err
+		}
+		if !from.send(nil, env.pack("redeem", q.USDX, q.IDs, q.ETH)) {
+			return fmt.Errorf("redemption of quote %v usdx for %v eth failed", q.USDX, q.ETH)
+		}
+		after, err := env.holdings()
+		if err != nil {
+			return err
+		}
+		// Redemptions move eth between actors, so each actor's basis
+		// is its change in holdings, which must sum to 0.
+		moved := new(big.Int)
+		for i, a := range env.actors {
+			d := new(big.Int).Sub(after[i], before[i])
+			a.basis.Add(a.basis, d)
+			moved.Add(moved, d)
+		}
+		if moved.Sign() != 0 {
+			return fmt.Errorf("redemption changed actors' eth by %v", moved)
+		}
+	}
+	return nil
+}
+
+// holdings returns each actor's locked and withdrawable eth.
+func (env *invEnv) holdings() ([]*big.Int, error) {
+	opts := &bind.CallOpts{}
+	held := make([]*big.Int, len(env.actors))
+	for i, a := range env.actors {
+		acct, err := env.contract.Accounts(opts, a.addr)
+		if err != nil {
+			return nil, err
+		}
+		wd, err := env.contract.Withdrawable(opts, a.addr)
+		if err != nil {
+			return nil, err
+		}
+		held[i] = new(big.Int).Add(acct.Locked, wd)
+	}
+	return held, nil
+}
+
+// check returns an error if any system invariant doesn't hold.
+func (env *invEnv) check() error {
+	ctx := context.Background()
+	opts := &bind.CallOpts{}
+
+	var (
+		locked   = new(big.Int)
+		withdraw = new(big.Int)
+		mint     = new(big.Int)
+		balances = new(big.Int)
+	)
+	for i, a := range env.actors {
+		acct, err := env.contract.Accounts(opts, a.addr)
+		if err != nil {
+			return err
+		}
+		wd, err := env.contract.Withdrawable(opts, a.addr)
+		if err != nil {
+			return err
+		}
+		bal, err := env.contract.BalanceOf(opts, a.addr)
+		if err != nil {
+			return err
+		}
+		ethBal, err := env.chain.BalanceAt(ctx, a.addr, nil)
+		if err != nil {
+			return err
+		}
+
+		locked.Add(locked, acct.Locked)
+		withdraw.Add(withdraw, wd)
+		mint.Add(mint, acct.Mint)
+		balances.Add(balances, bal)
+
+		if acct.Mint.Sign() > 0 && acct.Locked.Sign() == 0 {
+			return fmt.Errorf("actor%d has mint %v with no locked eth", i, acct.Mint)
+		}
+
+		// An account is the sum of its lots.
+		lotLocked, lotMint := new(big.Int), new(big.Int)
+		for id := acct.FirstLot; id.Sign() != 0; {
+			l, err := env.contract.Lots(opts, id)
+			if err != nil {
+				return err
+			}
+			if l.Owner != a.addr {
+				return fmt.Errorf("actor%d's lot %v is owned by %s", i, id, l.Owner.Hex())
+			}
+			if holder, err := env.positions.OwnerOf(opts, id); err != nil {
+				return err
+			} else if holder != a.addr {
+				return fmt.Errorf("actor%d's lot %v token is held by %s", i, id, holder.Hex())
+			}
+			lotLocked.Add(lotLocked, l.Locked)
+			lotMint.Add(lotMint, l.Mint)
+			id = l.Next
+		}
+		if lotLocked.Cmp(acct.Locked) != 0 || lotMint.Cmp(acct.Mint) != 0 {
+			return fmt.Errorf("actor%d's lots locked(%v), mint(%v) != account locked(%v), mint(%v)", i, lotLocked, lotMint, acct.Locked, acct.Mint)
+		}
+
+		// withdrawn + withdrawable + locked == basis, so an account
+		// can never unlock more eth than it locked.
+		withdrawn := new(big.Int).Sub(ethBal, a.initBal)
+		if !a.external {
+			withdrawn.Add(withdrawn, a.deposited)
+		}
+		if got := new(big.Int).Add(withdrawn, wd); got.Add(got, acct.Locked).Cmp(a.basis) != 0 {
+			return fmt.Errorf("actor%d withdrawn(%v) + withdrawable(%v) + locked(%v) != basis(%v)", i, withdrawn, wd, acct.Locked, a.basis)
+		}
+	}
+
+	contractBal, err := env.chain.BalanceAt(ctx, env.addr, nil)
+	if err != nil {
+		return err
+	}
+	if want := new(big.Int).Add(locked, withdraw); contractBal.Cmp(want) != 0 {
+		return fmt.Errorf("contract eth %v != locked(%v) + withdrawable(%v)", contractBal, locked, withdraw)
+	}
+	if totalLocked, err := env.contract.TotalLocked(opts); err != nil {
+		return err
+	} else if totalLocked.Cmp(locked) != 0 {
+		return fmt.Errorf("total locked %v != sum of account locked %v", totalLocked, locked)
+	}
+
+	supply, err := env.contract.TotalSupply(opts)
+	if err != nil {
+		return err
+	}
+	if supply.Cmp(mint) != 0 {
+		return fmt.Errorf("total supply %v != sum of account mint %v", supply, mint)
+	}
+	if supply.Cmp(balances) != 0 {
+		return fmt.Errorf("total supply %v != sum of balances %v", supply, balances)
+	}
+	return nil
+}
+
+// run resets the chain and runs ops against it, checking invariants
+// after every step.  It returns the index of the failing step, or -1.
+func (env *invEnv) run(t *testing.T, ops []op) (int, error) {
+	t.Helper()
+	if err := env.reset(); err != nil {
+		t.Fatalf("unable to reset chain: %v", err)
+	}
+	for i, o := range ops {
+		if err := env.apply(o); err != nil {
+			return i, err
+		}
+		if err := env.check(); err != nil {
+			return i, err
+		}
+	}
+	return -1, nil
+}
+
+// genSeq generates n random ops.
+func genSeq(r *rand.Rand, n int) []op {
+	price := bigint(1000, rate)
+	ops := make([]op, n)
+	for i := range ops {
+		o := op{
+			kind: opKind(r.Intn(int(numOps))),
+			from: r.Intn(numActors),
+			to:   r.Intn(numActors),
+		}
+		switch o.kind {
+		case opMint:
+			o.amt = randAmount(r, big.NewInt(params.Ether))
+		case opUnlock, opCollect, opTransfer, opRedeem:
+			o.amt = randAmount(r, bigint(1000, usdx))
+		case opSetPrice:
+			// Move price between -50% and +100%, with occasional
+			// extreme values.
+			switch r.Intn(10) {
+			case 0:
+				price = big.NewInt(1)
+			case 1:
+				price = bigint(1e6, rate)
+			default:
+				price = new(big.Int).Mul(price, big.NewInt(int64(50+r.Intn(150))))
+				price.Div(price, big.NewInt(100))
+				if price.Sign() == 0 {
+					price = big.NewInt(1)
+				}
+			}
+			o.amt = new(big.Int).Set(price)
+		case opArm:
+			o.method = []string{"withdraw", "unlock"}[r.Intn(2)]
+		}
+		ops[i] = o
+	}
+	return ops
+}
+
+// randAmount returns an amount around unit, favoring edge cases.
+func randAmount(r *rand.Rand, unit *big.Int) *big.Int {
+	switch r.Intn(8) {
+	case 0:
+		return new(big.Int)
+	case 1:
+		return big.NewInt(1 + r.Int63n(1000))
+	case 2:
+		return new(big.Int).Mul(unit, big.NewInt(1+r.Int63n(100)))
+	}
+	amt := new(big.Int).Mul(unit, big.NewInt(r.Int63n(1e6)))
+	return amt.Div(amt, big.NewInt(1e6))
+}
+
+// shrink reduces a failing sequence to a smaller sequence which still
+// fails, by removing chunks of ops, then simplifying op amounts.
+func shrink(t *testing.T, env *invEnv, ops []op) []op {
+	t.Helper()
+	fails := func(ops []op) bool {
+		i, _ := env.run(t, ops)
+		return i >= 0
+	}
+
+	for chunk := len(ops) / 2; chunk > 0; chunk /= 2 {
+		for start := 0; start+chunk <= len(ops); {
+			cand := append(append([]op{}, ops[:start]...), ops[start+chunk:]...)
+			if fails(cand) {
+				ops = cand
+			} else {
+				start += chunk
+			}
+		}
+	}
+
+	for i := range ops {
+		if ops[i].amt == nil {
+			continue
+		}
+		for ops[i].amt.Sign() > 0 {
+			cand := append([]op{}, ops...)
+			cand[i].amt = new(big.Int).Rsh(ops[i].amt, 1)
+			if !fails(cand) {
+				break
+			}
+			ops = cand
+		}
+	}
+	return ops
+}
+
+func formatSeq(ops []op) string {
+	var b strings.Builder
+	for i, o := range ops {
+		fmt.Fprintf(&b, "\t%d: %s\n", i, o)
+	}
+	return b.String()
+}
+
+// TestInvariants runs random sequences of calls from EOAs and a
+// reentrant contract wallet, and checks system invariants after each
+// call.  Failing sequences are shrunk to a minimal reproduction.
+func TestInvariants(t *testing.T) {
+	t.Parallel()
+	seed := *invSeed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	r := rand.New(rand.NewSource(seed))
+	env := newInvEnv(t)
+
+	for run := 0; run < *invRuns; run++ {
+		ops := genSeq(r, *invSteps)
+		i, err := env.run(t, ops)
+		if i < 0 {
+			continue
+		}
+		min := shrink(t, env, ops[:i+1])
+		_, minErr := env.run(t, min)
+		t.Fatalf("invariant violated (seed=%d, run=%d): %v\nminimal sequence: %v\n%s", seed, run, err, minErr, formatSeq(min))
+	}
+}
@@ -0,0 +1,123 @@
+package usdx
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Orders of unlockLots.
+const (
+	FIFO uint8 = iota // oldest lots first
+	LIFO              // newest lots first
+)
+
+// Lot is the eth locked, and usdx minted, by one mint into an account.
+// A lot's ID is also the id of its USDXPositions token.
+type Lot struct {
+	ID           *big.Int
+	Owner        common.Address // and holder of its token
+	Locked       *big.Int       // eth
+	Mint         *big.Int       // usdx
+	Appreciation *big.Int       // collectable usdx; nil if not read
+}
+
+// Unlocks returns the eth which unlocking amt usdx of l's mint would
+// unlock, as USDX computes it.
+func (l *Lot) Unlocks(amt *big.Int) *big.Int {
+	if amt.Cmp(l.Mint) >= 0 {
+		return new(big.Int).Set(l.Locked)
+	}
+	out := new(big.Int).Mul(l.Locked, amt)
+	return out.Quo(out, l.Mint)
+}
+
+// AccountLots returns addr's lots in the USDX contract at contract, in
+// the order they were added to addr's account, as of opts.
+func AccountLots(opts *bind.CallOpts, backend bind.ContractCaller, contract common.Address, addr common.Address) ([]Lot, error) {
+	caller, err := NewUSDXCaller(contract, backend)
+	if err != nil {
+		return nil, err
+	}
+	acct, err := caller.Accounts(opts, addr)
+	if err != nil {
+		return nil, fmt.Errorf("reading account: %v", err)
+	}
+	var lots []Lot
+	for id := acct.FirstLot; id.Sign() != 0; {
+		l, next, err := readLot(opts, caller, id)
+		if err != nil {
+			return nil, err
+		}
+		if l.Owner != addr {
+			return nil, fmt.Errorf("lot %v of %s is owned by %s", id, addr.Hex(), l.Owner.Hex())
+		}
+		lots = append(lots, *l)
+		id = next
+	}
+	return lots, nil
+}
+
+// LotByID returns lot id of the USDX contract at contract, as of opts.
+// ErrNoLot is returned if the lot doesn't exist, or has been fully
+// unlocked.
+func LotByID(opts *bind.CallOpts, backend bind.ContractCaller, contract common.Address, id *big.Int) (*Lot, error) {
+	caller, err := NewUSDXCaller(contract, backend)
+	if err != nil {
+		return nil, err
+	}
+	l, _, err := readLot(opts, caller, id)
+	return l, err
+}
+
+// lotPageSize is the number of lot ids allLots reads per call.
+const lotPageSize = 256
+
+// allLots returns every lot of caller's contract, by id, as of opts,
+// without their appreciation.  Lots are read a page of lotPageSize ids
+// per call.
+func allLots(opts *bind.CallOpts, caller *USDXCaller) ([]Lot, error) {
+	var lots []Lot
+	for start := big.NewInt(1); start.Sign() != 0; {
+		page, err := caller.LotPage(opts, start, big.NewInt(lotPageSize))
+		if err != nil {
+			return nil, fmt.Errorf("reading lots from %v: %v", start, err)
+		}
+		for i, id := range page.Ids {
+			lots = append(lots, Lot{ID: id, Owner: page.Owners[i], Locked: page.Locked[i], Mint: page.Mint[i]})
+		}
+		start = page.Next
+	}
+	return lots, nil
+}
+
+// ErrNoLot is returned by LotByID for lots which don't exist.
+var ErrNoLot = errors.New("usdx: no such lot")
+
+// readLot returns lot id, and the id of its owner's next lot.
+func readLot(opts *bind.CallOpts, caller *USDXCaller, id *big.Int) (*Lot, *big.Int, error) {
+	l, next, err := readRawLot(opts, caller, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if l.Appreciation, err = caller.LotAppreciation(opts, id); err != nil {
+		return nil, nil, fmt.Errorf("reading lot %v appreciation: %v", id, err)
+	}
+	return l, next, nil
+}
+
+// readRawLot is readLot, without the lot's appreciation, which can't
+// be read while the price feed is stale.
+func readRawLot(opts *bind.CallOpts, caller *USDXCaller, id *big.Int) (*Lot, *big.Int, error) {
+	l, err := caller.Lots(opts, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading lot %v: %v", id, err)
+	}
+	if l.Owner == (common.Address{}) {
+		return nil, nil, ErrNoLot
+	}
+	return &Lot{ID: id, Owner: l.Owner, Locked: l.Locked, Mint: l.Mint}, l.Next, nil
+}
@@ -0,0 +1,121 @@
+package usdx
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// feedUnit is 1 in the price feed's 8 decimals.
+var feedUnit = big.NewInt(1e8)
+
+// bpsUnit is 100%, in basis points.
+const bpsUnit = 10000
+
+// ErrStalePrice is returned by Quote and Rate when USDX would reject its price
+// feed's latest round as stale.
+var ErrStalePrice = errors.New("usdx: stale price feed")
+
+// ErrPaused is returned by Quote when USDX's guardian has paused
+// minting.
+var ErrPaused = errors.New("usdx: minting paused")
+
+// ErrMintLimit is returned by Quote when a mint would exceed USDX's
+// supply cap, or its mint limit for the current window.
+var ErrMintLimit = errors.New("usdx: mint over supply cap or mint limit")
+
+// Quote returns the usdx which minting with wei would mint, at the
+// latest rate of the price feed of the USDX contract at contract, as of
+// opts.  The rate may change before a mint is mined; pass MinUSDX of
+// the quote to Mint or MintTo to bound how much worse it may get.  If
+// the usdx is over Headroom, an error wrapping ErrMintLimit is returned.
+func Quote(opts *bind.CallOpts, backend bind.ContractCaller, contract common.Address, wei *big.Int) (*big.Int, error) {
+	caller, err := NewUSDXCaller(contract, backend)
+	if err != nil {
+		return nil, err
+	}
+	if paused, err := caller.Paused(opts); err != nil {
+		return nil, fmt.Errorf("reading paused: %v", err)
+	} else if paused {
+		return nil, ErrPaused
+	}
+	xrate, err := Rate(opts, backend, contract)
+	if err != nil {
+		return nil, err
+	}
+	out := new(big.Int).Mul(wei, xrate)
+	out.Quo(out, feedUnit)
+	room, err := Headroom(opts, backend, contract)
+	if err != nil {
+		return nil, err
+	}
+	if out.Cmp(room) > 0 {
+		return nil, fmt.Errorf("%w: minting %v usdx, headroom is %v", ErrMintLimit, out, room)
+	}
+	return out, nil
+}
+
+// Headroom returns the usdx which the USDX contract at contract would
+// mint, as of opts, before reaching its supply cap or its mint limit
+// for the current window; the max uint256 if it has neither.  Mint
+// limit windows may roll over before a mint is mined, adding headroom,
+// but other mints may use it up first.
+func Headroom(opts *bind.CallOpts, backend bind.ContractCaller, contract common.Address) (*big.Int, error) {
+	caller, err := NewUSDXCaller(contract, backend)
+	if err != nil {
+		return nil, err
+	}
+	room, err := caller.MintHeadroom(opts)
+	if err != nil {
+		return nil, fmt.Errorf("reading mint headroom: %v", err)
+	}
+	return room, nil
+}
+
+// Rate returns the eth/usd rate, in the price feed's 8 decimals, which
+// the USDX contract at contract would use as of opts.  ErrStalePrice is
+// returned if USDX would reject it as stale.
+func Rate(opts *bind.CallOpts, backend bind.ContractCaller, contract common.Address) (*big.Int, error) {
+	caller, err := NewUSDXCaller(contract, backend)
+	if err != nil {
+		return nil, err
+	}
+	feedAddr, err := caller.UsdPriceFeed(opts)
+	if err != nil {
+		return nil, fmt.Errorf("reading price feed: %v", err)
+	}
+	threshold, err := caller.PriceStalenessThreshold(opts)
+	if err != nil {
+		return nil, fmt.Errorf("reading staleness threshold: %v", err)
+	}
+	// MockOracle has the feed's latestRoundData.
+	feed, err := NewMockOracleCaller(feedAddr, backend)
+	if err != nil {
+		return nil, err
+	}
+	round, err := feed.LatestRoundData(opts)
+	if err != nil {
+		return nil, fmt.Errorf("reading price feed rate: %v", err)
+	}
+	if age := new(big.Int).Sub(round.RoundId, round.AnsweredInRound); age.Sign() < 0 || age.Cmp(threshold) > 0 {
+		return nil, ErrStalePrice
+	}
+	if round.Answer.Sign() < 0 {
+		return nil, fmt.Errorf("usdx: negative price feed rate %v", round.Answer)
+	}
+	return round.Answer, nil
+}
+
+// MinUSDX returns quote less a tolerance in basis points (ie: 50 is
+// 0.5%), rounded down; a mint of at least it succeeds unless the rate
+// falls by more than the tolerance.  Tolerances over 100% are 100%.
+func MinUSDX(quote *big.Int, toleranceBps uint64) *big.Int {
+	if toleranceBps > bpsUnit {
+		toleranceBps = bpsUnit
+	}
+	out := new(big.Int).Mul(quote, new(big.Int).SetUint64(bpsUnit-toleranceBps))
+	return out.Quo(out, big.NewInt(bpsUnit))
+}
@@ -1,23 +1,19 @@
 //go:generate abigen --sol ../../sol/MockOracle.sol --pkg usdx --out mock_oracle_abigen.go
 // NOTE: Using forked abigen from: https://github.com/ethereum/go-ethereum/pull/21938
-package usdx
+package usdx_test
 
 import (
 	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/royalfork/soltest"
+	"github.com/royalfork/usdx/pkg/usdxtest"
 )
 
 func TestMockOracle(t *testing.T) {
-	chain, accts := soltest.New()
-
-	_, _, contract, err := DeployMockOracle(accts[0].Auth, chain)
-	if err != nil {
-		t.Fatal(err)
-	}
-	chain.Commit()
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, contract := env.Chain, env.Accounts, env.Oracle
 
 	var (
 		zero = new(big.Int)
@@ -0,0 +1,57 @@
+package usdx
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Kinds of orders.
+const (
+	StopLoss   uint8 = iota // unlock once the rate falls to the price
+	TakeProfit              // collect appreciation once the rate rises to the price
+)
+
+// Order is an open stop-loss or take-profit order.
+type Order struct {
+	ID     *big.Int
+	Owner  common.Address
+	Kind   uint8
+	Price  *big.Int // eth/usd, in the price feed's 8 decimals
+	Amount *big.Int // usdx to unlock or collect, or 0 for all
+}
+
+// Triggered returns whether USDX would execute o at xrate, an eth/usd
+// rate in the price feed's 8 decimals.  An order which is triggered may
+// still fail to execute, such as when its owner has no usdx to unlock,
+// or no appreciation to collect.
+func (o *Order) Triggered(xrate *big.Int) bool {
+	if o.Kind == StopLoss {
+		return xrate.Cmp(o.Price) <= 0
+	}
+	return xrate.Cmp(o.Price) >= 0
+}
+
+// OrderByID returns order id of the USDX contract at contract, as of
+// opts.  ErrNoOrder is returned if the order doesn't exist, or has been
+// cancelled or executed.
+func OrderByID(opts *bind.CallOpts, backend bind.ContractCaller, contract common.Address, id *big.Int) (*Order, error) {
+	caller, err := NewUSDXCaller(contract, backend)
+	if err != nil {
+		return nil, err
+	}
+	o, err := caller.Orders(opts, id)
+	if err != nil {
+		return nil, fmt.Errorf("reading order %v: %v", id, err)
+	}
+	if o.Owner == (common.Address{}) {
+		return nil, ErrNoOrder
+	}
+	return &Order{ID: id, Owner: o.Owner, Kind: o.Kind, Price: o.Price, Amount: o.Amount}, nil
+}
+
+// ErrNoOrder is returned by OrderByID for orders which aren't open.
+var ErrNoOrder = errors.New("usdx: no such order")
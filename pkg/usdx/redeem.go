@@ -0,0 +1,108 @@
+package usdx
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrNoRedemption is returned by QuoteRedeem when no lot may be
+// redeemed from.
+var ErrNoRedemption = errors.New("usdx: no lots to redeem from")
+
+// Redemption is a quote of redeeming usdx for eth.
+type Redemption struct {
+	USDX *big.Int   // usdx redeemed
+	ETH  *big.Int   // eth credited to the redeemer
+	IDs  []*big.Int // lots redeemed from, in order
+}
+
+// RedemptionLots returns the lots of the USDX contract at contract
+// which redeem may redeem from, as of opts, in the order it requires:
+// most eth locked per usdx minted first, ties broken by id.  Redeem
+// only requires lots to be in order of their buckets, so any lots in
+// the same bucket may be redeemed from in any order.  Every lot is
+// read, a page of lots per call.
+func RedemptionLots(opts *bind.CallOpts, backend bind.ContractCaller, contract common.Address) ([]Lot, error) {
+	xrate, err := Rate(opts, backend, contract)
+	if err != nil {
+		return nil, err
+	}
+	return redemptionLots(opts, backend, contract, xrate)
+}
+
+// QuoteRedeem quotes redeeming amt usdx, or as much of it as lots may
+// be redeemed from, from the USDX contract at contract as of opts.
+// Pass the quote's USDX and IDs to Redeem, with its ETH less a
+// tolerance (see MinUSDX) as the minimum eth, to bound how much the
+// rate may fall before the redemption is mined.
+func QuoteRedeem(opts *bind.CallOpts, backend bind.ContractCaller, contract common.Address, amt *big.Int) (*Redemption, error) {
+	xrate, err := Rate(opts, backend, contract)
+	if err != nil {
+		return nil, err
+	}
+	if xrate.Sign() == 0 {
+		return nil, ErrNoRedemption
+	}
+	lots, err := redemptionLots(opts, backend, contract, xrate)
+	if err != nil {
+		return nil, err
+	}
+	r := &Redemption{USDX: new(big.Int), ETH: new(big.Int)}
+	left := new(big.Int).Set(amt)
+	for _, l := range lots {
+		if left.Sign() == 0 {
+			break
+		}
+		burn := l.Mint
+		if left.Cmp(burn) < 0 {
+			burn = left
+		}
+		eth := new(big.Int).Mul(burn, feedUnit)
+		eth.Quo(eth, xrate)
+		r.USDX.Add(r.USDX, burn)
+		r.ETH.Add(r.ETH, eth)
+		r.IDs = append(r.IDs, l.ID)
+		left.Sub(left, burn)
+	}
+	if r.USDX.Sign() == 0 {
+		return nil, ErrNoRedemption
+	}
+	return r, nil
+}
+
+// redemptionLots is RedemptionLots, at xrate.
+func redemptionLots(opts *bind.CallOpts, backend bind.ContractCaller, contract common.Address, xrate *big.Int) ([]Lot, error) {
+	caller, err := NewUSDXCaller(contract, backend)
+	if err != nil {
+		return nil, err
+	}
+	all, err := allLots(opts, caller)
+	if err != nil {
+		return nil, err
+	}
+	// Most eth per usdx first, which orders lots by their buckets,
+	// as redeem requires.
+	sort.SliceStable(all, func(i, j int) bool {
+		a := new(big.Int).Mul(all[i].Locked, all[j].Mint)
+		return a.Cmp(new(big.Int).Mul(all[j].Locked, all[i].Mint)) > 0
+	})
+	var lots []Lot
+	for _, l := range all {
+		// Lots minting nothing aren't redeemable.
+		if l.Mint.Sign() == 0 {
+			continue
+		}
+		// Nor is any lot after one not worth its mint, which every
+		// later lot also isn't.
+		value := new(big.Int).Mul(l.Locked, xrate)
+		if value.Quo(value, feedUnit).Cmp(l.Mint) < 0 {
+			break
+		}
+		lots = append(lots, l)
+	}
+	return lots, nil
+}
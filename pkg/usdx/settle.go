@@ -0,0 +1,132 @@
+package usdx
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// LotSettlement is a lot's settlement: its mint is settled for Debt
+// eth, paid to usdx holders, and the rest of its eth, Surplus, is
+// returned to its owner.
+type LotSettlement struct {
+	ID      *big.Int
+	Owner   common.Address
+	Debt    *big.Int // eth
+	Surplus *big.Int // eth
+}
+
+// Settlement is the outcome of shutting USDX down at a price, as
+// settleLots and claim compute it.
+type Settlement struct {
+	Price  *big.Int // eth/usd, in the price feed's 8 decimals
+	Supply *big.Int // usdx claiming Pool
+	Pool   *big.Int // eth, for usdx holders, once every lot is settled
+	Lots   []LotSettlement
+}
+
+// Settle returns the settlement of lots at price, when supply usdx is
+// outstanding and pool eth has been settled by lots settled earlier.
+func Settle(price, supply, pool *big.Int, lots []Lot) *Settlement {
+	s := &Settlement{Price: price, Supply: supply, Pool: new(big.Int).Set(pool)}
+	for _, l := range lots {
+		// Debts round up, in holders' favor.
+		debt := new(big.Int).Mul(l.Mint, feedUnit)
+		debt.Add(debt, price).Sub(debt, big.NewInt(1)).Quo(debt, price)
+		if debt.Cmp(l.Locked) > 0 {
+			debt.Set(l.Locked)
+		}
+		s.Pool.Add(s.Pool, debt)
+		s.Lots = append(s.Lots, LotSettlement{l.ID, l.Owner, debt, new(big.Int).Sub(l.Locked, debt)})
+	}
+	return s
+}
+
+// Claim returns the eth which claiming amt usdx would credit, if it's
+// the first claim.  Later claims of the same usdx get the same eth,
+// give or take rounding.
+func (s *Settlement) Claim(amt *big.Int) *big.Int {
+	if s.Supply.Sign() == 0 {
+		return new(big.Int)
+	}
+	out := new(big.Int).Mul(s.Pool, amt)
+	return out.Quo(out, s.Supply)
+}
+
+// Par returns the eth which amt usdx is worth at s's price.  Claims are
+// at par when the system is collateralized at the price, and at a
+// haircut otherwise.
+func (s *Settlement) Par(amt *big.Int) *big.Int {
+	out := new(big.Int).Mul(amt, feedUnit)
+	return out.Quo(out, s.Price)
+}
+
+// Surplus returns the eth returned to owner by the settlement.
+func (s *Settlement) Surplus(owner common.Address) *big.Int {
+	sum := new(big.Int)
+	for _, l := range s.Lots {
+		if l.Owner == owner {
+			sum.Add(sum, l.Surplus)
+		}
+	}
+	return sum
+}
+
+// Batches returns the ids of the lots s settles, which are yet to be
+// settled, in batches of at most n to pass to settleLots.
+func (s *Settlement) Batches(n int) [][]*big.Int {
+	var batches [][]*big.Int
+	for i := 0; i < len(s.Lots); i += n {
+		end := i + n
+		if end > len(s.Lots) {
+			end = len(s.Lots)
+		}
+		var ids []*big.Int
+		for _, l := range s.Lots[i:end] {
+			ids = append(ids, l.ID)
+		}
+		batches = append(batches, ids)
+	}
+	return batches
+}
+
+// SettlementAt returns the settlement of the USDX contract at contract
+// as of opts: at its settlement price, including lots already settled,
+// if it's shut down, and otherwise at price.  A nil price is the price
+// feed's latest rate.
+func SettlementAt(opts *bind.CallOpts, backend bind.ContractCaller, contract common.Address, price *big.Int) (*Settlement, error) {
+	caller, err := NewUSDXCaller(contract, backend)
+	if err != nil {
+		return nil, err
+	}
+	settled, err := caller.SettlementPrice(opts)
+	if err != nil {
+		return nil, fmt.Errorf("reading settlement price: %v", err)
+	}
+	pool := new(big.Int)
+	switch {
+	case settled.Sign() > 0:
+		price = settled
+		if pool, err = caller.SettlementPool(opts); err != nil {
+			return nil, fmt.Errorf("reading settlement pool: %v", err)
+		}
+	case price == nil:
+		if price, err = Rate(opts, backend, contract); err != nil {
+			return nil, err
+		}
+	}
+	if price.Sign() <= 0 {
+		return nil, fmt.Errorf("usdx: invalid settlement price %v", price)
+	}
+	supply, err := caller.TotalSupply(opts)
+	if err != nil {
+		return nil, fmt.Errorf("reading total supply: %v", err)
+	}
+	lots, err := allLots(opts, caller)
+	if err != nil {
+		return nil, err
+	}
+	return Settle(price, supply, pool, lots), nil
+}
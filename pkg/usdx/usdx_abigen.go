@@ -26,6 +26,168 @@ var (
 	_ = event.NewSubscription
 )
 
+// AddressABI is the input ABI used to generate the binding from.
+const AddressABI = "[]"
+
+// AddressBin is the compiled bytecode used for deploying new contracts.
+var AddressBin = "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea2646970667358221220dcd6daa0ead22d8214b5532c24b997a9fb57636d173c2ae78cd505bb8a1e246d64736f6c63430008150033"
+
+// DeployAddress deploys a new Ethereum contract, binding an instance of Address to it.
+func DeployAddress(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Address, error) {
+	parsed, err := abi.JSON(strings.NewReader(AddressABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(AddressBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Address{AddressCaller: AddressCaller{contract: contract}, AddressTransactor: AddressTransactor{contract: contract}, AddressFilterer: AddressFilterer{contract: contract}}, nil
+}
+
+// Address is an auto generated Go binding around an Ethereum contract.
+type Address struct {
+	AddressCaller     // Read-only binding to the contract
+	AddressTransactor // Write-only binding to the contract
+	AddressFilterer   // Log filterer for contract events
+}
+
+// AddressCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AddressCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AddressTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AddressTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AddressFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AddressFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AddressSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AddressSession struct {
+	Contract     *Address          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AddressCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AddressCallerSession struct {
+	Contract *AddressCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// AddressTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AddressTransactorSession struct {
+	Contract     *AddressTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// AddressRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AddressRaw struct {
+	Contract *Address // Generic contract binding to access the raw methods on
+}
+
+// AddressCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AddressCallerRaw struct {
+	Contract *AddressCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AddressTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AddressTransactorRaw struct {
+	Contract *AddressTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAddress creates a new instance of Address, bound to a specific deployed contract.
+func NewAddress(address common.Address, backend bind.ContractBackend) (*Address, error) {
+	contract, err := bindAddress(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Address{AddressCaller: AddressCaller{contract: contract}, AddressTransactor: AddressTransactor{contract: contract}, AddressFilterer: AddressFilterer{contract: contract}}, nil
+}
+
+// NewAddressCaller creates a new read-only instance of Address, bound to a specific deployed contract.
+func NewAddressCaller(address common.Address, caller bind.ContractCaller) (*AddressCaller, error) {
+	contract, err := bindAddress(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AddressCaller{contract: contract}, nil
+}
+
+// NewAddressTransactor creates a new write-only instance of Address, bound to a specific deployed contract.
+func NewAddressTransactor(address common.Address, transactor bind.ContractTransactor) (*AddressTransactor, error) {
+	contract, err := bindAddress(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AddressTransactor{contract: contract}, nil
+}
+
+// NewAddressFilterer creates a new log filterer instance of Address, bound to a specific deployed contract.
+func NewAddressFilterer(address common.Address, filterer bind.ContractFilterer) (*AddressFilterer, error) {
+	contract, err := bindAddress(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AddressFilterer{contract: contract}, nil
+}
+
+// bindAddress binds a generic wrapper to an already deployed contract.
+func bindAddress(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(AddressABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Address *AddressRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Address.Contract.AddressCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Address *AddressRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Address.Contract.AddressTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Address *AddressRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Address.Contract.AddressTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Address *AddressCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Address.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Address *AddressTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Address.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Address *AddressTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Address.Contract.contract.Transact(opts, method, params...)
+}
+
 // ContextABI is the input ABI used to generate the binding from.
 const ContextABI = "[]"
 
@@ -171,6 +333,187 @@ func (_Context *ContextTransactorRaw) Transact(opts *bind.TransactOpts, method s
 	return _Context.Contract.contract.Transact(opts, method, params...)
 }
 
+// ERC165ABI is the input ABI used to generate the binding from.
+const ERC165ABI = "[{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// ERC165FuncSigs maps the 4-byte function signature to its string representation.
+var ERC165FuncSigs = map[string]string{
+	"01ffc9a7": "supportsInterface(bytes4)",
+}
+
+// ERC165 is an auto generated Go binding around an Ethereum contract.
+type ERC165 struct {
+	ERC165Caller     // Read-only binding to the contract
+	ERC165Transactor // Write-only binding to the contract
+	ERC165Filterer   // Log filterer for contract events
+}
+
+// ERC165Caller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC165Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC165Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC165Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC165Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC165Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC165Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC165Session struct {
+	Contract     *ERC165           // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC165CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC165CallerSession struct {
+	Contract *ERC165Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// ERC165TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC165TransactorSession struct {
+	Contract     *ERC165Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC165Raw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC165Raw struct {
+	Contract *ERC165 // Generic contract binding to access the raw methods on
+}
+
+// ERC165CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC165CallerRaw struct {
+	Contract *ERC165Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC165TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC165TransactorRaw struct {
+	Contract *ERC165Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC165 creates a new instance of ERC165, bound to a specific deployed contract.
+func NewERC165(address common.Address, backend bind.ContractBackend) (*ERC165, error) {
+	contract, err := bindERC165(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC165{ERC165Caller: ERC165Caller{contract: contract}, ERC165Transactor: ERC165Transactor{contract: contract}, ERC165Filterer: ERC165Filterer{contract: contract}}, nil
+}
+
+// NewERC165Caller creates a new read-only instance of ERC165, bound to a specific deployed contract.
+func NewERC165Caller(address common.Address, caller bind.ContractCaller) (*ERC165Caller, error) {
+	contract, err := bindERC165(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC165Caller{contract: contract}, nil
+}
+
+// NewERC165Transactor creates a new write-only instance of ERC165, bound to a specific deployed contract.
+func NewERC165Transactor(address common.Address, transactor bind.ContractTransactor) (*ERC165Transactor, error) {
+	contract, err := bindERC165(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC165Transactor{contract: contract}, nil
+}
+
+// NewERC165Filterer creates a new log filterer instance of ERC165, bound to a specific deployed contract.
+func NewERC165Filterer(address common.Address, filterer bind.ContractFilterer) (*ERC165Filterer, error) {
+	contract, err := bindERC165(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC165Filterer{contract: contract}, nil
+}
+
+// bindERC165 binds a generic wrapper to an already deployed contract.
+func bindERC165(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ERC165ABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC165 *ERC165Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC165.Contract.ERC165Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC165 *ERC165Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC165.Contract.ERC165Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC165 *ERC165Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC165.Contract.ERC165Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC165 *ERC165CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC165.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC165 *ERC165TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC165.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC165 *ERC165TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC165.Contract.contract.Transact(opts, method, params...)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC165 *ERC165Caller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _ERC165.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC165 *ERC165Session) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC165.Contract.SupportsInterface(&_ERC165.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC165 *ERC165CallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC165.Contract.SupportsInterface(&_ERC165.CallOpts, interfaceId)
+}
+
 // ERC20ABI is the input ABI used to generate the binding from.
 const ERC20ABI = "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"subtractedValue\",\"type\":\"uint256\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
@@ -190,7 +533,7 @@ var ERC20FuncSigs = map[string]string{
 }
 
 // ERC20Bin is the compiled bytecode used for deploying new contracts.
-var ERC20Bin = "0x60806040523480156200001157600080fd5b5060405162000b7938038062000b798339810160408190526200003491620001c1565b81516200004990600390602085019062000068565b5080516200005f90600490602084019062000068565b5050506200027b565b828054620000769062000228565b90600052602060002090601f0160209004810192826200009a5760008555620000e5565b82601f10620000b557805160ff1916838001178555620000e5565b82800160010185558215620000e5579182015b82811115620000e5578251825591602001919060010190620000c8565b50620000f3929150620000f7565b5090565b5b80821115620000f35760008155600101620000f8565b600082601f8301126200011f578081fd5b81516001600160401b03808211156200013c576200013c62000265565b604051601f8301601f19908116603f0116810190828211818310171562000167576200016762000265565b8160405283815260209250868385880101111562000183578485fd5b8491505b83821015620001a6578582018301518183018401529082019062000187565b83821115620001b757848385830101525b9695505050505050565b60008060408385031215620001d4578182fd5b82516001600160401b0380821115620001eb578384fd5b620001f9868387016200010e565b935060208501519150808211156200020f578283fd5b506200021e858286016200010e565b9150509250929050565b6002810460018216806200023d57607f821691505b602082108114156200025f57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052604160045260246000fd5b6108ee806200028b6000396000f3fe608060405234801561001057600080fd5b50600436106100a95760003560e01c80633950935111610071578063395093511461012357806370a082311461013657806395d89b4114610149578063a457c2d714610151578063a9059cbb14610164578063dd62ed3e14610177576100a9565b806306fdde03146100ae578063095ea7b3146100cc57806318160ddd146100ef57806323b872dd14610101578063313ce56714610114575b600080fd5b6100b66101b0565b6040516100c391906107e5565b60405180910390f35b6100df6100da3660046107bc565b610242565b60405190151581526020016100c3565b6002545b6040519081526020016100c3565b6100df61010f366004610781565b610258565b604051601281526020016100c3565b6100df6101313660046107bc565b61030e565b6100f361014436600461072e565b610345565b6100b6610364565b6100df61015f3660046107bc565b610373565b6100df6101723660046107bc565b61040e565b6100f361018536600461074f565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205490565b6060600380546101bf90610867565b80601f01602080910402602001604051908101604052809291908181526020018280546101eb90610867565b80156102385780601f1061020d57610100808354040283529160200191610238565b820191906000526020600020905b81548152906001019060200180831161021b57829003601f168201915b5050505050905090565b600061024f33848461041b565b50600192915050565b600061026584848461053f565b6001600160a01b0384166000908152600160209081526040808320338452909152902054828110156102ef5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b60648201526084015b60405180910390fd5b61030385336102fe8685610850565b61041b565b506001949350505050565b3360008181526001602090815260408083206001600160a01b0387168452909152812054909161024f9185906102fe908690610838565b6001600160a01b0381166000908152602081905260409020545b919050565b6060600480546101bf90610867565b3360009081526001602090815260408083206001600160a01b0386168452909152812054828110156103f55760405162461bcd60e51b815260206004820152602560248201527f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f77604482015264207a65726f60d81b60648201526084016102e6565b61040433856102fe8685610850565b5060019392505050565b600061024f33848461053f565b6001600160a01b03831661047d5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b60648201526084016102e6565b6001600160a01b0382166104de5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b60648201526084016102e6565b6001600160a01b0383811660008181526001602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b6001600160a01b0383166105a35760405162461bcd60e51b815260206004820152602560248201527f45524332303a207472616e736665722066726f6d20746865207a65726f206164604482015264647265737360d81b60648201526084016102e6565b6001600160a01b0382166106055760405162461bcd60e51b815260206004820152602360248201527f45524332303a207472616e7366657220746f20746865207a65726f206164647260448201526265737360e81b60648201526084016102e6565b6001600160a01b0383166000908152602081905260409020548181101561067d5760405162461bcd60e51b815260206004820152602660248201527f45524332303a207472616e7366657220616d6f756e7420657863656564732062604482015265616c616e636560d01b60648201526084016102e6565b6106878282610850565b6001600160a01b0380861660009081526020819052604080822093909355908516815290812080548492906106bd908490610838565b92505081905550826001600160a01b0316846001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8460405161070991815260200190565b60405180910390a350505050565b80356001600160a01b038116811461035f57600080fd5b60006020828403121561073f578081fd5b61074882610717565b9392505050565b60008060408385031215610761578081fd5b61076a83610717565b915061077860208401610717565b90509250929050565b600080600060608486031215610795578081fd5b61079e84610717565b92506107ac60208501610717565b9150604084013590509250925092565b600080604083850312156107ce578182fd5b6107d783610717565b946020939093013593505050565b6000602080835283518082850152825b81811015610811578581018301518582016040015282016107f5565b818111156108225783604083870101525b50601f01601f1916929092016040019392505050565b6000821982111561084b5761084b6108a2565b500190565b600082821015610862576108626108a2565b500390565b60028104600182168061087b57607f821691505b6020821081141561089c57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fdfea264697066735822122094715c6d4bff958814f213991909f9060c50f790602bc0a9ffaba15451ceb2f764736f6c63430008020033"
+var ERC20Bin = "0x60806040523480156200001157600080fd5b5060405162000bd438038062000bd483398101604081905262000034916200011f565b600362000042838262000218565b50600462000051828262000218565b505050620002e4565b634e487b7160e01b600052604160045260246000fd5b600082601f8301126200008257600080fd5b81516001600160401b03808211156200009f576200009f6200005a565b604051601f8301601f19908116603f01168101908282118183101715620000ca57620000ca6200005a565b81604052838152602092508683858801011115620000e757600080fd5b600091505b838210156200010b5785820183015181830184015290820190620000ec565b600093810190920192909252949350505050565b600080604083850312156200013357600080fd5b82516001600160401b03808211156200014b57600080fd5b620001598683870162000070565b935060208501519150808211156200017057600080fd5b506200017f8582860162000070565b9150509250929050565b600181811c908216806200019e57607f821691505b602082108103620001bf57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200021357600081815260208120601f850160051c81016020861015620001ee5750805b601f850160051c820191505b818110156200020f57828155600101620001fa565b5050505b505050565b81516001600160401b038111156200023457620002346200005a565b6200024c8162000245845462000189565b84620001c5565b602080601f8311600181146200028457600084156200026b5750858301515b600019600386901b1c1916600185901b1785556200020f565b600085815260208120601f198616915b82811015620002b55788860151825594840194600190910190840162000294565b5085821015620002d45787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6108e080620002f46000396000f3fe608060405234801561001057600080fd5b50600436106100a95760003560e01c80633950935111610071578063395093511461012357806370a082311461013657806395d89b411461015f578063a457c2d714610167578063a9059cbb1461017a578063dd62ed3e1461018d57600080fd5b806306fdde03146100ae578063095ea7b3146100cc57806318160ddd146100ef57806323b872dd14610101578063313ce56714610114575b600080fd5b6100b66101c6565b6040516100c3919061070f565b60405180910390f35b6100df6100da366004610779565b610258565b60405190151581526020016100c3565b6002545b6040519081526020016100c3565b6100df61010f3660046107a3565b61026f565b604051601281526020016100c3565b6100df610131366004610779565b610325565b6100f36101443660046107df565b6001600160a01b031660009081526020819052604090205490565b6100b661035c565b6100df610175366004610779565b61036b565b6100df610188366004610779565b610406565b6100f361019b366004610801565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205490565b6060600380546101d590610834565b80601f016020809104026020016040519081016040528092919081815260200182805461020190610834565b801561024e5780601f106102235761010080835404028352916020019161024e565b820191906000526020600020905b81548152906001019060200180831161023157829003601f168201915b5050505050905090565b6000610265338484610413565b5060015b92915050565b600061027c848484610537565b6001600160a01b0384166000908152600160209081526040808320338452909152902054828110156103065760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b60648201526084015b60405180910390fd5b61031a85336103158685610884565b610413565b506001949350505050565b3360008181526001602090815260408083206001600160a01b03871684529091528120549091610265918590610315908690610897565b6060600480546101d590610834565b3360009081526001602090815260408083206001600160a01b0386168452909152812054828110156103ed5760405162461bcd60e51b815260206004820152602560248201527f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f77604482015264207a65726f60d81b60648201526084016102fd565b6103fc33856103158685610884565b5060019392505050565b6000610265338484610537565b6001600160a01b0383166104755760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b60648201526084016102fd565b6001600160a01b0382166104d65760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b60648201526084016102fd565b6001600160a01b0383811660008181526001602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b6001600160a01b03831661059b5760405162461bcd60e51b815260206004820152602560248201527f45524332303a207472616e736665722066726f6d20746865207a65726f206164604482015264647265737360d81b60648201526084016102fd565b6001600160a01b0382166105fd5760405162461bcd60e51b815260206004820152602360248201527f45524332303a207472616e7366657220746f20746865207a65726f206164647260448201526265737360e81b60648201526084016102fd565b6001600160a01b038316600090815260208190526040902054818110156106755760405162461bcd60e51b815260206004820152602660248201527f45524332303a207472616e7366657220616d6f756e7420657863656564732062604482015265616c616e636560d01b60648201526084016102fd565b61067f8282610884565b6001600160a01b0380861660009081526020819052604080822093909355908516815290812080548492906106b5908490610897565b92505081905550826001600160a01b0316846001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8460405161070191815260200190565b60405180910390a350505050565b600060208083528351808285015260005b8181101561073c57858101830151858201604001528201610720565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b038116811461077457600080fd5b919050565b6000806040838503121561078c57600080fd5b6107958361075d565b946020939093013593505050565b6000806000606084860312156107b857600080fd5b6107c18461075d565b92506107cf6020850161075d565b9150604084013590509250925092565b6000602082840312156107f157600080fd5b6107fa8261075d565b9392505050565b6000806040838503121561081457600080fd5b61081d8361075d565b915061082b6020840161075d565b90509250929050565b600181811c9082168061084857607f821691505b60208210810361086857634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b818103818111156102695761026961086e565b808201808211156102695761026961086e56fea26469706673582212205e6d55ccaa22ba9a2963bf5ec37191b8a6000a755883ed535ec43693c72e091c64736f6c63430008150033"
 
 // DeployERC20 deploys a new Ethereum contract, binding an instance of ERC20 to it.
 func DeployERC20(auth *bind.TransactOpts, backend bind.ContractBackend, name_ string, symbol_ string) (common.Address, *types.Transaction, *ERC20, error) {
@@ -947,117 +1290,141 @@ func (_ERC20 *ERC20Filterer) ParseTransfer(log types.Log) (*ERC20Transfer, error
 	return event, nil
 }
 
-// IERC20ABI is the input ABI used to generate the binding from.
-const IERC20ABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+// ERC721ABI is the input ABI used to generate the binding from.
+const ERC721ABI = "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
-// IERC20FuncSigs maps the 4-byte function signature to its string representation.
-var IERC20FuncSigs = map[string]string{
-	"dd62ed3e": "allowance(address,address)",
+// ERC721FuncSigs maps the 4-byte function signature to its string representation.
+var ERC721FuncSigs = map[string]string{
 	"095ea7b3": "approve(address,uint256)",
 	"70a08231": "balanceOf(address)",
-	"18160ddd": "totalSupply()",
-	"a9059cbb": "transfer(address,uint256)",
+	"081812fc": "getApproved(uint256)",
+	"e985e9c5": "isApprovedForAll(address,address)",
+	"06fdde03": "name()",
+	"6352211e": "ownerOf(uint256)",
+	"42842e0e": "safeTransferFrom(address,address,uint256)",
+	"b88d4fde": "safeTransferFrom(address,address,uint256,bytes)",
+	"a22cb465": "setApprovalForAll(address,bool)",
+	"01ffc9a7": "supportsInterface(bytes4)",
+	"95d89b41": "symbol()",
+	"c87b56dd": "tokenURI(uint256)",
 	"23b872dd": "transferFrom(address,address,uint256)",
 }
 
-// IERC20 is an auto generated Go binding around an Ethereum contract.
-type IERC20 struct {
-	IERC20Caller     // Read-only binding to the contract
-	IERC20Transactor // Write-only binding to the contract
-	IERC20Filterer   // Log filterer for contract events
+// ERC721Bin is the compiled bytecode used for deploying new contracts.
+var ERC721Bin = "0x60806040523480156200001157600080fd5b50604051620015003803806200150083398101604081905262000034916200011f565b600062000042838262000218565b50600162000051828262000218565b505050620002e4565b634e487b7160e01b600052604160045260246000fd5b600082601f8301126200008257600080fd5b81516001600160401b03808211156200009f576200009f6200005a565b604051601f8301601f19908116603f01168101908282118183101715620000ca57620000ca6200005a565b81604052838152602092508683858801011115620000e757600080fd5b600091505b838210156200010b5785820183015181830184015290820190620000ec565b600093810190920192909252949350505050565b600080604083850312156200013357600080fd5b82516001600160401b03808211156200014b57600080fd5b620001598683870162000070565b935060208501519150808211156200017057600080fd5b506200017f8582860162000070565b9150509250929050565b600181811c908216806200019e57607f821691505b602082108103620001bf57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200021357600081815260208120601f850160051c81016020861015620001ee5750805b601f850160051c820191505b818110156200020f57828155600101620001fa565b5050505b505050565b81516001600160401b038111156200023457620002346200005a565b6200024c8162000245845462000189565b84620001c5565b602080601f8311600181146200028457600084156200026b5750858301515b600019600386901b1c1916600185901b1785556200020f565b600085815260208120601f198616915b82811015620002b55788860151825594840194600190910190840162000294565b5085821015620002d45787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61120c80620002f46000396000f3fe608060405234801561001057600080fd5b50600436106100cf5760003560e01c80636352211e1161008c578063a22cb46511610066578063a22cb465146101b3578063b88d4fde146101c6578063c87b56dd146101d9578063e985e9c5146101ec57600080fd5b80636352211e1461017757806370a082311461018a57806395d89b41146101ab57600080fd5b806301ffc9a7146100d457806306fdde03146100fc578063081812fc14610111578063095ea7b31461013c57806323b872dd1461015157806342842e0e14610164575b600080fd5b6100e76100e2366004610d30565b6101ff565b60405190151581526020015b60405180910390f35b610104610251565b6040516100f39190610d9d565b61012461011f366004610db0565b6102e3565b6040516001600160a01b0390911681526020016100f3565b61014f61014a366004610de5565b61037d565b005b61014f61015f366004610e0f565b610492565b61014f610172366004610e0f565b6104c3565b610124610185366004610db0565b6104de565b61019d610198366004610e4b565b610555565b6040519081526020016100f3565b6101046105dc565b61014f6101c1366004610e66565b6105eb565b61014f6101d4366004610eb8565b6106af565b6101046101e7366004610db0565b6106e7565b6100e76101fa366004610f94565b6107cf565b60006001600160e01b031982166380ac58cd60e01b148061023057506001600160e01b03198216635b5e139f60e01b145b8061024b57506301ffc9a760e01b6001600160e01b03198316145b92915050565b60606000805461026090610fc7565b80601f016020809104026020016040519081016040528092919081815260200182805461028c90610fc7565b80156102d95780601f106102ae576101008083540402835291602001916102d9565b820191906000526020600020905b8154815290600101906020018083116102bc57829003601f168201915b5050505050905090565b6000818152600260205260408120546001600160a01b03166103615760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a20617070726f76656420717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b60648201526084015b60405180910390fd5b506000908152600460205260409020546001600160a01b031690565b6000610388826104de565b9050806001600160a01b0316836001600160a01b0316036103f55760405162461bcd60e51b815260206004820152602160248201527f4552433732313a20617070726f76616c20746f2063757272656e74206f776e656044820152603960f91b6064820152608401610358565b336001600160a01b0382161480610411575061041181336107cf565b6104835760405162461bcd60e51b815260206004820152603860248201527f4552433732313a20617070726f76652063616c6c6572206973206e6f74206f7760448201527f6e6572206e6f7220617070726f76656420666f7220616c6c00000000000000006064820152608401610358565b61048d83836107fd565b505050565b61049c338261086b565b6104b85760405162461bcd60e51b815260040161035890611001565b61048d838383610942565b61048d838383604051806020016040528060008152506106af565b6000818152600260205260408120546001600160a01b03168061024b5760405162461bcd60e51b815260206004820152602960248201527f4552433732313a206f776e657220717565727920666f72206e6f6e657869737460448201526832b73a103a37b5b2b760b91b6064820152608401610358565b60006001600160a01b0382166105c05760405162461bcd60e51b815260206004820152602a60248201527f4552433732313a2062616c616e636520717565727920666f7220746865207a65604482015269726f206164647265737360b01b6064820152608401610358565b506001600160a01b031660009081526003602052604090205490565b60606001805461026090610fc7565b336001600160a01b038316036106435760405162461bcd60e51b815260206004820152601960248201527f4552433732313a20617070726f766520746f2063616c6c6572000000000000006044820152606401610358565b3360008181526005602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915590519081529192917f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a35050565b6106b9338361086b565b6106d55760405162461bcd60e51b815260040161035890611001565b6106e184848484610ae2565b50505050565b6000818152600260205260409020546060906001600160a01b03166107665760405162461bcd60e51b815260206004820152602f60248201527f4552433732314d657461646174613a2055524920717565727920666f72206e6f60448201526e3732bc34b9ba32b73a103a37b5b2b760891b6064820152608401610358565b600061077d60408051602081019091526000815290565b9050600081511161079d57604051806020016040528060008152506107c8565b806107a784610b15565b6040516020016107b8929190611052565b6040516020818303038152906040525b9392505050565b6001600160a01b03918216600090815260056020908152604080832093909416825291909152205460ff1690565b600081815260046020526040902080546001600160a01b0319166001600160a01b0384169081179091558190610832826104de565b6001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45050565b6000818152600260205260408120546001600160a01b03166108e45760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a206f70657261746f7220717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b6064820152608401610358565b60006108ef836104de565b9050806001600160a01b0316846001600160a01b0316148061092a5750836001600160a01b031661091f846102e3565b6001600160a01b0316145b8061093a575061093a81856107cf565b949350505050565b826001600160a01b0316610955826104de565b6001600160a01b0316146109bd5760405162461bcd60e51b815260206004820152602960248201527f4552433732313a207472616e73666572206f6620746f6b656e2074686174206960448201526839903737ba1037bbb760b91b6064820152608401610358565b6001600160a01b038216610a1f5760405162461bcd60e51b8152602060048201526024808201527f4552433732313a207472616e7366657220746f20746865207a65726f206164646044820152637265737360e01b6064820152608401610358565b610a2a6000826107fd565b6001600160a01b0383166000908152600360205260408120805460019290610a53908490611097565b90915550506001600160a01b0382166000908152600360205260408120805460019290610a819084906110aa565b909155505060008181526002602052604080822080546001600160a01b0319166001600160a01b0386811691821790925591518493918716917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4505050565b610aed848484610942565b610af984848484610c16565b6106e15760405162461bcd60e51b8152600401610358906110bd565b606081600003610b3c5750506040805180820190915260018152600360fc1b602082015290565b8160005b8115610b665780610b508161110f565b9150610b5f9050600a8361113e565b9150610b40565b60008167ffffffffffffffff811115610b8157610b81610ea2565b6040519080825280601f01601f191660200182016040528015610bab576020820181803683370190505b5090505b841561093a57610bc0600183611097565b9150610bcd600a86611152565b610bd89060306110aa565b60f81b818381518110610bed57610bed611166565b60200101906001600160f81b031916908160001a905350610c0f600a8661113e565b9450610baf565b60006001600160a01b0384163b15610d0c57604051630a85bd0160e11b81526001600160a01b0385169063150b7a0290610c5a90339089908890889060040161117c565b6020604051808303816000875af1925050508015610c95575060408051601f3d908101601f19168201909252610c92918101906111b9565b60015b610cf2573d808015610cc3576040519150601f19603f3d011682016040523d82523d6000602084013e610cc8565b606091505b508051600003610cea5760405162461bcd60e51b8152600401610358906110bd565b805181602001fd5b6001600160e01b031916630a85bd0160e11b14905061093a565b506001949350505050565b6001600160e01b031981168114610d2d57600080fd5b50565b600060208284031215610d4257600080fd5b81356107c881610d17565b60005b83811015610d68578181015183820152602001610d50565b50506000910152565b60008151808452610d89816020860160208601610d4d565b601f01601f19169290920160200192915050565b6020815260006107c86020830184610d71565b600060208284031215610dc257600080fd5b5035919050565b80356001600160a01b0381168114610de057600080fd5b919050565b60008060408385031215610df857600080fd5b610e0183610dc9565b946020939093013593505050565b600080600060608486031215610e2457600080fd5b610e2d84610dc9565b9250610e3b60208501610dc9565b9150604084013590509250925092565b600060208284031215610e5d57600080fd5b6107c882610dc9565b60008060408385031215610e7957600080fd5b610e8283610dc9565b915060208301358015158114610e9757600080fd5b809150509250929050565b634e487b7160e01b600052604160045260246000fd5b60008060008060808587031215610ece57600080fd5b610ed785610dc9565b9350610ee560208601610dc9565b925060408501359150606085013567ffffffffffffffff80821115610f0957600080fd5b818701915087601f830112610f1d57600080fd5b813581811115610f2f57610f2f610ea2565b604051601f8201601f19908116603f01168101908382118183101715610f5757610f57610ea2565b816040528281528a6020848701011115610f7057600080fd5b82602086016020830137600060208483010152809550505050505092959194509250565b60008060408385031215610fa757600080fd5b610fb083610dc9565b9150610fbe60208401610dc9565b90509250929050565b600181811c90821680610fdb57607f821691505b602082108103610ffb57634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526031908201527f4552433732313a207472616e736665722063616c6c6572206973206e6f74206f6040820152701ddb995c881b9bdc88185c1c1c9bdd9959607a1b606082015260800190565b60008351611064818460208801610d4d565b835190830190611078818360208801610d4d565b01949350505050565b634e487b7160e01b600052601160045260246000fd5b8181038181111561024b5761024b611081565b8082018082111561024b5761024b611081565b60208082526032908201527f4552433732313a207472616e7366657220746f206e6f6e20455243373231526560408201527131b2b4bb32b91034b6b83632b6b2b73a32b960711b606082015260800190565b60006001820161112157611121611081565b5060010190565b634e487b7160e01b600052601260045260246000fd5b60008261114d5761114d611128565b500490565b60008261116157611161611128565b500690565b634e487b7160e01b600052603260045260246000fd5b6001600160a01b03858116825284166020820152604081018390526080606082018190526000906111af90830184610d71565b9695505050505050565b6000602082840312156111cb57600080fd5b81516107c881610d1756fea26469706673582212200656e3b4fae96321ae45354c92a05feb950db247083b44f9c5a293a7e34201d464736f6c63430008150033"
+
+// DeployERC721 deploys a new Ethereum contract, binding an instance of ERC721 to it.
+func DeployERC721(auth *bind.TransactOpts, backend bind.ContractBackend, name_ string, symbol_ string) (common.Address, *types.Transaction, *ERC721, error) {
+	parsed, err := abi.JSON(strings.NewReader(ERC721ABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(ERC721Bin), backend, name_, symbol_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC721{ERC721Caller: ERC721Caller{contract: contract}, ERC721Transactor: ERC721Transactor{contract: contract}, ERC721Filterer: ERC721Filterer{contract: contract}}, nil
 }
 
-// IERC20Caller is an auto generated read-only Go binding around an Ethereum contract.
-type IERC20Caller struct {
+// ERC721 is an auto generated Go binding around an Ethereum contract.
+type ERC721 struct {
+	ERC721Caller     // Read-only binding to the contract
+	ERC721Transactor // Write-only binding to the contract
+	ERC721Filterer   // Log filterer for contract events
+}
+
+// ERC721Caller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC721Caller struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// IERC20Transactor is an auto generated write-only Go binding around an Ethereum contract.
-type IERC20Transactor struct {
+// ERC721Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC721Transactor struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// IERC20Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
-type IERC20Filterer struct {
+// ERC721Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC721Filterer struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// IERC20Session is an auto generated Go binding around an Ethereum contract,
+// ERC721Session is an auto generated Go binding around an Ethereum contract,
 // with pre-set call and transact options.
-type IERC20Session struct {
-	Contract     *IERC20           // Generic contract binding to set the session for
+type ERC721Session struct {
+	Contract     *ERC721           // Generic contract binding to set the session for
 	CallOpts     bind.CallOpts     // Call options to use throughout this session
 	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
 }
 
-// IERC20CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// ERC721CallerSession is an auto generated read-only Go binding around an Ethereum contract,
 // with pre-set call options.
-type IERC20CallerSession struct {
-	Contract *IERC20Caller // Generic contract caller binding to set the session for
+type ERC721CallerSession struct {
+	Contract *ERC721Caller // Generic contract caller binding to set the session for
 	CallOpts bind.CallOpts // Call options to use throughout this session
 }
 
-// IERC20TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// ERC721TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
 // with pre-set transact options.
-type IERC20TransactorSession struct {
-	Contract     *IERC20Transactor // Generic contract transactor binding to set the session for
+type ERC721TransactorSession struct {
+	Contract     *ERC721Transactor // Generic contract transactor binding to set the session for
 	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
 }
 
-// IERC20Raw is an auto generated low-level Go binding around an Ethereum contract.
-type IERC20Raw struct {
-	Contract *IERC20 // Generic contract binding to access the raw methods on
+// ERC721Raw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC721Raw struct {
+	Contract *ERC721 // Generic contract binding to access the raw methods on
 }
 
-// IERC20CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
-type IERC20CallerRaw struct {
-	Contract *IERC20Caller // Generic read-only contract binding to access the raw methods on
+// ERC721CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC721CallerRaw struct {
+	Contract *ERC721Caller // Generic read-only contract binding to access the raw methods on
 }
 
-// IERC20TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
-type IERC20TransactorRaw struct {
-	Contract *IERC20Transactor // Generic write-only contract binding to access the raw methods on
+// ERC721TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC721TransactorRaw struct {
+	Contract *ERC721Transactor // Generic write-only contract binding to access the raw methods on
 }
 
-// NewIERC20 creates a new instance of IERC20, bound to a specific deployed contract.
-func NewIERC20(address common.Address, backend bind.ContractBackend) (*IERC20, error) {
-	contract, err := bindIERC20(address, backend, backend, backend)
+// NewERC721 creates a new instance of ERC721, bound to a specific deployed contract.
+func NewERC721(address common.Address, backend bind.ContractBackend) (*ERC721, error) {
+	contract, err := bindERC721(address, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
-	return &IERC20{IERC20Caller: IERC20Caller{contract: contract}, IERC20Transactor: IERC20Transactor{contract: contract}, IERC20Filterer: IERC20Filterer{contract: contract}}, nil
+	return &ERC721{ERC721Caller: ERC721Caller{contract: contract}, ERC721Transactor: ERC721Transactor{contract: contract}, ERC721Filterer: ERC721Filterer{contract: contract}}, nil
 }
 
-// NewIERC20Caller creates a new read-only instance of IERC20, bound to a specific deployed contract.
-func NewIERC20Caller(address common.Address, caller bind.ContractCaller) (*IERC20Caller, error) {
-	contract, err := bindIERC20(address, caller, nil, nil)
+// NewERC721Caller creates a new read-only instance of ERC721, bound to a specific deployed contract.
+func NewERC721Caller(address common.Address, caller bind.ContractCaller) (*ERC721Caller, error) {
+	contract, err := bindERC721(address, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	return &IERC20Caller{contract: contract}, nil
+	return &ERC721Caller{contract: contract}, nil
 }
 
-// NewIERC20Transactor creates a new write-only instance of IERC20, bound to a specific deployed contract.
-func NewIERC20Transactor(address common.Address, transactor bind.ContractTransactor) (*IERC20Transactor, error) {
-	contract, err := bindIERC20(address, nil, transactor, nil)
+// NewERC721Transactor creates a new write-only instance of ERC721, bound to a specific deployed contract.
+func NewERC721Transactor(address common.Address, transactor bind.ContractTransactor) (*ERC721Transactor, error) {
+	contract, err := bindERC721(address, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
-	return &IERC20Transactor{contract: contract}, nil
+	return &ERC721Transactor{contract: contract}, nil
 }
 
-// NewIERC20Filterer creates a new log filterer instance of IERC20, bound to a specific deployed contract.
-func NewIERC20Filterer(address common.Address, filterer bind.ContractFilterer) (*IERC20Filterer, error) {
-	contract, err := bindIERC20(address, nil, nil, filterer)
+// NewERC721Filterer creates a new log filterer instance of ERC721, bound to a specific deployed contract.
+func NewERC721Filterer(address common.Address, filterer bind.ContractFilterer) (*ERC721Filterer, error) {
+	contract, err := bindERC721(address, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
-	return &IERC20Filterer{contract: contract}, nil
+	return &ERC721Filterer{contract: contract}, nil
 }
 
-// bindIERC20 binds a generic wrapper to an already deployed contract.
-func bindIERC20(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
-	parsed, err := abi.JSON(strings.NewReader(IERC20ABI))
+// bindERC721 binds a generic wrapper to an already deployed contract.
+func bindERC721(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ERC721ABI))
 	if err != nil {
 		return nil, err
 	}
@@ -1068,46 +1435,46 @@ func bindIERC20(address common.Address, caller bind.ContractCaller, transactor b
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_IERC20 *IERC20Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _IERC20.Contract.IERC20Caller.contract.Call(opts, result, method, params...)
+func (_ERC721 *ERC721Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC721.Contract.ERC721Caller.contract.Call(opts, result, method, params...)
 }
 
 // Transfer initiates a plain transaction to move funds to the contract, calling
 // its default method if one is available.
-func (_IERC20 *IERC20Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _IERC20.Contract.IERC20Transactor.contract.Transfer(opts)
+func (_ERC721 *ERC721Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721.Contract.ERC721Transactor.contract.Transfer(opts)
 }
 
 // Transact invokes the (paid) contract method with params as input values.
-func (_IERC20 *IERC20Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _IERC20.Contract.IERC20Transactor.contract.Transact(opts, method, params...)
+func (_ERC721 *ERC721Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC721.Contract.ERC721Transactor.contract.Transact(opts, method, params...)
 }
 
 // Call invokes the (constant) contract method with params as input values and
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_IERC20 *IERC20CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _IERC20.Contract.contract.Call(opts, result, method, params...)
+func (_ERC721 *ERC721CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC721.Contract.contract.Call(opts, result, method, params...)
 }
 
 // Transfer initiates a plain transaction to move funds to the contract, calling
 // its default method if one is available.
-func (_IERC20 *IERC20TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _IERC20.Contract.contract.Transfer(opts)
+func (_ERC721 *ERC721TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721.Contract.contract.Transfer(opts)
 }
 
 // Transact invokes the (paid) contract method with params as input values.
-func (_IERC20 *IERC20TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _IERC20.Contract.contract.Transact(opts, method, params...)
+func (_ERC721 *ERC721TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC721.Contract.contract.Transact(opts, method, params...)
 }
 
-// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function allowance(address owner, address spender) view returns(uint256)
-func (_IERC20 *IERC20Caller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC721 *ERC721Caller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
 	var out []interface{}
-	err := _IERC20.contract.Call(opts, &out, "allowance", owner, spender)
+	err := _ERC721.contract.Call(opts, &out, "balanceOf", owner)
 
 	if err != nil {
 		return *new(*big.Int), err
@@ -1119,148 +1486,345 @@ func (_IERC20 *IERC20Caller) Allowance(opts *bind.CallOpts, owner common.Address
 
 }
 
-// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function allowance(address owner, address spender) view returns(uint256)
-func (_IERC20 *IERC20Session) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
-	return _IERC20.Contract.Allowance(&_IERC20.CallOpts, owner, spender)
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC721 *ERC721Session) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ERC721.Contract.BalanceOf(&_ERC721.CallOpts, owner)
 }
 
-// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function allowance(address owner, address spender) view returns(uint256)
-func (_IERC20 *IERC20CallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
-	return _IERC20.Contract.Allowance(&_IERC20.CallOpts, owner, spender)
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC721 *ERC721CallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ERC721.Contract.BalanceOf(&_ERC721.CallOpts, owner)
 }
 
-// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
 //
-// Solidity: function balanceOf(address account) view returns(uint256)
-func (_IERC20 *IERC20Caller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC721 *ERC721Caller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
 	var out []interface{}
-	err := _IERC20.contract.Call(opts, &out, "balanceOf", account)
+	err := _ERC721.contract.Call(opts, &out, "getApproved", tokenId)
 
 	if err != nil {
-		return *new(*big.Int), err
+		return *new(common.Address), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
 
 	return out0, err
 
 }
 
-// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
 //
-// Solidity: function balanceOf(address account) view returns(uint256)
-func (_IERC20 *IERC20Session) BalanceOf(account common.Address) (*big.Int, error) {
-	return _IERC20.Contract.BalanceOf(&_IERC20.CallOpts, account)
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC721 *ERC721Session) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ERC721.Contract.GetApproved(&_ERC721.CallOpts, tokenId)
 }
 
-// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
 //
-// Solidity: function balanceOf(address account) view returns(uint256)
-func (_IERC20 *IERC20CallerSession) BalanceOf(account common.Address) (*big.Int, error) {
-	return _IERC20.Contract.BalanceOf(&_IERC20.CallOpts, account)
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC721 *ERC721CallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ERC721.Contract.GetApproved(&_ERC721.CallOpts, tokenId)
 }
 
-// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
 //
-// Solidity: function totalSupply() view returns(uint256)
-func (_IERC20 *IERC20Caller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC721 *ERC721Caller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
 	var out []interface{}
-	err := _IERC20.contract.Call(opts, &out, "totalSupply")
+	err := _ERC721.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
 
 	if err != nil {
-		return *new(*big.Int), err
+		return *new(bool), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
 
 	return out0, err
 
 }
 
-// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
 //
-// Solidity: function totalSupply() view returns(uint256)
-func (_IERC20 *IERC20Session) TotalSupply() (*big.Int, error) {
-	return _IERC20.Contract.TotalSupply(&_IERC20.CallOpts)
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC721 *ERC721Session) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ERC721.Contract.IsApprovedForAll(&_ERC721.CallOpts, owner, operator)
 }
 
-// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
 //
-// Solidity: function totalSupply() view returns(uint256)
-func (_IERC20 *IERC20CallerSession) TotalSupply() (*big.Int, error) {
-	return _IERC20.Contract.TotalSupply(&_IERC20.CallOpts)
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC721 *ERC721CallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ERC721.Contract.IsApprovedForAll(&_ERC721.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC721 *ERC721Caller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC721.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC721 *ERC721Session) Name() (string, error) {
+	return _ERC721.Contract.Name(&_ERC721.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC721 *ERC721CallerSession) Name() (string, error) {
+	return _ERC721.Contract.Name(&_ERC721.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC721 *ERC721Caller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _ERC721.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC721 *ERC721Session) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ERC721.Contract.OwnerOf(&_ERC721.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC721 *ERC721CallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ERC721.Contract.OwnerOf(&_ERC721.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC721 *ERC721Caller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _ERC721.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC721 *ERC721Session) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC721.Contract.SupportsInterface(&_ERC721.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC721 *ERC721CallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC721.Contract.SupportsInterface(&_ERC721.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC721 *ERC721Caller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC721.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC721 *ERC721Session) Symbol() (string, error) {
+	return _ERC721.Contract.Symbol(&_ERC721.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC721 *ERC721CallerSession) Symbol() (string, error) {
+	return _ERC721.Contract.Symbol(&_ERC721.CallOpts)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC721 *ERC721Caller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _ERC721.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC721 *ERC721Session) TokenURI(tokenId *big.Int) (string, error) {
+	return _ERC721.Contract.TokenURI(&_ERC721.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC721 *ERC721CallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _ERC721.Contract.TokenURI(&_ERC721.CallOpts, tokenId)
 }
 
 // Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
 //
-// Solidity: function approve(address spender, uint256 amount) returns(bool)
-func (_IERC20 *IERC20Transactor) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20.contract.Transact(opts, "approve", spender, amount)
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ERC721 *ERC721Transactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.contract.Transact(opts, "approve", to, tokenId)
 }
 
 // Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
 //
-// Solidity: function approve(address spender, uint256 amount) returns(bool)
-func (_IERC20 *IERC20Session) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20.Contract.Approve(&_IERC20.TransactOpts, spender, amount)
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ERC721 *ERC721Session) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.Approve(&_ERC721.TransactOpts, to, tokenId)
 }
 
 // Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
 //
-// Solidity: function approve(address spender, uint256 amount) returns(bool)
-func (_IERC20 *IERC20TransactorSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20.Contract.Approve(&_IERC20.TransactOpts, spender, amount)
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ERC721 *ERC721TransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.Approve(&_ERC721.TransactOpts, to, tokenId)
 }
 
-// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
 //
-// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
-func (_IERC20 *IERC20Transactor) Transfer(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20.contract.Transact(opts, "transfer", recipient, amount)
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721 *ERC721Transactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
 }
 
-// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
 //
-// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
-func (_IERC20 *IERC20Session) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20.Contract.Transfer(&_IERC20.TransactOpts, recipient, amount)
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721 *ERC721Session) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.SafeTransferFrom(&_ERC721.TransactOpts, from, to, tokenId)
 }
 
-// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
 //
-// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
-func (_IERC20 *IERC20TransactorSession) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20.Contract.Transfer(&_IERC20.TransactOpts, recipient, amount)
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721 *ERC721TransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.SafeTransferFrom(&_ERC721.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_ERC721 *ERC721Transactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _ERC721.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, _data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_ERC721 *ERC721Session) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _ERC721.Contract.SafeTransferFrom0(&_ERC721.TransactOpts, from, to, tokenId, _data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_ERC721 *ERC721TransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _ERC721.Contract.SafeTransferFrom0(&_ERC721.TransactOpts, from, to, tokenId, _data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC721 *ERC721Transactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC721 *ERC721Session) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721.Contract.SetApprovalForAll(&_ERC721.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC721 *ERC721TransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721.Contract.SetApprovalForAll(&_ERC721.TransactOpts, operator, approved)
 }
 
 // TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
 //
-// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
-func (_IERC20 *IERC20Transactor) TransferFrom(opts *bind.TransactOpts, sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20.contract.Transact(opts, "transferFrom", sender, recipient, amount)
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721 *ERC721Transactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.contract.Transact(opts, "transferFrom", from, to, tokenId)
 }
 
 // TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
 //
-// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
-func (_IERC20 *IERC20Session) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20.Contract.TransferFrom(&_IERC20.TransactOpts, sender, recipient, amount)
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721 *ERC721Session) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.TransferFrom(&_ERC721.TransactOpts, from, to, tokenId)
 }
 
 // TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
 //
-// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
-func (_IERC20 *IERC20TransactorSession) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20.Contract.TransferFrom(&_IERC20.TransactOpts, sender, recipient, amount)
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721 *ERC721TransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.TransferFrom(&_ERC721.TransactOpts, from, to, tokenId)
 }
 
-// IERC20ApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the IERC20 contract.
-type IERC20ApprovalIterator struct {
-	Event *IERC20Approval // Event containing the contract specifics and raw log
+// ERC721ApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the ERC721 contract.
+type ERC721ApprovalIterator struct {
+	Event *ERC721Approval // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1274,7 +1838,7 @@ type IERC20ApprovalIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *IERC20ApprovalIterator) Next() bool {
+func (it *ERC721ApprovalIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1283,7 +1847,7 @@ func (it *IERC20ApprovalIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(IERC20Approval)
+			it.Event = new(ERC721Approval)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1298,7 +1862,7 @@ func (it *IERC20ApprovalIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(IERC20Approval)
+		it.Event = new(ERC721Approval)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1314,61 +1878,69 @@ func (it *IERC20ApprovalIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *IERC20ApprovalIterator) Error() error {
+func (it *ERC721ApprovalIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *IERC20ApprovalIterator) Close() error {
+func (it *ERC721ApprovalIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// IERC20Approval represents a Approval event raised by the IERC20 contract.
-type IERC20Approval struct {
-	Owner   common.Address
-	Spender common.Address
-	Value   *big.Int
-	Raw     types.Log // Blockchain specific contextual infos
+// ERC721Approval represents a Approval event raised by the ERC721 contract.
+type ERC721Approval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
 }
 
 // FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
 //
-// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
-func (_IERC20 *IERC20Filterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*IERC20ApprovalIterator, error) {
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721 *ERC721Filterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*ERC721ApprovalIterator, error) {
 
 	var ownerRule []interface{}
 	for _, ownerItem := range owner {
 		ownerRule = append(ownerRule, ownerItem)
 	}
-	var spenderRule []interface{}
-	for _, spenderItem := range spender {
-		spenderRule = append(spenderRule, spenderItem)
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
 	}
 
-	logs, sub, err := _IERC20.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	logs, sub, err := _ERC721.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
 	if err != nil {
 		return nil, err
 	}
-	return &IERC20ApprovalIterator{contract: _IERC20.contract, event: "Approval", logs: logs, sub: sub}, nil
+	return &ERC721ApprovalIterator{contract: _ERC721.contract, event: "Approval", logs: logs, sub: sub}, nil
 }
 
 // WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
 //
-// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
-func (_IERC20 *IERC20Filterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *IERC20Approval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721 *ERC721Filterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *ERC721Approval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
 
 	var ownerRule []interface{}
 	for _, ownerItem := range owner {
 		ownerRule = append(ownerRule, ownerItem)
 	}
-	var spenderRule []interface{}
-	for _, spenderItem := range spender {
-		spenderRule = append(spenderRule, spenderItem)
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
 	}
 
-	logs, sub, err := _IERC20.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	logs, sub, err := _ERC721.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
 	if err != nil {
 		return nil, err
 	}
@@ -1378,8 +1950,8 @@ func (_IERC20 *IERC20Filterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(IERC20Approval)
-				if err := _IERC20.contract.UnpackLog(event, "Approval", log); err != nil {
+				event := new(ERC721Approval)
+				if err := _ERC721.contract.UnpackLog(event, "Approval", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1402,19 +1974,19 @@ func (_IERC20 *IERC20Filterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *
 
 // ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
 //
-// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
-func (_IERC20 *IERC20Filterer) ParseApproval(log types.Log) (*IERC20Approval, error) {
-	event := new(IERC20Approval)
-	if err := _IERC20.contract.UnpackLog(event, "Approval", log); err != nil {
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721 *ERC721Filterer) ParseApproval(log types.Log) (*ERC721Approval, error) {
+	event := new(ERC721Approval)
+	if err := _ERC721.contract.UnpackLog(event, "Approval", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// IERC20TransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the IERC20 contract.
-type IERC20TransferIterator struct {
-	Event *IERC20Transfer // Event containing the contract specifics and raw log
+// ERC721ApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the ERC721 contract.
+type ERC721ApprovalForAllIterator struct {
+	Event *ERC721ApprovalForAll // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1428,7 +2000,7 @@ type IERC20TransferIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *IERC20TransferIterator) Next() bool {
+func (it *ERC721ApprovalForAllIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1437,7 +2009,7 @@ func (it *IERC20TransferIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(IERC20Transfer)
+			it.Event = new(ERC721ApprovalForAll)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1452,7 +2024,7 @@ func (it *IERC20TransferIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(IERC20Transfer)
+		it.Event = new(ERC721ApprovalForAll)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1468,29 +2040,183 @@ func (it *IERC20TransferIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *IERC20TransferIterator) Error() error {
+func (it *ERC721ApprovalForAllIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *IERC20TransferIterator) Close() error {
+func (it *ERC721ApprovalForAllIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// IERC20Transfer represents a Transfer event raised by the IERC20 contract.
-type IERC20Transfer struct {
-	From  common.Address
-	To    common.Address
-	Value *big.Int
-	Raw   types.Log // Blockchain specific contextual infos
+// ERC721ApprovalForAll represents a ApprovalForAll event raised by the ERC721 contract.
+type ERC721ApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721 *ERC721Filterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*ERC721ApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC721.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721ApprovalForAllIterator{contract: _ERC721.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721 *ERC721Filterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *ERC721ApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC721.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721ApprovalForAll)
+				if err := _ERC721.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721 *ERC721Filterer) ParseApprovalForAll(log types.Log) (*ERC721ApprovalForAll, error) {
+	event := new(ERC721ApprovalForAll)
+	if err := _ERC721.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721TransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the ERC721 contract.
+type ERC721TransferIterator struct {
+	Event *ERC721Transfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721TransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721Transfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721Transfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721TransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721TransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721Transfer represents a Transfer event raised by the ERC721 contract.
+type ERC721Transfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
 // FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_IERC20 *IERC20Filterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*IERC20TransferIterator, error) {
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721 *ERC721Filterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*ERC721TransferIterator, error) {
 
 	var fromRule []interface{}
 	for _, fromItem := range from {
@@ -1500,18 +2226,22 @@ func (_IERC20 *IERC20Filterer) FilterTransfer(opts *bind.FilterOpts, from []comm
 	for _, toItem := range to {
 		toRule = append(toRule, toItem)
 	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
 
-	logs, sub, err := _IERC20.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	logs, sub, err := _ERC721.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
 	if err != nil {
 		return nil, err
 	}
-	return &IERC20TransferIterator{contract: _IERC20.contract, event: "Transfer", logs: logs, sub: sub}, nil
+	return &ERC721TransferIterator{contract: _ERC721.contract, event: "Transfer", logs: logs, sub: sub}, nil
 }
 
 // WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_IERC20 *IERC20Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *IERC20Transfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721 *ERC721Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ERC721Transfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
 
 	var fromRule []interface{}
 	for _, fromItem := range from {
@@ -1521,8 +2251,12 @@ func (_IERC20 *IERC20Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *
 	for _, toItem := range to {
 		toRule = append(toRule, toItem)
 	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
 
-	logs, sub, err := _IERC20.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	logs, sub, err := _ERC721.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
 	if err != nil {
 		return nil, err
 	}
@@ -1532,8 +2266,8 @@ func (_IERC20 *IERC20Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(IERC20Transfer)
-				if err := _IERC20.contract.UnpackLog(event, "Transfer", log); err != nil {
+				event := new(ERC721Transfer)
+				if err := _ERC721.contract.UnpackLog(event, "Transfer", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1556,130 +2290,122 @@ func (_IERC20 *IERC20Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *
 
 // ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_IERC20 *IERC20Filterer) ParseTransfer(log types.Log) (*IERC20Transfer, error) {
-	event := new(IERC20Transfer)
-	if err := _IERC20.contract.UnpackLog(event, "Transfer", log); err != nil {
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721 *ERC721Filterer) ParseTransfer(log types.Log) (*ERC721Transfer, error) {
+	event := new(ERC721Transfer)
+	if err := _ERC721.contract.UnpackLog(event, "Transfer", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// IERC20MetadataABI is the input ABI used to generate the binding from.
-const IERC20MetadataABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+// IERC165ABI is the input ABI used to generate the binding from.
+const IERC165ABI = "[{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
 
-// IERC20MetadataFuncSigs maps the 4-byte function signature to its string representation.
-var IERC20MetadataFuncSigs = map[string]string{
-	"dd62ed3e": "allowance(address,address)",
-	"095ea7b3": "approve(address,uint256)",
-	"70a08231": "balanceOf(address)",
-	"313ce567": "decimals()",
-	"06fdde03": "name()",
-	"95d89b41": "symbol()",
-	"18160ddd": "totalSupply()",
-	"a9059cbb": "transfer(address,uint256)",
-	"23b872dd": "transferFrom(address,address,uint256)",
+// IERC165FuncSigs maps the 4-byte function signature to its string representation.
+var IERC165FuncSigs = map[string]string{
+	"01ffc9a7": "supportsInterface(bytes4)",
 }
 
-// IERC20Metadata is an auto generated Go binding around an Ethereum contract.
-type IERC20Metadata struct {
-	IERC20MetadataCaller     // Read-only binding to the contract
-	IERC20MetadataTransactor // Write-only binding to the contract
-	IERC20MetadataFilterer   // Log filterer for contract events
+// IERC165 is an auto generated Go binding around an Ethereum contract.
+type IERC165 struct {
+	IERC165Caller     // Read-only binding to the contract
+	IERC165Transactor // Write-only binding to the contract
+	IERC165Filterer   // Log filterer for contract events
 }
 
-// IERC20MetadataCaller is an auto generated read-only Go binding around an Ethereum contract.
-type IERC20MetadataCaller struct {
+// IERC165Caller is an auto generated read-only Go binding around an Ethereum contract.
+type IERC165Caller struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// IERC20MetadataTransactor is an auto generated write-only Go binding around an Ethereum contract.
-type IERC20MetadataTransactor struct {
+// IERC165Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type IERC165Transactor struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// IERC20MetadataFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
-type IERC20MetadataFilterer struct {
+// IERC165Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IERC165Filterer struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// IERC20MetadataSession is an auto generated Go binding around an Ethereum contract,
+// IERC165Session is an auto generated Go binding around an Ethereum contract,
 // with pre-set call and transact options.
-type IERC20MetadataSession struct {
-	Contract     *IERC20Metadata   // Generic contract binding to set the session for
+type IERC165Session struct {
+	Contract     *IERC165          // Generic contract binding to set the session for
 	CallOpts     bind.CallOpts     // Call options to use throughout this session
 	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
 }
 
-// IERC20MetadataCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// IERC165CallerSession is an auto generated read-only Go binding around an Ethereum contract,
 // with pre-set call options.
-type IERC20MetadataCallerSession struct {
-	Contract *IERC20MetadataCaller // Generic contract caller binding to set the session for
-	CallOpts bind.CallOpts         // Call options to use throughout this session
+type IERC165CallerSession struct {
+	Contract *IERC165Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
 }
 
-// IERC20MetadataTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// IERC165TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
 // with pre-set transact options.
-type IERC20MetadataTransactorSession struct {
-	Contract     *IERC20MetadataTransactor // Generic contract transactor binding to set the session for
-	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+type IERC165TransactorSession struct {
+	Contract     *IERC165Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
 }
 
-// IERC20MetadataRaw is an auto generated low-level Go binding around an Ethereum contract.
-type IERC20MetadataRaw struct {
-	Contract *IERC20Metadata // Generic contract binding to access the raw methods on
+// IERC165Raw is an auto generated low-level Go binding around an Ethereum contract.
+type IERC165Raw struct {
+	Contract *IERC165 // Generic contract binding to access the raw methods on
 }
 
-// IERC20MetadataCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
-type IERC20MetadataCallerRaw struct {
-	Contract *IERC20MetadataCaller // Generic read-only contract binding to access the raw methods on
+// IERC165CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IERC165CallerRaw struct {
+	Contract *IERC165Caller // Generic read-only contract binding to access the raw methods on
 }
 
-// IERC20MetadataTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
-type IERC20MetadataTransactorRaw struct {
-	Contract *IERC20MetadataTransactor // Generic write-only contract binding to access the raw methods on
+// IERC165TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IERC165TransactorRaw struct {
+	Contract *IERC165Transactor // Generic write-only contract binding to access the raw methods on
 }
 
-// NewIERC20Metadata creates a new instance of IERC20Metadata, bound to a specific deployed contract.
-func NewIERC20Metadata(address common.Address, backend bind.ContractBackend) (*IERC20Metadata, error) {
-	contract, err := bindIERC20Metadata(address, backend, backend, backend)
+// NewIERC165 creates a new instance of IERC165, bound to a specific deployed contract.
+func NewIERC165(address common.Address, backend bind.ContractBackend) (*IERC165, error) {
+	contract, err := bindIERC165(address, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
-	return &IERC20Metadata{IERC20MetadataCaller: IERC20MetadataCaller{contract: contract}, IERC20MetadataTransactor: IERC20MetadataTransactor{contract: contract}, IERC20MetadataFilterer: IERC20MetadataFilterer{contract: contract}}, nil
+	return &IERC165{IERC165Caller: IERC165Caller{contract: contract}, IERC165Transactor: IERC165Transactor{contract: contract}, IERC165Filterer: IERC165Filterer{contract: contract}}, nil
 }
 
-// NewIERC20MetadataCaller creates a new read-only instance of IERC20Metadata, bound to a specific deployed contract.
-func NewIERC20MetadataCaller(address common.Address, caller bind.ContractCaller) (*IERC20MetadataCaller, error) {
-	contract, err := bindIERC20Metadata(address, caller, nil, nil)
+// NewIERC165Caller creates a new read-only instance of IERC165, bound to a specific deployed contract.
+func NewIERC165Caller(address common.Address, caller bind.ContractCaller) (*IERC165Caller, error) {
+	contract, err := bindIERC165(address, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	return &IERC20MetadataCaller{contract: contract}, nil
+	return &IERC165Caller{contract: contract}, nil
 }
 
-// NewIERC20MetadataTransactor creates a new write-only instance of IERC20Metadata, bound to a specific deployed contract.
-func NewIERC20MetadataTransactor(address common.Address, transactor bind.ContractTransactor) (*IERC20MetadataTransactor, error) {
-	contract, err := bindIERC20Metadata(address, nil, transactor, nil)
+// NewIERC165Transactor creates a new write-only instance of IERC165, bound to a specific deployed contract.
+func NewIERC165Transactor(address common.Address, transactor bind.ContractTransactor) (*IERC165Transactor, error) {
+	contract, err := bindIERC165(address, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
-	return &IERC20MetadataTransactor{contract: contract}, nil
+	return &IERC165Transactor{contract: contract}, nil
 }
 
-// NewIERC20MetadataFilterer creates a new log filterer instance of IERC20Metadata, bound to a specific deployed contract.
-func NewIERC20MetadataFilterer(address common.Address, filterer bind.ContractFilterer) (*IERC20MetadataFilterer, error) {
-	contract, err := bindIERC20Metadata(address, nil, nil, filterer)
+// NewIERC165Filterer creates a new log filterer instance of IERC165, bound to a specific deployed contract.
+func NewIERC165Filterer(address common.Address, filterer bind.ContractFilterer) (*IERC165Filterer, error) {
+	contract, err := bindIERC165(address, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
-	return &IERC20MetadataFilterer{contract: contract}, nil
+	return &IERC165Filterer{contract: contract}, nil
 }
 
-// bindIERC20Metadata binds a generic wrapper to an already deployed contract.
-func bindIERC20Metadata(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
-	parsed, err := abi.JSON(strings.NewReader(IERC20MetadataABI))
+// bindIERC165 binds a generic wrapper to an already deployed contract.
+func bindIERC165(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(IERC165ABI))
 	if err != nil {
 		return nil, err
 	}
@@ -1690,201 +2416,294 @@ func bindIERC20Metadata(address common.Address, caller bind.ContractCaller, tran
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_IERC20Metadata *IERC20MetadataRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _IERC20Metadata.Contract.IERC20MetadataCaller.contract.Call(opts, result, method, params...)
+func (_IERC165 *IERC165Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IERC165.Contract.IERC165Caller.contract.Call(opts, result, method, params...)
 }
 
 // Transfer initiates a plain transaction to move funds to the contract, calling
 // its default method if one is available.
-func (_IERC20Metadata *IERC20MetadataRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _IERC20Metadata.Contract.IERC20MetadataTransactor.contract.Transfer(opts)
+func (_IERC165 *IERC165Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IERC165.Contract.IERC165Transactor.contract.Transfer(opts)
 }
 
 // Transact invokes the (paid) contract method with params as input values.
-func (_IERC20Metadata *IERC20MetadataRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _IERC20Metadata.Contract.IERC20MetadataTransactor.contract.Transact(opts, method, params...)
+func (_IERC165 *IERC165Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IERC165.Contract.IERC165Transactor.contract.Transact(opts, method, params...)
 }
 
 // Call invokes the (constant) contract method with params as input values and
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_IERC20Metadata *IERC20MetadataCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _IERC20Metadata.Contract.contract.Call(opts, result, method, params...)
+func (_IERC165 *IERC165CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IERC165.Contract.contract.Call(opts, result, method, params...)
 }
 
 // Transfer initiates a plain transaction to move funds to the contract, calling
 // its default method if one is available.
-func (_IERC20Metadata *IERC20MetadataTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _IERC20Metadata.Contract.contract.Transfer(opts)
+func (_IERC165 *IERC165TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IERC165.Contract.contract.Transfer(opts)
 }
 
 // Transact invokes the (paid) contract method with params as input values.
-func (_IERC20Metadata *IERC20MetadataTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _IERC20Metadata.Contract.contract.Transact(opts, method, params...)
+func (_IERC165 *IERC165TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IERC165.Contract.contract.Transact(opts, method, params...)
 }
 
-// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
 //
-// Solidity: function allowance(address owner, address spender) view returns(uint256)
-func (_IERC20Metadata *IERC20MetadataCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_IERC165 *IERC165Caller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
 	var out []interface{}
-	err := _IERC20Metadata.contract.Call(opts, &out, "allowance", owner, spender)
+	err := _IERC165.contract.Call(opts, &out, "supportsInterface", interfaceId)
 
 	if err != nil {
-		return *new(*big.Int), err
+		return *new(bool), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
 
 	return out0, err
 
 }
 
-// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
 //
-// Solidity: function allowance(address owner, address spender) view returns(uint256)
-func (_IERC20Metadata *IERC20MetadataSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
-	return _IERC20Metadata.Contract.Allowance(&_IERC20Metadata.CallOpts, owner, spender)
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_IERC165 *IERC165Session) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _IERC165.Contract.SupportsInterface(&_IERC165.CallOpts, interfaceId)
 }
 
-// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
 //
-// Solidity: function allowance(address owner, address spender) view returns(uint256)
-func (_IERC20Metadata *IERC20MetadataCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
-	return _IERC20Metadata.Contract.Allowance(&_IERC20Metadata.CallOpts, owner, spender)
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_IERC165 *IERC165CallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _IERC165.Contract.SupportsInterface(&_IERC165.CallOpts, interfaceId)
 }
 
-// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
-//
-// Solidity: function balanceOf(address account) view returns(uint256)
-func (_IERC20Metadata *IERC20MetadataCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
-	var out []interface{}
-	err := _IERC20Metadata.contract.Call(opts, &out, "balanceOf", account)
-
-	if err != nil {
-		return *new(*big.Int), err
-	}
+// IERC20ABI is the input ABI used to generate the binding from.
+const IERC20ABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
-	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+// IERC20FuncSigs maps the 4-byte function signature to its string representation.
+var IERC20FuncSigs = map[string]string{
+	"dd62ed3e": "allowance(address,address)",
+	"095ea7b3": "approve(address,uint256)",
+	"70a08231": "balanceOf(address)",
+	"18160ddd": "totalSupply()",
+	"a9059cbb": "transfer(address,uint256)",
+	"23b872dd": "transferFrom(address,address,uint256)",
+}
 
-	return out0, err
+// IERC20 is an auto generated Go binding around an Ethereum contract.
+type IERC20 struct {
+	IERC20Caller     // Read-only binding to the contract
+	IERC20Transactor // Write-only binding to the contract
+	IERC20Filterer   // Log filterer for contract events
+}
 
+// IERC20Caller is an auto generated read-only Go binding around an Ethereum contract.
+type IERC20Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
-//
-// Solidity: function balanceOf(address account) view returns(uint256)
-func (_IERC20Metadata *IERC20MetadataSession) BalanceOf(account common.Address) (*big.Int, error) {
-	return _IERC20Metadata.Contract.BalanceOf(&_IERC20Metadata.CallOpts, account)
+// IERC20Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type IERC20Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
-//
-// Solidity: function balanceOf(address account) view returns(uint256)
-func (_IERC20Metadata *IERC20MetadataCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
-	return _IERC20Metadata.Contract.BalanceOf(&_IERC20Metadata.CallOpts, account)
+// IERC20Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IERC20Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// Decimals is a free data retrieval call binding the contract method 0x313ce567.
-//
-// Solidity: function decimals() view returns(uint8)
-func (_IERC20Metadata *IERC20MetadataCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
-	var out []interface{}
-	err := _IERC20Metadata.contract.Call(opts, &out, "decimals")
+// IERC20Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IERC20Session struct {
+	Contract     *IERC20           // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IERC20CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IERC20CallerSession struct {
+	Contract *IERC20Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// IERC20TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IERC20TransactorSession struct {
+	Contract     *IERC20Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IERC20Raw is an auto generated low-level Go binding around an Ethereum contract.
+type IERC20Raw struct {
+	Contract *IERC20 // Generic contract binding to access the raw methods on
+}
+
+// IERC20CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IERC20CallerRaw struct {
+	Contract *IERC20Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// IERC20TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IERC20TransactorRaw struct {
+	Contract *IERC20Transactor // Generic write-only contract binding to access the raw methods on
+}
 
+// NewIERC20 creates a new instance of IERC20, bound to a specific deployed contract.
+func NewIERC20(address common.Address, backend bind.ContractBackend) (*IERC20, error) {
+	contract, err := bindIERC20(address, backend, backend, backend)
 	if err != nil {
-		return *new(uint8), err
+		return nil, err
 	}
+	return &IERC20{IERC20Caller: IERC20Caller{contract: contract}, IERC20Transactor: IERC20Transactor{contract: contract}, IERC20Filterer: IERC20Filterer{contract: contract}}, nil
+}
 
-	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+// NewIERC20Caller creates a new read-only instance of IERC20, bound to a specific deployed contract.
+func NewIERC20Caller(address common.Address, caller bind.ContractCaller) (*IERC20Caller, error) {
+	contract, err := bindIERC20(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC20Caller{contract: contract}, nil
+}
 
-	return out0, err
+// NewIERC20Transactor creates a new write-only instance of IERC20, bound to a specific deployed contract.
+func NewIERC20Transactor(address common.Address, transactor bind.ContractTransactor) (*IERC20Transactor, error) {
+	contract, err := bindIERC20(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC20Transactor{contract: contract}, nil
+}
 
+// NewIERC20Filterer creates a new log filterer instance of IERC20, bound to a specific deployed contract.
+func NewIERC20Filterer(address common.Address, filterer bind.ContractFilterer) (*IERC20Filterer, error) {
+	contract, err := bindIERC20(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC20Filterer{contract: contract}, nil
 }
 
-// Decimals is a free data retrieval call binding the contract method 0x313ce567.
-//
-// Solidity: function decimals() view returns(uint8)
-func (_IERC20Metadata *IERC20MetadataSession) Decimals() (uint8, error) {
-	return _IERC20Metadata.Contract.Decimals(&_IERC20Metadata.CallOpts)
+// bindIERC20 binds a generic wrapper to an already deployed contract.
+func bindIERC20(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(IERC20ABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
 }
 
-// Decimals is a free data retrieval call binding the contract method 0x313ce567.
-//
-// Solidity: function decimals() view returns(uint8)
-func (_IERC20Metadata *IERC20MetadataCallerSession) Decimals() (uint8, error) {
-	return _IERC20Metadata.Contract.Decimals(&_IERC20Metadata.CallOpts)
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IERC20 *IERC20Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IERC20.Contract.IERC20Caller.contract.Call(opts, result, method, params...)
 }
 
-// Name is a free data retrieval call binding the contract method 0x06fdde03.
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IERC20 *IERC20Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IERC20.Contract.IERC20Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IERC20 *IERC20Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IERC20.Contract.IERC20Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IERC20 *IERC20CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IERC20.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IERC20 *IERC20TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IERC20.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IERC20 *IERC20TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IERC20.Contract.contract.Transact(opts, method, params...)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
 //
-// Solidity: function name() view returns(string)
-func (_IERC20Metadata *IERC20MetadataCaller) Name(opts *bind.CallOpts) (string, error) {
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_IERC20 *IERC20Caller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
 	var out []interface{}
-	err := _IERC20Metadata.contract.Call(opts, &out, "name")
+	err := _IERC20.contract.Call(opts, &out, "allowance", owner, spender)
 
 	if err != nil {
-		return *new(string), err
+		return *new(*big.Int), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
 
 	return out0, err
 
 }
 
-// Name is a free data retrieval call binding the contract method 0x06fdde03.
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
 //
-// Solidity: function name() view returns(string)
-func (_IERC20Metadata *IERC20MetadataSession) Name() (string, error) {
-	return _IERC20Metadata.Contract.Name(&_IERC20Metadata.CallOpts)
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_IERC20 *IERC20Session) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _IERC20.Contract.Allowance(&_IERC20.CallOpts, owner, spender)
 }
 
-// Name is a free data retrieval call binding the contract method 0x06fdde03.
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
 //
-// Solidity: function name() view returns(string)
-func (_IERC20Metadata *IERC20MetadataCallerSession) Name() (string, error) {
-	return _IERC20Metadata.Contract.Name(&_IERC20Metadata.CallOpts)
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_IERC20 *IERC20CallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _IERC20.Contract.Allowance(&_IERC20.CallOpts, owner, spender)
 }
 
-// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function symbol() view returns(string)
-func (_IERC20Metadata *IERC20MetadataCaller) Symbol(opts *bind.CallOpts) (string, error) {
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_IERC20 *IERC20Caller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
 	var out []interface{}
-	err := _IERC20Metadata.contract.Call(opts, &out, "symbol")
+	err := _IERC20.contract.Call(opts, &out, "balanceOf", account)
 
 	if err != nil {
-		return *new(string), err
+		return *new(*big.Int), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
 
 	return out0, err
 
 }
 
-// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function symbol() view returns(string)
-func (_IERC20Metadata *IERC20MetadataSession) Symbol() (string, error) {
-	return _IERC20Metadata.Contract.Symbol(&_IERC20Metadata.CallOpts)
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_IERC20 *IERC20Session) BalanceOf(account common.Address) (*big.Int, error) {
+	return _IERC20.Contract.BalanceOf(&_IERC20.CallOpts, account)
 }
 
-// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function symbol() view returns(string)
-func (_IERC20Metadata *IERC20MetadataCallerSession) Symbol() (string, error) {
-	return _IERC20Metadata.Contract.Symbol(&_IERC20Metadata.CallOpts)
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_IERC20 *IERC20CallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _IERC20.Contract.BalanceOf(&_IERC20.CallOpts, account)
 }
 
 // TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
 //
 // Solidity: function totalSupply() view returns(uint256)
-func (_IERC20Metadata *IERC20MetadataCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+func (_IERC20 *IERC20Caller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
 	var out []interface{}
-	err := _IERC20Metadata.contract.Call(opts, &out, "totalSupply")
+	err := _IERC20.contract.Call(opts, &out, "totalSupply")
 
 	if err != nil {
 		return *new(*big.Int), err
@@ -1899,83 +2718,83 @@ func (_IERC20Metadata *IERC20MetadataCaller) TotalSupply(opts *bind.CallOpts) (*
 // TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
 //
 // Solidity: function totalSupply() view returns(uint256)
-func (_IERC20Metadata *IERC20MetadataSession) TotalSupply() (*big.Int, error) {
-	return _IERC20Metadata.Contract.TotalSupply(&_IERC20Metadata.CallOpts)
+func (_IERC20 *IERC20Session) TotalSupply() (*big.Int, error) {
+	return _IERC20.Contract.TotalSupply(&_IERC20.CallOpts)
 }
 
 // TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
 //
 // Solidity: function totalSupply() view returns(uint256)
-func (_IERC20Metadata *IERC20MetadataCallerSession) TotalSupply() (*big.Int, error) {
-	return _IERC20Metadata.Contract.TotalSupply(&_IERC20Metadata.CallOpts)
+func (_IERC20 *IERC20CallerSession) TotalSupply() (*big.Int, error) {
+	return _IERC20.Contract.TotalSupply(&_IERC20.CallOpts)
 }
 
 // Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
 //
 // Solidity: function approve(address spender, uint256 amount) returns(bool)
-func (_IERC20Metadata *IERC20MetadataTransactor) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20Metadata.contract.Transact(opts, "approve", spender, amount)
+func (_IERC20 *IERC20Transactor) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20.contract.Transact(opts, "approve", spender, amount)
 }
 
 // Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
 //
 // Solidity: function approve(address spender, uint256 amount) returns(bool)
-func (_IERC20Metadata *IERC20MetadataSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20Metadata.Contract.Approve(&_IERC20Metadata.TransactOpts, spender, amount)
+func (_IERC20 *IERC20Session) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20.Contract.Approve(&_IERC20.TransactOpts, spender, amount)
 }
 
 // Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
 //
 // Solidity: function approve(address spender, uint256 amount) returns(bool)
-func (_IERC20Metadata *IERC20MetadataTransactorSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20Metadata.Contract.Approve(&_IERC20Metadata.TransactOpts, spender, amount)
+func (_IERC20 *IERC20TransactorSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20.Contract.Approve(&_IERC20.TransactOpts, spender, amount)
 }
 
 // Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
 //
 // Solidity: function transfer(address recipient, uint256 amount) returns(bool)
-func (_IERC20Metadata *IERC20MetadataTransactor) Transfer(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20Metadata.contract.Transact(opts, "transfer", recipient, amount)
+func (_IERC20 *IERC20Transactor) Transfer(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20.contract.Transact(opts, "transfer", recipient, amount)
 }
 
 // Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
 //
 // Solidity: function transfer(address recipient, uint256 amount) returns(bool)
-func (_IERC20Metadata *IERC20MetadataSession) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20Metadata.Contract.Transfer(&_IERC20Metadata.TransactOpts, recipient, amount)
+func (_IERC20 *IERC20Session) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20.Contract.Transfer(&_IERC20.TransactOpts, recipient, amount)
 }
 
 // Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
 //
 // Solidity: function transfer(address recipient, uint256 amount) returns(bool)
-func (_IERC20Metadata *IERC20MetadataTransactorSession) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20Metadata.Contract.Transfer(&_IERC20Metadata.TransactOpts, recipient, amount)
+func (_IERC20 *IERC20TransactorSession) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20.Contract.Transfer(&_IERC20.TransactOpts, recipient, amount)
 }
 
 // TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
 //
 // Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
-func (_IERC20Metadata *IERC20MetadataTransactor) TransferFrom(opts *bind.TransactOpts, sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20Metadata.contract.Transact(opts, "transferFrom", sender, recipient, amount)
+func (_IERC20 *IERC20Transactor) TransferFrom(opts *bind.TransactOpts, sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20.contract.Transact(opts, "transferFrom", sender, recipient, amount)
 }
 
 // TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
 //
 // Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
-func (_IERC20Metadata *IERC20MetadataSession) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20Metadata.Contract.TransferFrom(&_IERC20Metadata.TransactOpts, sender, recipient, amount)
+func (_IERC20 *IERC20Session) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20.Contract.TransferFrom(&_IERC20.TransactOpts, sender, recipient, amount)
 }
 
 // TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
 //
 // Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
-func (_IERC20Metadata *IERC20MetadataTransactorSession) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _IERC20Metadata.Contract.TransferFrom(&_IERC20Metadata.TransactOpts, sender, recipient, amount)
-}
+func (_IERC20 *IERC20TransactorSession) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20.Contract.TransferFrom(&_IERC20.TransactOpts, sender, recipient, amount)
+}
 
-// IERC20MetadataApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the IERC20Metadata contract.
-type IERC20MetadataApprovalIterator struct {
-	Event *IERC20MetadataApproval // Event containing the contract specifics and raw log
+// IERC20ApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the IERC20 contract.
+type IERC20ApprovalIterator struct {
+	Event *IERC20Approval // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1989,7 +2808,7 @@ type IERC20MetadataApprovalIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *IERC20MetadataApprovalIterator) Next() bool {
+func (it *IERC20ApprovalIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1998,7 +2817,7 @@ func (it *IERC20MetadataApprovalIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(IERC20MetadataApproval)
+			it.Event = new(IERC20Approval)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -2013,7 +2832,7 @@ func (it *IERC20MetadataApprovalIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(IERC20MetadataApproval)
+		it.Event = new(IERC20Approval)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -2029,19 +2848,19 @@ func (it *IERC20MetadataApprovalIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *IERC20MetadataApprovalIterator) Error() error {
+func (it *IERC20ApprovalIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *IERC20MetadataApprovalIterator) Close() error {
+func (it *IERC20ApprovalIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// IERC20MetadataApproval represents a Approval event raised by the IERC20Metadata contract.
-type IERC20MetadataApproval struct {
+// IERC20Approval represents a Approval event raised by the IERC20 contract.
+type IERC20Approval struct {
 	Owner   common.Address
 	Spender common.Address
 	Value   *big.Int
@@ -2051,7 +2870,7 @@ type IERC20MetadataApproval struct {
 // FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
 //
 // Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
-func (_IERC20Metadata *IERC20MetadataFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*IERC20MetadataApprovalIterator, error) {
+func (_IERC20 *IERC20Filterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*IERC20ApprovalIterator, error) {
 
 	var ownerRule []interface{}
 	for _, ownerItem := range owner {
@@ -2062,17 +2881,17 @@ func (_IERC20Metadata *IERC20MetadataFilterer) FilterApproval(opts *bind.FilterO
 		spenderRule = append(spenderRule, spenderItem)
 	}
 
-	logs, sub, err := _IERC20Metadata.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	logs, sub, err := _IERC20.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
 	if err != nil {
 		return nil, err
 	}
-	return &IERC20MetadataApprovalIterator{contract: _IERC20Metadata.contract, event: "Approval", logs: logs, sub: sub}, nil
+	return &IERC20ApprovalIterator{contract: _IERC20.contract, event: "Approval", logs: logs, sub: sub}, nil
 }
 
 // WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
 //
 // Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
-func (_IERC20Metadata *IERC20MetadataFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *IERC20MetadataApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+func (_IERC20 *IERC20Filterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *IERC20Approval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
 
 	var ownerRule []interface{}
 	for _, ownerItem := range owner {
@@ -2083,7 +2902,7 @@ func (_IERC20Metadata *IERC20MetadataFilterer) WatchApproval(opts *bind.WatchOpt
 		spenderRule = append(spenderRule, spenderItem)
 	}
 
-	logs, sub, err := _IERC20Metadata.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	logs, sub, err := _IERC20.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
 	if err != nil {
 		return nil, err
 	}
@@ -2093,8 +2912,8 @@ func (_IERC20Metadata *IERC20MetadataFilterer) WatchApproval(opts *bind.WatchOpt
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(IERC20MetadataApproval)
-				if err := _IERC20Metadata.contract.UnpackLog(event, "Approval", log); err != nil {
+				event := new(IERC20Approval)
+				if err := _IERC20.contract.UnpackLog(event, "Approval", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -2118,18 +2937,18 @@ func (_IERC20Metadata *IERC20MetadataFilterer) WatchApproval(opts *bind.WatchOpt
 // ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
 //
 // Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
-func (_IERC20Metadata *IERC20MetadataFilterer) ParseApproval(log types.Log) (*IERC20MetadataApproval, error) {
-	event := new(IERC20MetadataApproval)
-	if err := _IERC20Metadata.contract.UnpackLog(event, "Approval", log); err != nil {
+func (_IERC20 *IERC20Filterer) ParseApproval(log types.Log) (*IERC20Approval, error) {
+	event := new(IERC20Approval)
+	if err := _IERC20.contract.UnpackLog(event, "Approval", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// IERC20MetadataTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the IERC20Metadata contract.
-type IERC20MetadataTransferIterator struct {
-	Event *IERC20MetadataTransfer // Event containing the contract specifics and raw log
+// IERC20TransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the IERC20 contract.
+type IERC20TransferIterator struct {
+	Event *IERC20Transfer // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -2143,7 +2962,7 @@ type IERC20MetadataTransferIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *IERC20MetadataTransferIterator) Next() bool {
+func (it *IERC20TransferIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -2152,7 +2971,7 @@ func (it *IERC20MetadataTransferIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(IERC20MetadataTransfer)
+			it.Event = new(IERC20Transfer)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -2167,7 +2986,7 @@ func (it *IERC20MetadataTransferIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(IERC20MetadataTransfer)
+		it.Event = new(IERC20Transfer)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -2183,19 +3002,19 @@ func (it *IERC20MetadataTransferIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *IERC20MetadataTransferIterator) Error() error {
+func (it *IERC20TransferIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *IERC20MetadataTransferIterator) Close() error {
+func (it *IERC20TransferIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// IERC20MetadataTransfer represents a Transfer event raised by the IERC20Metadata contract.
-type IERC20MetadataTransfer struct {
+// IERC20Transfer represents a Transfer event raised by the IERC20 contract.
+type IERC20Transfer struct {
 	From  common.Address
 	To    common.Address
 	Value *big.Int
@@ -2205,7 +3024,7 @@ type IERC20MetadataTransfer struct {
 // FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
 //
 // Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_IERC20Metadata *IERC20MetadataFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*IERC20MetadataTransferIterator, error) {
+func (_IERC20 *IERC20Filterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*IERC20TransferIterator, error) {
 
 	var fromRule []interface{}
 	for _, fromItem := range from {
@@ -2216,17 +3035,17 @@ func (_IERC20Metadata *IERC20MetadataFilterer) FilterTransfer(opts *bind.FilterO
 		toRule = append(toRule, toItem)
 	}
 
-	logs, sub, err := _IERC20Metadata.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	logs, sub, err := _IERC20.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
 	if err != nil {
 		return nil, err
 	}
-	return &IERC20MetadataTransferIterator{contract: _IERC20Metadata.contract, event: "Transfer", logs: logs, sub: sub}, nil
+	return &IERC20TransferIterator{contract: _IERC20.contract, event: "Transfer", logs: logs, sub: sub}, nil
 }
 
 // WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
 //
 // Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_IERC20Metadata *IERC20MetadataFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *IERC20MetadataTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+func (_IERC20 *IERC20Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *IERC20Transfer, from []common.Address, to []common.Address) (event.Subscription, error) {
 
 	var fromRule []interface{}
 	for _, fromItem := range from {
@@ -2237,7 +3056,7 @@ func (_IERC20Metadata *IERC20MetadataFilterer) WatchTransfer(opts *bind.WatchOpt
 		toRule = append(toRule, toItem)
 	}
 
-	logs, sub, err := _IERC20Metadata.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	logs, sub, err := _IERC20.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
 	if err != nil {
 		return nil, err
 	}
@@ -2247,8 +3066,8 @@ func (_IERC20Metadata *IERC20MetadataFilterer) WatchTransfer(opts *bind.WatchOpt
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(IERC20MetadataTransfer)
-				if err := _IERC20Metadata.contract.UnpackLog(event, "Transfer", log); err != nil {
+				event := new(IERC20Transfer)
+				if err := _IERC20.contract.UnpackLog(event, "Transfer", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -2272,123 +3091,129 @@ func (_IERC20Metadata *IERC20MetadataFilterer) WatchTransfer(opts *bind.WatchOpt
 // ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
 //
 // Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_IERC20Metadata *IERC20MetadataFilterer) ParseTransfer(log types.Log) (*IERC20MetadataTransfer, error) {
-	event := new(IERC20MetadataTransfer)
-	if err := _IERC20Metadata.contract.UnpackLog(event, "Transfer", log); err != nil {
+func (_IERC20 *IERC20Filterer) ParseTransfer(log types.Log) (*IERC20Transfer, error) {
+	event := new(IERC20Transfer)
+	if err := _IERC20.contract.UnpackLog(event, "Transfer", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// OwnableABI is the input ABI used to generate the binding from.
-const OwnableABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+// IERC20MetadataABI is the input ABI used to generate the binding from.
+const IERC20MetadataABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
-// OwnableFuncSigs maps the 4-byte function signature to its string representation.
-var OwnableFuncSigs = map[string]string{
-	"8da5cb5b": "owner()",
-	"715018a6": "renounceOwnership()",
-	"f2fde38b": "transferOwnership(address)",
+// IERC20MetadataFuncSigs maps the 4-byte function signature to its string representation.
+var IERC20MetadataFuncSigs = map[string]string{
+	"dd62ed3e": "allowance(address,address)",
+	"095ea7b3": "approve(address,uint256)",
+	"70a08231": "balanceOf(address)",
+	"313ce567": "decimals()",
+	"06fdde03": "name()",
+	"95d89b41": "symbol()",
+	"18160ddd": "totalSupply()",
+	"a9059cbb": "transfer(address,uint256)",
+	"23b872dd": "transferFrom(address,address,uint256)",
 }
 
-// Ownable is an auto generated Go binding around an Ethereum contract.
-type Ownable struct {
-	OwnableCaller     // Read-only binding to the contract
-	OwnableTransactor // Write-only binding to the contract
-	OwnableFilterer   // Log filterer for contract events
+// IERC20Metadata is an auto generated Go binding around an Ethereum contract.
+type IERC20Metadata struct {
+	IERC20MetadataCaller     // Read-only binding to the contract
+	IERC20MetadataTransactor // Write-only binding to the contract
+	IERC20MetadataFilterer   // Log filterer for contract events
 }
 
-// OwnableCaller is an auto generated read-only Go binding around an Ethereum contract.
-type OwnableCaller struct {
+// IERC20MetadataCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IERC20MetadataCaller struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// OwnableTransactor is an auto generated write-only Go binding around an Ethereum contract.
-type OwnableTransactor struct {
+// IERC20MetadataTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IERC20MetadataTransactor struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// OwnableFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
-type OwnableFilterer struct {
+// IERC20MetadataFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IERC20MetadataFilterer struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// OwnableSession is an auto generated Go binding around an Ethereum contract,
+// IERC20MetadataSession is an auto generated Go binding around an Ethereum contract,
 // with pre-set call and transact options.
-type OwnableSession struct {
-	Contract     *Ownable          // Generic contract binding to set the session for
+type IERC20MetadataSession struct {
+	Contract     *IERC20Metadata   // Generic contract binding to set the session for
 	CallOpts     bind.CallOpts     // Call options to use throughout this session
 	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
 }
 
-// OwnableCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// IERC20MetadataCallerSession is an auto generated read-only Go binding around an Ethereum contract,
 // with pre-set call options.
-type OwnableCallerSession struct {
-	Contract *OwnableCaller // Generic contract caller binding to set the session for
-	CallOpts bind.CallOpts  // Call options to use throughout this session
+type IERC20MetadataCallerSession struct {
+	Contract *IERC20MetadataCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
 }
 
-// OwnableTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// IERC20MetadataTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
 // with pre-set transact options.
-type OwnableTransactorSession struct {
-	Contract     *OwnableTransactor // Generic contract transactor binding to set the session for
-	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+type IERC20MetadataTransactorSession struct {
+	Contract     *IERC20MetadataTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
 }
 
-// OwnableRaw is an auto generated low-level Go binding around an Ethereum contract.
-type OwnableRaw struct {
-	Contract *Ownable // Generic contract binding to access the raw methods on
+// IERC20MetadataRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IERC20MetadataRaw struct {
+	Contract *IERC20Metadata // Generic contract binding to access the raw methods on
 }
 
-// OwnableCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
-type OwnableCallerRaw struct {
-	Contract *OwnableCaller // Generic read-only contract binding to access the raw methods on
+// IERC20MetadataCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IERC20MetadataCallerRaw struct {
+	Contract *IERC20MetadataCaller // Generic read-only contract binding to access the raw methods on
 }
 
-// OwnableTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
-type OwnableTransactorRaw struct {
-	Contract *OwnableTransactor // Generic write-only contract binding to access the raw methods on
+// IERC20MetadataTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IERC20MetadataTransactorRaw struct {
+	Contract *IERC20MetadataTransactor // Generic write-only contract binding to access the raw methods on
 }
 
-// NewOwnable creates a new instance of Ownable, bound to a specific deployed contract.
-func NewOwnable(address common.Address, backend bind.ContractBackend) (*Ownable, error) {
-	contract, err := bindOwnable(address, backend, backend, backend)
+// NewIERC20Metadata creates a new instance of IERC20Metadata, bound to a specific deployed contract.
+func NewIERC20Metadata(address common.Address, backend bind.ContractBackend) (*IERC20Metadata, error) {
+	contract, err := bindIERC20Metadata(address, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
-	return &Ownable{OwnableCaller: OwnableCaller{contract: contract}, OwnableTransactor: OwnableTransactor{contract: contract}, OwnableFilterer: OwnableFilterer{contract: contract}}, nil
+	return &IERC20Metadata{IERC20MetadataCaller: IERC20MetadataCaller{contract: contract}, IERC20MetadataTransactor: IERC20MetadataTransactor{contract: contract}, IERC20MetadataFilterer: IERC20MetadataFilterer{contract: contract}}, nil
 }
 
-// NewOwnableCaller creates a new read-only instance of Ownable, bound to a specific deployed contract.
-func NewOwnableCaller(address common.Address, caller bind.ContractCaller) (*OwnableCaller, error) {
-	contract, err := bindOwnable(address, caller, nil, nil)
+// NewIERC20MetadataCaller creates a new read-only instance of IERC20Metadata, bound to a specific deployed contract.
+func NewIERC20MetadataCaller(address common.Address, caller bind.ContractCaller) (*IERC20MetadataCaller, error) {
+	contract, err := bindIERC20Metadata(address, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	return &OwnableCaller{contract: contract}, nil
+	return &IERC20MetadataCaller{contract: contract}, nil
 }
 
-// NewOwnableTransactor creates a new write-only instance of Ownable, bound to a specific deployed contract.
-func NewOwnableTransactor(address common.Address, transactor bind.ContractTransactor) (*OwnableTransactor, error) {
-	contract, err := bindOwnable(address, nil, transactor, nil)
+// NewIERC20MetadataTransactor creates a new write-only instance of IERC20Metadata, bound to a specific deployed contract.
+func NewIERC20MetadataTransactor(address common.Address, transactor bind.ContractTransactor) (*IERC20MetadataTransactor, error) {
+	contract, err := bindIERC20Metadata(address, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
-	return &OwnableTransactor{contract: contract}, nil
+	return &IERC20MetadataTransactor{contract: contract}, nil
 }
 
-// NewOwnableFilterer creates a new log filterer instance of Ownable, bound to a specific deployed contract.
-func NewOwnableFilterer(address common.Address, filterer bind.ContractFilterer) (*OwnableFilterer, error) {
-	contract, err := bindOwnable(address, nil, nil, filterer)
+// NewIERC20MetadataFilterer creates a new log filterer instance of IERC20Metadata, bound to a specific deployed contract.
+func NewIERC20MetadataFilterer(address common.Address, filterer bind.ContractFilterer) (*IERC20MetadataFilterer, error) {
+	contract, err := bindIERC20Metadata(address, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
-	return &OwnableFilterer{contract: contract}, nil
+	return &IERC20MetadataFilterer{contract: contract}, nil
 }
 
-// bindOwnable binds a generic wrapper to an already deployed contract.
-func bindOwnable(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
-	parsed, err := abi.JSON(strings.NewReader(OwnableABI))
+// bindIERC20Metadata binds a generic wrapper to an already deployed contract.
+func bindIERC20Metadata(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(IERC20MetadataABI))
 	if err != nil {
 		return nil, err
 	}
@@ -2399,116 +3224,292 @@ func bindOwnable(address common.Address, caller bind.ContractCaller, transactor
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_Ownable *OwnableRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _Ownable.Contract.OwnableCaller.contract.Call(opts, result, method, params...)
+func (_IERC20Metadata *IERC20MetadataRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IERC20Metadata.Contract.IERC20MetadataCaller.contract.Call(opts, result, method, params...)
 }
 
 // Transfer initiates a plain transaction to move funds to the contract, calling
 // its default method if one is available.
-func (_Ownable *OwnableRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _Ownable.Contract.OwnableTransactor.contract.Transfer(opts)
+func (_IERC20Metadata *IERC20MetadataRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IERC20Metadata.Contract.IERC20MetadataTransactor.contract.Transfer(opts)
 }
 
 // Transact invokes the (paid) contract method with params as input values.
-func (_Ownable *OwnableRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _Ownable.Contract.OwnableTransactor.contract.Transact(opts, method, params...)
+func (_IERC20Metadata *IERC20MetadataRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IERC20Metadata.Contract.IERC20MetadataTransactor.contract.Transact(opts, method, params...)
 }
 
 // Call invokes the (constant) contract method with params as input values and
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_Ownable *OwnableCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _Ownable.Contract.contract.Call(opts, result, method, params...)
+func (_IERC20Metadata *IERC20MetadataCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IERC20Metadata.Contract.contract.Call(opts, result, method, params...)
 }
 
 // Transfer initiates a plain transaction to move funds to the contract, calling
 // its default method if one is available.
-func (_Ownable *OwnableTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _Ownable.Contract.contract.Transfer(opts)
+func (_IERC20Metadata *IERC20MetadataTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IERC20Metadata.Contract.contract.Transfer(opts)
 }
 
 // Transact invokes the (paid) contract method with params as input values.
-func (_Ownable *OwnableTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _Ownable.Contract.contract.Transact(opts, method, params...)
+func (_IERC20Metadata *IERC20MetadataTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IERC20Metadata.Contract.contract.Transact(opts, method, params...)
 }
 
-// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
 //
-// Solidity: function owner() view returns(address)
-func (_Ownable *OwnableCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_IERC20Metadata *IERC20MetadataCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
 	var out []interface{}
-	err := _Ownable.contract.Call(opts, &out, "owner")
+	err := _IERC20Metadata.contract.Call(opts, &out, "allowance", owner, spender)
 
 	if err != nil {
-		return *new(common.Address), err
+		return *new(*big.Int), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
 
 	return out0, err
 
 }
 
-// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
 //
-// Solidity: function owner() view returns(address)
-func (_Ownable *OwnableSession) Owner() (common.Address, error) {
-	return _Ownable.Contract.Owner(&_Ownable.CallOpts)
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_IERC20Metadata *IERC20MetadataSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _IERC20Metadata.Contract.Allowance(&_IERC20Metadata.CallOpts, owner, spender)
 }
 
-// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
 //
-// Solidity: function owner() view returns(address)
-func (_Ownable *OwnableCallerSession) Owner() (common.Address, error) {
-	return _Ownable.Contract.Owner(&_Ownable.CallOpts)
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_IERC20Metadata *IERC20MetadataCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _IERC20Metadata.Contract.Allowance(&_IERC20Metadata.CallOpts, owner, spender)
 }
 
-// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function renounceOwnership() returns()
-func (_Ownable *OwnableTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _Ownable.contract.Transact(opts, "renounceOwnership")
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_IERC20Metadata *IERC20MetadataCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IERC20Metadata.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
 }
 
-// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function renounceOwnership() returns()
-func (_Ownable *OwnableSession) RenounceOwnership() (*types.Transaction, error) {
-	return _Ownable.Contract.RenounceOwnership(&_Ownable.TransactOpts)
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_IERC20Metadata *IERC20MetadataSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _IERC20Metadata.Contract.BalanceOf(&_IERC20Metadata.CallOpts, account)
 }
 
-// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function renounceOwnership() returns()
-func (_Ownable *OwnableTransactorSession) RenounceOwnership() (*types.Transaction, error) {
-	return _Ownable.Contract.RenounceOwnership(&_Ownable.TransactOpts)
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_IERC20Metadata *IERC20MetadataCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _IERC20Metadata.Contract.BalanceOf(&_IERC20Metadata.CallOpts, account)
 }
 
-// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
 //
-// Solidity: function transferOwnership(address newOwner) returns()
-func (_Ownable *OwnableTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
-	return _Ownable.contract.Transact(opts, "transferOwnership", newOwner)
+// Solidity: function decimals() view returns(uint8)
+func (_IERC20Metadata *IERC20MetadataCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _IERC20Metadata.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
 }
 
-// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
 //
-// Solidity: function transferOwnership(address newOwner) returns()
-func (_Ownable *OwnableSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
-	return _Ownable.Contract.TransferOwnership(&_Ownable.TransactOpts, newOwner)
+// Solidity: function decimals() view returns(uint8)
+func (_IERC20Metadata *IERC20MetadataSession) Decimals() (uint8, error) {
+	return _IERC20Metadata.Contract.Decimals(&_IERC20Metadata.CallOpts)
 }
 
-// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
 //
-// Solidity: function transferOwnership(address newOwner) returns()
-func (_Ownable *OwnableTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
-	return _Ownable.Contract.TransferOwnership(&_Ownable.TransactOpts, newOwner)
+// Solidity: function decimals() view returns(uint8)
+func (_IERC20Metadata *IERC20MetadataCallerSession) Decimals() (uint8, error) {
+	return _IERC20Metadata.Contract.Decimals(&_IERC20Metadata.CallOpts)
 }
 
-// OwnableOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Ownable contract.
-type OwnableOwnershipTransferredIterator struct {
-	Event *OwnableOwnershipTransferred // Event containing the contract specifics and raw log
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_IERC20Metadata *IERC20MetadataCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _IERC20Metadata.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_IERC20Metadata *IERC20MetadataSession) Name() (string, error) {
+	return _IERC20Metadata.Contract.Name(&_IERC20Metadata.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_IERC20Metadata *IERC20MetadataCallerSession) Name() (string, error) {
+	return _IERC20Metadata.Contract.Name(&_IERC20Metadata.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_IERC20Metadata *IERC20MetadataCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _IERC20Metadata.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_IERC20Metadata *IERC20MetadataSession) Symbol() (string, error) {
+	return _IERC20Metadata.Contract.Symbol(&_IERC20Metadata.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_IERC20Metadata *IERC20MetadataCallerSession) Symbol() (string, error) {
+	return _IERC20Metadata.Contract.Symbol(&_IERC20Metadata.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_IERC20Metadata *IERC20MetadataCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IERC20Metadata.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_IERC20Metadata *IERC20MetadataSession) TotalSupply() (*big.Int, error) {
+	return _IERC20Metadata.Contract.TotalSupply(&_IERC20Metadata.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_IERC20Metadata *IERC20MetadataCallerSession) TotalSupply() (*big.Int, error) {
+	return _IERC20Metadata.Contract.TotalSupply(&_IERC20Metadata.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_IERC20Metadata *IERC20MetadataTransactor) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20Metadata.contract.Transact(opts, "approve", spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_IERC20Metadata *IERC20MetadataSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20Metadata.Contract.Approve(&_IERC20Metadata.TransactOpts, spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_IERC20Metadata *IERC20MetadataTransactorSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20Metadata.Contract.Approve(&_IERC20Metadata.TransactOpts, spender, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
+func (_IERC20Metadata *IERC20MetadataTransactor) Transfer(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20Metadata.contract.Transact(opts, "transfer", recipient, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
+func (_IERC20Metadata *IERC20MetadataSession) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20Metadata.Contract.Transfer(&_IERC20Metadata.TransactOpts, recipient, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
+func (_IERC20Metadata *IERC20MetadataTransactorSession) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20Metadata.Contract.Transfer(&_IERC20Metadata.TransactOpts, recipient, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
+func (_IERC20Metadata *IERC20MetadataTransactor) TransferFrom(opts *bind.TransactOpts, sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20Metadata.contract.Transact(opts, "transferFrom", sender, recipient, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
+func (_IERC20Metadata *IERC20MetadataSession) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20Metadata.Contract.TransferFrom(&_IERC20Metadata.TransactOpts, sender, recipient, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
+func (_IERC20Metadata *IERC20MetadataTransactorSession) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IERC20Metadata.Contract.TransferFrom(&_IERC20Metadata.TransactOpts, sender, recipient, amount)
+}
+
+// IERC20MetadataApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the IERC20Metadata contract.
+type IERC20MetadataApprovalIterator struct {
+	Event *IERC20MetadataApproval // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -2522,7 +3523,7 @@ type OwnableOwnershipTransferredIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *OwnableOwnershipTransferredIterator) Next() bool {
+func (it *IERC20MetadataApprovalIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -2531,7 +3532,7 @@ func (it *OwnableOwnershipTransferredIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(OwnableOwnershipTransferred)
+			it.Event = new(IERC20MetadataApproval)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -2546,7 +3547,7 @@ func (it *OwnableOwnershipTransferredIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(OwnableOwnershipTransferred)
+		it.Event = new(IERC20MetadataApproval)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -2562,60 +3563,61 @@ func (it *OwnableOwnershipTransferredIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *OwnableOwnershipTransferredIterator) Error() error {
+func (it *IERC20MetadataApprovalIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *OwnableOwnershipTransferredIterator) Close() error {
+func (it *IERC20MetadataApprovalIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// OwnableOwnershipTransferred represents a OwnershipTransferred event raised by the Ownable contract.
-type OwnableOwnershipTransferred struct {
-	PreviousOwner common.Address
-	NewOwner      common.Address
-	Raw           types.Log // Blockchain specific contextual infos
+// IERC20MetadataApproval represents a Approval event raised by the IERC20Metadata contract.
+type IERC20MetadataApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_Ownable *OwnableFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*OwnableOwnershipTransferredIterator, error) {
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_IERC20Metadata *IERC20MetadataFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*IERC20MetadataApprovalIterator, error) {
 
-	var previousOwnerRule []interface{}
-	for _, previousOwnerItem := range previousOwner {
-		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
 	}
-	var newOwnerRule []interface{}
-	for _, newOwnerItem := range newOwner {
-		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
 	}
 
-	logs, sub, err := _Ownable.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	logs, sub, err := _IERC20Metadata.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
 	if err != nil {
 		return nil, err
 	}
-	return &OwnableOwnershipTransferredIterator{contract: _Ownable.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+	return &IERC20MetadataApprovalIterator{contract: _IERC20Metadata.contract, event: "Approval", logs: logs, sub: sub}, nil
 }
 
-// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_Ownable *OwnableFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *OwnableOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_IERC20Metadata *IERC20MetadataFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *IERC20MetadataApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
 
-	var previousOwnerRule []interface{}
-	for _, previousOwnerItem := range previousOwner {
-		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
 	}
-	var newOwnerRule []interface{}
-	for _, newOwnerItem := range newOwner {
-		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
 	}
 
-	logs, sub, err := _Ownable.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	logs, sub, err := _IERC20Metadata.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
 	if err != nil {
 		return nil, err
 	}
@@ -2625,8 +3627,8 @@ func (_Ownable *OwnableFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts,
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(OwnableOwnershipTransferred)
-				if err := _Ownable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+				event := new(IERC20MetadataApproval)
+				if err := _IERC20Metadata.contract.UnpackLog(event, "Approval", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -2647,136 +3649,287 @@ func (_Ownable *OwnableFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts,
 	}), nil
 }
 
-// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_Ownable *OwnableFilterer) ParseOwnershipTransferred(log types.Log) (*OwnableOwnershipTransferred, error) {
-	event := new(OwnableOwnershipTransferred)
-	if err := _Ownable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_IERC20Metadata *IERC20MetadataFilterer) ParseApproval(log types.Log) (*IERC20MetadataApproval, error) {
+	event := new(IERC20MetadataApproval)
+	if err := _IERC20Metadata.contract.UnpackLog(event, "Approval", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// SafeCastABI is the input ABI used to generate the binding from.
-const SafeCastABI = "[]"
+// IERC20MetadataTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the IERC20Metadata contract.
+type IERC20MetadataTransferIterator struct {
+	Event *IERC20MetadataTransfer // Event containing the contract specifics and raw log
 
-// SafeCastBin is the compiled bytecode used for deploying new contracts.
-var SafeCastBin = "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea26469706673582212201a800db746c62b0348c5b1f61c0cc6cac0714442b2fa0f336beedf8d0b54c6f364736f6c63430008020033"
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
 
-// DeploySafeCast deploys a new Ethereum contract, binding an instance of SafeCast to it.
-func DeploySafeCast(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *SafeCast, error) {
-	parsed, err := abi.JSON(strings.NewReader(SafeCastABI))
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IERC20MetadataTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IERC20MetadataTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IERC20MetadataTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IERC20MetadataTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IERC20MetadataTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IERC20MetadataTransfer represents a Transfer event raised by the IERC20Metadata contract.
+type IERC20MetadataTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_IERC20Metadata *IERC20MetadataFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*IERC20MetadataTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _IERC20Metadata.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
 	if err != nil {
-		return common.Address{}, nil, nil, err
+		return nil, err
 	}
+	return &IERC20MetadataTransferIterator{contract: _IERC20Metadata.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
 
-	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(SafeCastBin), backend)
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_IERC20Metadata *IERC20MetadataFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *IERC20MetadataTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _IERC20Metadata.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
 	if err != nil {
-		return common.Address{}, nil, nil, err
+		return nil, err
 	}
-	return address, tx, &SafeCast{SafeCastCaller: SafeCastCaller{contract: contract}, SafeCastTransactor: SafeCastTransactor{contract: contract}, SafeCastFilterer: SafeCastFilterer{contract: contract}}, nil
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IERC20MetadataTransfer)
+				if err := _IERC20Metadata.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
 }
 
-// SafeCast is an auto generated Go binding around an Ethereum contract.
-type SafeCast struct {
-	SafeCastCaller     // Read-only binding to the contract
-	SafeCastTransactor // Write-only binding to the contract
-	SafeCastFilterer   // Log filterer for contract events
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_IERC20Metadata *IERC20MetadataFilterer) ParseTransfer(log types.Log) (*IERC20MetadataTransfer, error) {
+	event := new(IERC20MetadataTransfer)
+	if err := _IERC20Metadata.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
 }
 
-// SafeCastCaller is an auto generated read-only Go binding around an Ethereum contract.
-type SafeCastCaller struct {
+// IERC721ABI is the input ABI used to generate the binding from.
+const IERC721ABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"_approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// IERC721FuncSigs maps the 4-byte function signature to its string representation.
+var IERC721FuncSigs = map[string]string{
+	"095ea7b3": "approve(address,uint256)",
+	"70a08231": "balanceOf(address)",
+	"081812fc": "getApproved(uint256)",
+	"e985e9c5": "isApprovedForAll(address,address)",
+	"6352211e": "ownerOf(uint256)",
+	"42842e0e": "safeTransferFrom(address,address,uint256)",
+	"b88d4fde": "safeTransferFrom(address,address,uint256,bytes)",
+	"a22cb465": "setApprovalForAll(address,bool)",
+	"01ffc9a7": "supportsInterface(bytes4)",
+	"23b872dd": "transferFrom(address,address,uint256)",
+}
+
+// IERC721 is an auto generated Go binding around an Ethereum contract.
+type IERC721 struct {
+	IERC721Caller     // Read-only binding to the contract
+	IERC721Transactor // Write-only binding to the contract
+	IERC721Filterer   // Log filterer for contract events
+}
+
+// IERC721Caller is an auto generated read-only Go binding around an Ethereum contract.
+type IERC721Caller struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// SafeCastTransactor is an auto generated write-only Go binding around an Ethereum contract.
-type SafeCastTransactor struct {
+// IERC721Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type IERC721Transactor struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// SafeCastFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
-type SafeCastFilterer struct {
+// IERC721Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IERC721Filterer struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// SafeCastSession is an auto generated Go binding around an Ethereum contract,
+// IERC721Session is an auto generated Go binding around an Ethereum contract,
 // with pre-set call and transact options.
-type SafeCastSession struct {
-	Contract     *SafeCast         // Generic contract binding to set the session for
+type IERC721Session struct {
+	Contract     *IERC721          // Generic contract binding to set the session for
 	CallOpts     bind.CallOpts     // Call options to use throughout this session
 	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
 }
 
-// SafeCastCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// IERC721CallerSession is an auto generated read-only Go binding around an Ethereum contract,
 // with pre-set call options.
-type SafeCastCallerSession struct {
-	Contract *SafeCastCaller // Generic contract caller binding to set the session for
-	CallOpts bind.CallOpts   // Call options to use throughout this session
+type IERC721CallerSession struct {
+	Contract *IERC721Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
 }
 
-// SafeCastTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// IERC721TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
 // with pre-set transact options.
-type SafeCastTransactorSession struct {
-	Contract     *SafeCastTransactor // Generic contract transactor binding to set the session for
-	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+type IERC721TransactorSession struct {
+	Contract     *IERC721Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
 }
 
-// SafeCastRaw is an auto generated low-level Go binding around an Ethereum contract.
-type SafeCastRaw struct {
-	Contract *SafeCast // Generic contract binding to access the raw methods on
+// IERC721Raw is an auto generated low-level Go binding around an Ethereum contract.
+type IERC721Raw struct {
+	Contract *IERC721 // Generic contract binding to access the raw methods on
 }
 
-// SafeCastCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
-type SafeCastCallerRaw struct {
-	Contract *SafeCastCaller // Generic read-only contract binding to access the raw methods on
+// IERC721CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IERC721CallerRaw struct {
+	Contract *IERC721Caller // Generic read-only contract binding to access the raw methods on
 }
 
-// SafeCastTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
-type SafeCastTransactorRaw struct {
-	Contract *SafeCastTransactor // Generic write-only contract binding to access the raw methods on
+// IERC721TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IERC721TransactorRaw struct {
+	Contract *IERC721Transactor // Generic write-only contract binding to access the raw methods on
 }
 
-// NewSafeCast creates a new instance of SafeCast, bound to a specific deployed contract.
-func NewSafeCast(address common.Address, backend bind.ContractBackend) (*SafeCast, error) {
-	contract, err := bindSafeCast(address, backend, backend, backend)
+// NewIERC721 creates a new instance of IERC721, bound to a specific deployed contract.
+func NewIERC721(address common.Address, backend bind.ContractBackend) (*IERC721, error) {
+	contract, err := bindIERC721(address, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
-	return &SafeCast{SafeCastCaller: SafeCastCaller{contract: contract}, SafeCastTransactor: SafeCastTransactor{contract: contract}, SafeCastFilterer: SafeCastFilterer{contract: contract}}, nil
+	return &IERC721{IERC721Caller: IERC721Caller{contract: contract}, IERC721Transactor: IERC721Transactor{contract: contract}, IERC721Filterer: IERC721Filterer{contract: contract}}, nil
 }
 
-// NewSafeCastCaller creates a new read-only instance of SafeCast, bound to a specific deployed contract.
-func NewSafeCastCaller(address common.Address, caller bind.ContractCaller) (*SafeCastCaller, error) {
-	contract, err := bindSafeCast(address, caller, nil, nil)
+// NewIERC721Caller creates a new read-only instance of IERC721, bound to a specific deployed contract.
+func NewIERC721Caller(address common.Address, caller bind.ContractCaller) (*IERC721Caller, error) {
+	contract, err := bindIERC721(address, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	return &SafeCastCaller{contract: contract}, nil
+	return &IERC721Caller{contract: contract}, nil
 }
 
-// NewSafeCastTransactor creates a new write-only instance of SafeCast, bound to a specific deployed contract.
-func NewSafeCastTransactor(address common.Address, transactor bind.ContractTransactor) (*SafeCastTransactor, error) {
-	contract, err := bindSafeCast(address, nil, transactor, nil)
+// NewIERC721Transactor creates a new write-only instance of IERC721, bound to a specific deployed contract.
+func NewIERC721Transactor(address common.Address, transactor bind.ContractTransactor) (*IERC721Transactor, error) {
+	contract, err := bindIERC721(address, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
-	return &SafeCastTransactor{contract: contract}, nil
+	return &IERC721Transactor{contract: contract}, nil
 }
 
-// NewSafeCastFilterer creates a new log filterer instance of SafeCast, bound to a specific deployed contract.
-func NewSafeCastFilterer(address common.Address, filterer bind.ContractFilterer) (*SafeCastFilterer, error) {
-	contract, err := bindSafeCast(address, nil, nil, filterer)
+// NewIERC721Filterer creates a new log filterer instance of IERC721, bound to a specific deployed contract.
+func NewIERC721Filterer(address common.Address, filterer bind.ContractFilterer) (*IERC721Filterer, error) {
+	contract, err := bindIERC721(address, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
-	return &SafeCastFilterer{contract: contract}, nil
+	return &IERC721Filterer{contract: contract}, nil
 }
 
-// bindSafeCast binds a generic wrapper to an already deployed contract.
-func bindSafeCast(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
-	parsed, err := abi.JSON(strings.NewReader(SafeCastABI))
+// bindIERC721 binds a generic wrapper to an already deployed contract.
+func bindIERC721(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(IERC721ABI))
 	if err != nil {
 		return nil, err
 	}
@@ -2787,269 +3940,2921 @@ func bindSafeCast(address common.Address, caller bind.ContractCaller, transactor
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_SafeCast *SafeCastRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _SafeCast.Contract.SafeCastCaller.contract.Call(opts, result, method, params...)
+func (_IERC721 *IERC721Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IERC721.Contract.IERC721Caller.contract.Call(opts, result, method, params...)
 }
 
 // Transfer initiates a plain transaction to move funds to the contract, calling
 // its default method if one is available.
-func (_SafeCast *SafeCastRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _SafeCast.Contract.SafeCastTransactor.contract.Transfer(opts)
+func (_IERC721 *IERC721Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IERC721.Contract.IERC721Transactor.contract.Transfer(opts)
 }
 
 // Transact invokes the (paid) contract method with params as input values.
-func (_SafeCast *SafeCastRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _SafeCast.Contract.SafeCastTransactor.contract.Transact(opts, method, params...)
+func (_IERC721 *IERC721Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IERC721.Contract.IERC721Transactor.contract.Transact(opts, method, params...)
 }
 
 // Call invokes the (constant) contract method with params as input values and
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_SafeCast *SafeCastCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _SafeCast.Contract.contract.Call(opts, result, method, params...)
+func (_IERC721 *IERC721CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IERC721.Contract.contract.Call(opts, result, method, params...)
 }
 
 // Transfer initiates a plain transaction to move funds to the contract, calling
 // its default method if one is available.
-func (_SafeCast *SafeCastTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _SafeCast.Contract.contract.Transfer(opts)
+func (_IERC721 *IERC721TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IERC721.Contract.contract.Transfer(opts)
 }
 
 // Transact invokes the (paid) contract method with params as input values.
-func (_SafeCast *SafeCastTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _SafeCast.Contract.contract.Transact(opts, method, params...)
+func (_IERC721 *IERC721TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IERC721.Contract.contract.Transact(opts, method, params...)
 }
 
-// SafeMathABI is the input ABI used to generate the binding from.
-const SafeMathABI = "[]"
-
-// SafeMathBin is the compiled bytecode used for deploying new contracts.
-var SafeMathBin = "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea26469706673582212201f436db860a221130ded49a84e9deb1bea525d08a5a93f1933e1ff980bf4f82864736f6c63430008020033"
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256 balance)
+func (_IERC721 *IERC721Caller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IERC721.contract.Call(opts, &out, "balanceOf", owner)
 
-// DeploySafeMath deploys a new Ethereum contract, binding an instance of SafeMath to it.
-func DeploySafeMath(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *SafeMath, error) {
-	parsed, err := abi.JSON(strings.NewReader(SafeMathABI))
 	if err != nil {
-		return common.Address{}, nil, nil, err
+		return *new(*big.Int), err
 	}
 
-	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(SafeMathBin), backend)
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-	return address, tx, &SafeMath{SafeMathCaller: SafeMathCaller{contract: contract}, SafeMathTransactor: SafeMathTransactor{contract: contract}, SafeMathFilterer: SafeMathFilterer{contract: contract}}, nil
-}
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
 
-// SafeMath is an auto generated Go binding around an Ethereum contract.
-type SafeMath struct {
-	SafeMathCaller     // Read-only binding to the contract
-	SafeMathTransactor // Write-only binding to the contract
-	SafeMathFilterer   // Log filterer for contract events
-}
+	return out0, err
 
-// SafeMathCaller is an auto generated read-only Go binding around an Ethereum contract.
-type SafeMathCaller struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// SafeMathTransactor is an auto generated write-only Go binding around an Ethereum contract.
-type SafeMathTransactor struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256 balance)
+func (_IERC721 *IERC721Session) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _IERC721.Contract.BalanceOf(&_IERC721.CallOpts, owner)
 }
 
-// SafeMathFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
-type SafeMathFilterer struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256 balance)
+func (_IERC721 *IERC721CallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _IERC721.Contract.BalanceOf(&_IERC721.CallOpts, owner)
 }
 
-// SafeMathSession is an auto generated Go binding around an Ethereum contract,
-// with pre-set call and transact options.
-type SafeMathSession struct {
-	Contract     *SafeMath         // Generic contract binding to set the session for
-	CallOpts     bind.CallOpts     // Call options to use throughout this session
-	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
-}
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address operator)
+func (_IERC721 *IERC721Caller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _IERC721.contract.Call(opts, &out, "getApproved", tokenId)
 
-// SafeMathCallerSession is an auto generated read-only Go binding around an Ethereum contract,
-// with pre-set call options.
-type SafeMathCallerSession struct {
-	Contract *SafeMathCaller // Generic contract caller binding to set the session for
-	CallOpts bind.CallOpts   // Call options to use throughout this session
-}
+	if err != nil {
+		return *new(common.Address), err
+	}
 
-// SafeMathTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
-// with pre-set transact options.
-type SafeMathTransactorSession struct {
-	Contract     *SafeMathTransactor // Generic contract transactor binding to set the session for
-	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
-}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
 
-// SafeMathRaw is an auto generated low-level Go binding around an Ethereum contract.
-type SafeMathRaw struct {
-	Contract *SafeMath // Generic contract binding to access the raw methods on
 }
 
-// SafeMathCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
-type SafeMathCallerRaw struct {
-	Contract *SafeMathCaller // Generic read-only contract binding to access the raw methods on
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address operator)
+func (_IERC721 *IERC721Session) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _IERC721.Contract.GetApproved(&_IERC721.CallOpts, tokenId)
 }
 
-// SafeMathTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
-type SafeMathTransactorRaw struct {
-	Contract *SafeMathTransactor // Generic write-only contract binding to access the raw methods on
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address operator)
+func (_IERC721 *IERC721CallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _IERC721.Contract.GetApproved(&_IERC721.CallOpts, tokenId)
 }
 
-// NewSafeMath creates a new instance of SafeMath, bound to a specific deployed contract.
-func NewSafeMath(address common.Address, backend bind.ContractBackend) (*SafeMath, error) {
-	contract, err := bindSafeMath(address, backend, backend, backend)
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_IERC721 *IERC721Caller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _IERC721.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
 	if err != nil {
-		return nil, err
+		return *new(bool), err
 	}
-	return &SafeMath{SafeMathCaller: SafeMathCaller{contract: contract}, SafeMathTransactor: SafeMathTransactor{contract: contract}, SafeMathFilterer: SafeMathFilterer{contract: contract}}, nil
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
 }
 
-// NewSafeMathCaller creates a new read-only instance of SafeMath, bound to a specific deployed contract.
-func NewSafeMathCaller(address common.Address, caller bind.ContractCaller) (*SafeMathCaller, error) {
-	contract, err := bindSafeMath(address, caller, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	return &SafeMathCaller{contract: contract}, nil
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_IERC721 *IERC721Session) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _IERC721.Contract.IsApprovedForAll(&_IERC721.CallOpts, owner, operator)
 }
 
-// NewSafeMathTransactor creates a new write-only instance of SafeMath, bound to a specific deployed contract.
-func NewSafeMathTransactor(address common.Address, transactor bind.ContractTransactor) (*SafeMathTransactor, error) {
-	contract, err := bindSafeMath(address, nil, transactor, nil)
-	if err != nil {
-		return nil, err
-	}
-	return &SafeMathTransactor{contract: contract}, nil
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_IERC721 *IERC721CallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _IERC721.Contract.IsApprovedForAll(&_IERC721.CallOpts, owner, operator)
 }
 
-// NewSafeMathFilterer creates a new log filterer instance of SafeMath, bound to a specific deployed contract.
-func NewSafeMathFilterer(address common.Address, filterer bind.ContractFilterer) (*SafeMathFilterer, error) {
-	contract, err := bindSafeMath(address, nil, nil, filterer)
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address owner)
+func (_IERC721 *IERC721Caller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _IERC721.contract.Call(opts, &out, "ownerOf", tokenId)
+
 	if err != nil {
-		return nil, err
+		return *new(common.Address), err
 	}
-	return &SafeMathFilterer{contract: contract}, nil
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
 }
 
-// bindSafeMath binds a generic wrapper to an already deployed contract.
-func bindSafeMath(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
-	parsed, err := abi.JSON(strings.NewReader(SafeMathABI))
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address owner)
+func (_IERC721 *IERC721Session) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _IERC721.Contract.OwnerOf(&_IERC721.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address owner)
+func (_IERC721 *IERC721CallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _IERC721.Contract.OwnerOf(&_IERC721.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_IERC721 *IERC721Caller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _IERC721.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
 	if err != nil {
-		return nil, err
+		return *new(bool), err
 	}
-	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
 }
 
-// Call invokes the (constant) contract method with params as input values and
-// sets the output to result. The result type might be a single field for simple
-// returns, a slice of interfaces for anonymous returns and a struct for named
-// returns.
-func (_SafeMath *SafeMathRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _SafeMath.Contract.SafeMathCaller.contract.Call(opts, result, method, params...)
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_IERC721 *IERC721Session) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _IERC721.Contract.SupportsInterface(&_IERC721.CallOpts, interfaceId)
 }
 
-// Transfer initiates a plain transaction to move funds to the contract, calling
-// its default method if one is available.
-func (_SafeMath *SafeMathRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _SafeMath.Contract.SafeMathTransactor.contract.Transfer(opts)
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_IERC721 *IERC721CallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _IERC721.Contract.SupportsInterface(&_IERC721.CallOpts, interfaceId)
 }
 
-// Transact invokes the (paid) contract method with params as input values.
-func (_SafeMath *SafeMathRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _SafeMath.Contract.SafeMathTransactor.contract.Transact(opts, method, params...)
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_IERC721 *IERC721Transactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721.contract.Transact(opts, "approve", to, tokenId)
 }
 
-// Call invokes the (constant) contract method with params as input values and
-// sets the output to result. The result type might be a single field for simple
-// returns, a slice of interfaces for anonymous returns and a struct for named
-// returns.
-func (_SafeMath *SafeMathCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _SafeMath.Contract.contract.Call(opts, result, method, params...)
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_IERC721 *IERC721Session) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721.Contract.Approve(&_IERC721.TransactOpts, to, tokenId)
 }
 
-// Transfer initiates a plain transaction to move funds to the contract, calling
-// its default method if one is available.
-func (_SafeMath *SafeMathTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _SafeMath.Contract.contract.Transfer(opts)
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_IERC721 *IERC721TransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721.Contract.Approve(&_IERC721.TransactOpts, to, tokenId)
 }
 
-// Transact invokes the (paid) contract method with params as input values.
-func (_SafeMath *SafeMathTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _SafeMath.Contract.contract.Transact(opts, method, params...)
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_IERC721 *IERC721Transactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
 }
 
-// USDXABI is the input ABI used to generate the binding from.
-const USDXABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_priceFeed\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"accounts\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"locked\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"mint\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"appreciation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_limit\",\"type\":\"uint256\"}],\"name\":\"collectAppreciation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"subtractedValue\",\"type\":\"uint256\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"priceStalenessThreshold\",\"outputs\":[{\"internalType\":\"uint80\",\"name\":\"\",\"type\":\"uint80\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_newFeed\",\"type\":\"address\"}],\"name\":\"setFeed\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint80\",\"name\":\"_newThreshold\",\"type\":\"uint80\"}],\"name\":\"setStalenessThreshold\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"}],\"name\":\"transferAcct\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_usdx\",\"type\":\"uint256\"}],\"name\":\"unlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"usdPriceFeed\",\"outputs\":[{\"internalType\":\"contractAggregatorV3Interface\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]"
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_IERC721 *IERC721Session) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721.Contract.SafeTransferFrom(&_IERC721.TransactOpts, from, to, tokenId)
+}
 
-// USDXFuncSigs maps the 4-byte function signature to its string representation.
-var USDXFuncSigs = map[string]string{
-	"5e5c06e2": "accounts(address)",
-	"dd62ed3e": "allowance(address,address)",
-	"d3980103": "appreciation(address)",
-	"095ea7b3": "approve(address,uint256)",
-	"70a08231": "balanceOf(address)",
-	"1a254f12": "collectAppreciation(uint256)",
-	"313ce567": "decimals()",
-	"a457c2d7": "decreaseAllowance(address,uint256)",
-	"39509351": "increaseAllowance(address,uint256)",
-	"06fdde03": "name()",
-	"8da5cb5b": "owner()",
-	"bd111870": "priceStalenessThreshold()",
-	"715018a6": "renounceOwnership()",
-	"55b775ea": "setFeed(address)",
-	"0f3a72ce": "setStalenessThreshold(uint80)",
-	"95d89b41": "symbol()",
-	"18160ddd": "totalSupply()",
-	"a9059cbb": "transfer(address,uint256)",
-	"ac660479": "transferAcct(address)",
-	"23b872dd": "transferFrom(address,address,uint256)",
-	"f2fde38b": "transferOwnership(address)",
-	"6198e339": "unlock(uint256)",
-	"de4874b0": "usdPriceFeed()",
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_IERC721 *IERC721TransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721.Contract.SafeTransferFrom(&_IERC721.TransactOpts, from, to, tokenId)
 }
 
-// USDXBin is the compiled bytecode used for deploying new contracts.
-var USDXBin = "0x608060405260068054600160a01b600160f01b03191690553480156200002457600080fd5b5060405162001bb738038062001bb78339810160408190526200004791620002de565b604080518082018252600f81526e2aa9a22c1029ba30b13632b1b7b4b760891b6020808301918252835180850190945260048452630aaa688b60e31b9084015281519192916200009a9160039162000238565b508051620000b090600490602084019062000238565b5050506000620000c56200012560201b60201c565b600580546001600160a01b0319166001600160a01b038316908117909155604051919250906000907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0908290a3506200011e8162000129565b506200036e565b3390565b6005546001600160a01b03163314620001885760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604482015260640160405180910390fd5b6000819050600860ff16816001600160a01b031663313ce5676040518163ffffffff1660e01b815260040160206040518083038186803b158015620001cc57600080fd5b505afa158015620001e1573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200020791906200030e565b60ff16146200021557600080fd5b600680546001600160a01b0319166001600160a01b039290921691909117905550565b828054620002469062000331565b90600052602060002090601f0160209004810192826200026a5760008555620002b5565b82601f106200028557805160ff1916838001178555620002b5565b82800160010185558215620002b5579182015b82811115620002b557825182559160200191906001019062000298565b50620002c3929150620002c7565b5090565b5b80821115620002c35760008155600101620002c8565b600060208284031215620002f0578081fd5b81516001600160a01b038116811462000307578182fd5b9392505050565b60006020828403121562000320578081fd5b815160ff8116811462000307578182fd5b6002810460018216806200034657607f821691505b602082108114156200036857634e487b7160e01b600052602260045260246000fd5b50919050565b611839806200037e6000396000f3fe6080604052600436106101445760003560e01c806370a08231116100b6578063ac6604791161006f578063ac6604791461042d578063bd1118701461044d578063d39801031461048c578063dd62ed3e146104ac578063de4874b0146104f2578063f2fde38b14610512576101b5565b806370a082311461035b578063715018a6146103915780638da5cb5b146103a657806395d89b41146103d8578063a457c2d7146103ed578063a9059cbb1461040d576101b5565b806323b872dd1161010857806323b872dd14610276578063313ce5671461029657806339509351146102b257806355b775ea146102d25780635e5c06e2146102f25780636198e3391461033b576101b5565b806306fdde03146101ba578063095ea7b3146101e55780630f3a72ce1461021557806318160ddd146102375780631a254f1214610256576101b5565b366101b5576000610153610532565b905060006101613483610647565b336000908152600760205260408120805492935091349183916101859084906115ed565b92505081905550818160010160008282546101a091906115ed565b909155506101b090503383610678565b505050005b600080fd5b3480156101c657600080fd5b506101cf610757565b6040516101dc9190611565565b60405180910390f35b3480156101f157600080fd5b50610205610200366004611490565b6107e9565b60405190151581526020016101dc565b34801561022157600080fd5b506102356102303660046114d1565b6107ff565b005b34801561024357600080fd5b506002545b6040519081526020016101dc565b34801561026257600080fd5b506102486102713660046114b9565b610858565b34801561028257600080fd5b50610205610291366004611455565b6108c8565b3480156102a257600080fd5b50604051601281526020016101dc565b3480156102be57600080fd5b506102056102cd366004611490565b610979565b3480156102de57600080fd5b506102356102ed366004611409565b6109b0565b3480156102fe57600080fd5b5061032661030d366004611409565b6007602052600090815260409020805460019091015482565b604080519283526020830191909152016101dc565b34801561034757600080fd5b506102486103563660046114b9565b610a85565b34801561036757600080fd5b50610248610376366004611409565b6001600160a01b031660009081526020819052604090205490565b34801561039d57600080fd5b50610235610c0b565b3480156103b257600080fd5b506005546001600160a01b03165b6040516001600160a01b0390911681526020016101dc565b3480156103e457600080fd5b506101cf610c7f565b3480156103f957600080fd5b50610205610408366004611490565b610c8e565b34801561041957600080fd5b50610205610428366004611490565b610d29565b34801561043957600080fd5b50610235610448366004611409565b610d36565b34801561045957600080fd5b5060065461047490600160a01b90046001600160501b031681565b6040516001600160501b0390911681526020016101dc565b34801561049857600080fd5b506102486104a7366004611409565b610dac565b3480156104b857600080fd5b506102486104c7366004611423565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205490565b3480156104fe57600080fd5b506006546103c0906001600160a01b031681565b34801561051e57600080fd5b5061023561052d366004611409565b610dd7565b600080600080600660009054906101000a90046001600160a01b03166001600160a01b031663feaf968c6040518163ffffffff1660e01b815260040160a06040518083038186803b15801561058657600080fd5b505afa15801561059a573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906105be91906114ed565b9450505092509250600660149054906101000a90046001600160501b03166001600160501b031681846105f19190611772565b6001600160501b031611156106405760405162461bcd60e51b815260206004820152601060248201526f1cdd185b19481c1c9a58d9481999595960821b60448201526064015b60405180910390fd5b5091505090565b60006106716106586008600a61166b565b61066b61066485610ec2565b8690610f18565b90610f24565b9392505050565b6001600160a01b0382166106ce5760405162461bcd60e51b815260206004820152601f60248201527f45524332303a206d696e7420746f20746865207a65726f2061646472657373006044820152606401610637565b80600260008282546106e091906115ed565b90915550506001600160a01b0382166000908152602081905260408120805483929061070d9084906115ed565b90915550506040518181526001600160a01b038316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a35050565b6060600380546107669061179a565b80601f01602080910402602001604051908101604052809291908181526020018280546107929061179a565b80156107df5780601f106107b4576101008083540402835291602001916107df565b820191906000526020600020905b8154815290600101906020018083116107c257829003601f168201915b5050505050905090565b60006107f6338484610f30565b50600192915050565b6005546001600160a01b031633146108295760405162461bcd60e51b8152600401610637906115b8565b600680546001600160501b03909216600160a01b0269ffffffffffffffffffff60a01b19909216919091179055565b336000908152600760205260408120818061087283611055565b915091508161088757600093505050506108c3565b841561089a57610897858261108f565b90505b808360010160008282546108ae91906115ed565b909155506108be90503382610678565b925050505b919050565b60006108d58484846110a5565b6001600160a01b03841660009081526001602090815260408083203384529091529020548281101561095a5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b6064820152608401610637565b61096e8533610969868561175b565b610f30565b506001949350505050565b3360008181526001602090815260408083206001600160a01b038716845290915281205490916107f69185906109699086906115ed565b6005546001600160a01b031633146109da5760405162461bcd60e51b8152600401610637906115b8565b6000819050600860ff16816001600160a01b031663313ce5676040518163ffffffff1660e01b815260040160206040518083038186803b158015610a1d57600080fd5b505afa158015610a31573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610a559190611544565b60ff1614610a6257600080fd5b600680546001600160a01b0319166001600160a01b039290921691909117905550565b3360009081526007602052604081208054610ad65760405162461bcd60e51b81526020600482015260116024820152706e6f7468696e6720746f2072656465656d60781b6044820152606401610637565b82610ae75780600101549250610af8565b610af583826001015461108f565b92505b33600090815260208190526040902054610b1390849061108f565b925060008311610b575760405162461bcd60e51b815260206004820152600f60248201526e6e6f20757364782062616c616e636560881b6044820152606401610637565b610b61338461127d565b60018101548154600091610b799161066b9087610f18565b90508160010154841415610ba25733600090815260076020526040812081815560010155610bd5565b83826001016000828254610bb6919061175b565b9091555050815481908390600090610bcf90849061175b565b90915550505b604051339082156108fc029083906000818181858888f19350505050158015610c02573d6000803e3d6000fd5b50929392505050565b6005546001600160a01b03163314610c355760405162461bcd60e51b8152600401610637906115b8565b6005546040516000916001600160a01b0316907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0908390a3600580546001600160a01b0319169055565b6060600480546107669061179a565b3360009081526001602090815260408083206001600160a01b038616845290915281205482811015610d105760405162461bcd60e51b815260206004820152602560248201527f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f77604482015264207a65726f60d81b6064820152608401610637565b610d1f3385610969868561175b565b5060019392505050565b60006107f63384846110a5565b33600090815260076020526040902054610d4f57600080fd5b6001600160a01b03811660009081526007602052604090205415610d7257600080fd5b336000818152600760205260408082206001600160a01b039490941682528120835481556001808501805491909201559181529182905555565b6001600160a01b038116600090815260076020526040812081610dce82611055565b95945050505050565b6005546001600160a01b03163314610e015760405162461bcd60e51b8152600401610637906115b8565b6001600160a01b038116610e665760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610637565b6005546040516001600160a01b038084169216907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3600580546001600160a01b0319166001600160a01b0392909216919091179055565b600080821215610f145760405162461bcd60e51b815260206004820181905260248201527f53616665436173743a2076616c7565206d75737420626520706f7369746976656044820152606401610637565b5090565b6000610671828461173c565b60006106718284611605565b6001600160a01b038316610f925760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b6064820152608401610637565b6001600160a01b038216610ff35760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b6064820152608401610637565b6001600160a01b0383811660008181526001602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6000806000611062610532565b90506000611074856000015483610647565b90506110848186600101546113cc565b935093505050915091565b600081831061109e5781610671565b5090919050565b6001600160a01b0383166111095760405162461bcd60e51b815260206004820152602560248201527f45524332303a207472616e736665722066726f6d20746865207a65726f206164604482015264647265737360d81b6064820152608401610637565b6001600160a01b03821661116b5760405162461bcd60e51b815260206004820152602360248201527f45524332303a207472616e7366657220746f20746865207a65726f206164647260448201526265737360e81b6064820152608401610637565b6001600160a01b038316600090815260208190526040902054818110156111e35760405162461bcd60e51b815260206004820152602660248201527f45524332303a207472616e7366657220616d6f756e7420657863656564732062604482015265616c616e636560d01b6064820152608401610637565b6111ed828261175b565b6001600160a01b0380861660009081526020819052604080822093909355908516815290812080548492906112239084906115ed565b92505081905550826001600160a01b0316846001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8460405161126f91815260200190565b60405180910390a350505050565b6001600160a01b0382166112dd5760405162461bcd60e51b815260206004820152602160248201527f45524332303a206275726e2066726f6d20746865207a65726f206164647265736044820152607360f81b6064820152608401610637565b6001600160a01b038216600090815260208190526040902054818110156113515760405162461bcd60e51b815260206004820152602260248201527f45524332303a206275726e20616d6f756e7420657863656564732062616c616e604482015261636560f01b6064820152608401610637565b61135b828261175b565b6001600160a01b0384166000908152602081905260408120919091556002805484929061138990849061175b565b90915550506040518281526000906001600160a01b038516907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001611048565b600080838311156113e2575060009050806113eb565b50600190508183035b9250929050565b80356001600160a01b03811681146108c357600080fd5b60006020828403121561141a578081fd5b610671826113f2565b60008060408385031215611435578081fd5b61143e836113f2565b915061144c602084016113f2565b90509250929050565b600080600060608486031215611469578081fd5b611472846113f2565b9250611480602085016113f2565b9150604084013590509250925092565b600080604083850312156114a2578182fd5b6114ab836113f2565b946020939093013593505050565b6000602082840312156114ca578081fd5b5035919050565b6000602082840312156114e2578081fd5b8135610671816117eb565b600080600080600060a08688031215611504578081fd5b855161150f816117eb565b809550506020860151935060408601519250606086015191506080860151611536816117eb565b809150509295509295909350565b600060208284031215611555578081fd5b815160ff81168114610671578182fd5b6000602080835283518082850152825b8181101561159157858101830151858201604001528201611575565b818111156115a25783604083870101525b50601f01601f1916929092016040019392505050565b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b60008219821115611600576116006117d5565b500190565b60008261162057634e487b7160e01b81526012600452602481fd5b500490565b80825b60018086116116375750611662565b818704821115611649576116496117d5565b8086161561165657918102915b9490941c938002611628565b94509492505050565b600061067160001960ff85168460008261168757506001610671565b8161169457506000610671565b81600181146116aa57600281146116b4576116e1565b6001915050610671565b60ff8411156116c5576116c56117d5565b6001841b9150848211156116db576116db6117d5565b50610671565b5060208310610133831016604e8410600b8410161715611714575081810a8381111561170f5761170f6117d5565b610671565b6117218484846001611625565b808604821115611733576117336117d5565b02949350505050565b6000816000190483118215151615611756576117566117d5565b500290565b60008282101561176d5761176d6117d5565b500390565b60006001600160501b0383811690831681811015611792576117926117d5565b039392505050565b6002810460018216806117ae57607f821691505b602082108114156117cf57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b6001600160501b038116811461180057600080fd5b5056fea2646970667358221220c7b6f68642c1ed46856f4725e4140fa2875f7b1f12ace27602cdc8530fba853d64736f6c63430008020033"
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_IERC721 *IERC721Transactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _IERC721.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
 
-// DeployUSDX deploys a new Ethereum contract, binding an instance of USDX to it.
-func DeployUSDX(auth *bind.TransactOpts, backend bind.ContractBackend, _priceFeed common.Address) (common.Address, *types.Transaction, *USDX, error) {
-	parsed, err := abi.JSON(strings.NewReader(USDXABI))
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_IERC721 *IERC721Session) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _IERC721.Contract.SafeTransferFrom0(&_IERC721.TransactOpts, from, to, tokenId, data)
+}
 
-	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(USDXBin), backend, _priceFeed)
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-	return address, tx, &USDX{USDXCaller: USDXCaller{contract: contract}, USDXTransactor: USDXTransactor{contract: contract}, USDXFilterer: USDXFilterer{contract: contract}}, nil
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_IERC721 *IERC721TransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _IERC721.Contract.SafeTransferFrom0(&_IERC721.TransactOpts, from, to, tokenId, data)
 }
 
-// USDX is an auto generated Go binding around an Ethereum contract.
-type USDX struct {
-	USDXCaller     // Read-only binding to the contract
-	USDXTransactor // Write-only binding to the contract
-	USDXFilterer   // Log filterer for contract events
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool _approved) returns()
+func (_IERC721 *IERC721Transactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, _approved bool) (*types.Transaction, error) {
+	return _IERC721.contract.Transact(opts, "setApprovalForAll", operator, _approved)
 }
 
-// USDXCaller is an auto generated read-only Go binding around an Ethereum contract.
-type USDXCaller struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool _approved) returns()
+func (_IERC721 *IERC721Session) SetApprovalForAll(operator common.Address, _approved bool) (*types.Transaction, error) {
+	return _IERC721.Contract.SetApprovalForAll(&_IERC721.TransactOpts, operator, _approved)
 }
 
-// USDXTransactor is an auto generated write-only Go binding around an Ethereum contract.
-type USDXTransactor struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool _approved) returns()
+func (_IERC721 *IERC721TransactorSession) SetApprovalForAll(operator common.Address, _approved bool) (*types.Transaction, error) {
+	return _IERC721.Contract.SetApprovalForAll(&_IERC721.TransactOpts, operator, _approved)
 }
 
-// USDXFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
-type USDXFilterer struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_IERC721 *IERC721Transactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_IERC721 *IERC721Session) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721.Contract.TransferFrom(&_IERC721.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_IERC721 *IERC721TransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721.Contract.TransferFrom(&_IERC721.TransactOpts, from, to, tokenId)
+}
+
+// IERC721ApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the IERC721 contract.
+type IERC721ApprovalIterator struct {
+	Event *IERC721Approval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IERC721ApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IERC721Approval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IERC721Approval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IERC721ApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IERC721ApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IERC721Approval represents a Approval event raised by the IERC721 contract.
+type IERC721Approval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_IERC721 *IERC721Filterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*IERC721ApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _IERC721.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721ApprovalIterator{contract: _IERC721.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_IERC721 *IERC721Filterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *IERC721Approval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _IERC721.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IERC721Approval)
+				if err := _IERC721.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_IERC721 *IERC721Filterer) ParseApproval(log types.Log) (*IERC721Approval, error) {
+	event := new(IERC721Approval)
+	if err := _IERC721.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IERC721ApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the IERC721 contract.
+type IERC721ApprovalForAllIterator struct {
+	Event *IERC721ApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IERC721ApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IERC721ApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IERC721ApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IERC721ApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IERC721ApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IERC721ApprovalForAll represents a ApprovalForAll event raised by the IERC721 contract.
+type IERC721ApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_IERC721 *IERC721Filterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*IERC721ApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IERC721.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721ApprovalForAllIterator{contract: _IERC721.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_IERC721 *IERC721Filterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *IERC721ApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IERC721.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IERC721ApprovalForAll)
+				if err := _IERC721.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_IERC721 *IERC721Filterer) ParseApprovalForAll(log types.Log) (*IERC721ApprovalForAll, error) {
+	event := new(IERC721ApprovalForAll)
+	if err := _IERC721.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IERC721TransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the IERC721 contract.
+type IERC721TransferIterator struct {
+	Event *IERC721Transfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IERC721TransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IERC721Transfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IERC721Transfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IERC721TransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IERC721TransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IERC721Transfer represents a Transfer event raised by the IERC721 contract.
+type IERC721Transfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_IERC721 *IERC721Filterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*IERC721TransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _IERC721.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721TransferIterator{contract: _IERC721.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_IERC721 *IERC721Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *IERC721Transfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _IERC721.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IERC721Transfer)
+				if err := _IERC721.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_IERC721 *IERC721Filterer) ParseTransfer(log types.Log) (*IERC721Transfer, error) {
+	event := new(IERC721Transfer)
+	if err := _IERC721.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IERC721MetadataABI is the input ABI used to generate the binding from.
+const IERC721MetadataABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"_approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// IERC721MetadataFuncSigs maps the 4-byte function signature to its string representation.
+var IERC721MetadataFuncSigs = map[string]string{
+	"095ea7b3": "approve(address,uint256)",
+	"70a08231": "balanceOf(address)",
+	"081812fc": "getApproved(uint256)",
+	"e985e9c5": "isApprovedForAll(address,address)",
+	"06fdde03": "name()",
+	"6352211e": "ownerOf(uint256)",
+	"42842e0e": "safeTransferFrom(address,address,uint256)",
+	"b88d4fde": "safeTransferFrom(address,address,uint256,bytes)",
+	"a22cb465": "setApprovalForAll(address,bool)",
+	"01ffc9a7": "supportsInterface(bytes4)",
+	"95d89b41": "symbol()",
+	"c87b56dd": "tokenURI(uint256)",
+	"23b872dd": "transferFrom(address,address,uint256)",
+}
+
+// IERC721Metadata is an auto generated Go binding around an Ethereum contract.
+type IERC721Metadata struct {
+	IERC721MetadataCaller     // Read-only binding to the contract
+	IERC721MetadataTransactor // Write-only binding to the contract
+	IERC721MetadataFilterer   // Log filterer for contract events
+}
+
+// IERC721MetadataCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IERC721MetadataCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IERC721MetadataTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IERC721MetadataTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IERC721MetadataFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IERC721MetadataFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IERC721MetadataSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IERC721MetadataSession struct {
+	Contract     *IERC721Metadata  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IERC721MetadataCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IERC721MetadataCallerSession struct {
+	Contract *IERC721MetadataCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// IERC721MetadataTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IERC721MetadataTransactorSession struct {
+	Contract     *IERC721MetadataTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// IERC721MetadataRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IERC721MetadataRaw struct {
+	Contract *IERC721Metadata // Generic contract binding to access the raw methods on
+}
+
+// IERC721MetadataCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IERC721MetadataCallerRaw struct {
+	Contract *IERC721MetadataCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IERC721MetadataTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IERC721MetadataTransactorRaw struct {
+	Contract *IERC721MetadataTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIERC721Metadata creates a new instance of IERC721Metadata, bound to a specific deployed contract.
+func NewIERC721Metadata(address common.Address, backend bind.ContractBackend) (*IERC721Metadata, error) {
+	contract, err := bindIERC721Metadata(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721Metadata{IERC721MetadataCaller: IERC721MetadataCaller{contract: contract}, IERC721MetadataTransactor: IERC721MetadataTransactor{contract: contract}, IERC721MetadataFilterer: IERC721MetadataFilterer{contract: contract}}, nil
+}
+
+// NewIERC721MetadataCaller creates a new read-only instance of IERC721Metadata, bound to a specific deployed contract.
+func NewIERC721MetadataCaller(address common.Address, caller bind.ContractCaller) (*IERC721MetadataCaller, error) {
+	contract, err := bindIERC721Metadata(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721MetadataCaller{contract: contract}, nil
+}
+
+// NewIERC721MetadataTransactor creates a new write-only instance of IERC721Metadata, bound to a specific deployed contract.
+func NewIERC721MetadataTransactor(address common.Address, transactor bind.ContractTransactor) (*IERC721MetadataTransactor, error) {
+	contract, err := bindIERC721Metadata(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721MetadataTransactor{contract: contract}, nil
+}
+
+// NewIERC721MetadataFilterer creates a new log filterer instance of IERC721Metadata, bound to a specific deployed contract.
+func NewIERC721MetadataFilterer(address common.Address, filterer bind.ContractFilterer) (*IERC721MetadataFilterer, error) {
+	contract, err := bindIERC721Metadata(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721MetadataFilterer{contract: contract}, nil
+}
+
+// bindIERC721Metadata binds a generic wrapper to an already deployed contract.
+func bindIERC721Metadata(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(IERC721MetadataABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IERC721Metadata *IERC721MetadataRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IERC721Metadata.Contract.IERC721MetadataCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IERC721Metadata *IERC721MetadataRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.IERC721MetadataTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IERC721Metadata *IERC721MetadataRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.IERC721MetadataTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IERC721Metadata *IERC721MetadataCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IERC721Metadata.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IERC721Metadata *IERC721MetadataTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IERC721Metadata *IERC721MetadataTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256 balance)
+func (_IERC721Metadata *IERC721MetadataCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IERC721Metadata.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256 balance)
+func (_IERC721Metadata *IERC721MetadataSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _IERC721Metadata.Contract.BalanceOf(&_IERC721Metadata.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256 balance)
+func (_IERC721Metadata *IERC721MetadataCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _IERC721Metadata.Contract.BalanceOf(&_IERC721Metadata.CallOpts, owner)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address operator)
+func (_IERC721Metadata *IERC721MetadataCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _IERC721Metadata.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address operator)
+func (_IERC721Metadata *IERC721MetadataSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _IERC721Metadata.Contract.GetApproved(&_IERC721Metadata.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address operator)
+func (_IERC721Metadata *IERC721MetadataCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _IERC721Metadata.Contract.GetApproved(&_IERC721Metadata.CallOpts, tokenId)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_IERC721Metadata *IERC721MetadataCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _IERC721Metadata.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_IERC721Metadata *IERC721MetadataSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _IERC721Metadata.Contract.IsApprovedForAll(&_IERC721Metadata.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_IERC721Metadata *IERC721MetadataCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _IERC721Metadata.Contract.IsApprovedForAll(&_IERC721Metadata.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_IERC721Metadata *IERC721MetadataCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _IERC721Metadata.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_IERC721Metadata *IERC721MetadataSession) Name() (string, error) {
+	return _IERC721Metadata.Contract.Name(&_IERC721Metadata.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_IERC721Metadata *IERC721MetadataCallerSession) Name() (string, error) {
+	return _IERC721Metadata.Contract.Name(&_IERC721Metadata.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address owner)
+func (_IERC721Metadata *IERC721MetadataCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _IERC721Metadata.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address owner)
+func (_IERC721Metadata *IERC721MetadataSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _IERC721Metadata.Contract.OwnerOf(&_IERC721Metadata.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address owner)
+func (_IERC721Metadata *IERC721MetadataCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _IERC721Metadata.Contract.OwnerOf(&_IERC721Metadata.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_IERC721Metadata *IERC721MetadataCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _IERC721Metadata.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_IERC721Metadata *IERC721MetadataSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _IERC721Metadata.Contract.SupportsInterface(&_IERC721Metadata.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_IERC721Metadata *IERC721MetadataCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _IERC721Metadata.Contract.SupportsInterface(&_IERC721Metadata.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_IERC721Metadata *IERC721MetadataCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _IERC721Metadata.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_IERC721Metadata *IERC721MetadataSession) Symbol() (string, error) {
+	return _IERC721Metadata.Contract.Symbol(&_IERC721Metadata.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_IERC721Metadata *IERC721MetadataCallerSession) Symbol() (string, error) {
+	return _IERC721Metadata.Contract.Symbol(&_IERC721Metadata.CallOpts)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_IERC721Metadata *IERC721MetadataCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _IERC721Metadata.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_IERC721Metadata *IERC721MetadataSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _IERC721Metadata.Contract.TokenURI(&_IERC721Metadata.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_IERC721Metadata *IERC721MetadataCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _IERC721Metadata.Contract.TokenURI(&_IERC721Metadata.CallOpts, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_IERC721Metadata *IERC721MetadataTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721Metadata.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_IERC721Metadata *IERC721MetadataSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.Approve(&_IERC721Metadata.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_IERC721Metadata *IERC721MetadataTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.Approve(&_IERC721Metadata.TransactOpts, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_IERC721Metadata *IERC721MetadataTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721Metadata.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_IERC721Metadata *IERC721MetadataSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.SafeTransferFrom(&_IERC721Metadata.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_IERC721Metadata *IERC721MetadataTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.SafeTransferFrom(&_IERC721Metadata.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_IERC721Metadata *IERC721MetadataTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _IERC721Metadata.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_IERC721Metadata *IERC721MetadataSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.SafeTransferFrom0(&_IERC721Metadata.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_IERC721Metadata *IERC721MetadataTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.SafeTransferFrom0(&_IERC721Metadata.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool _approved) returns()
+func (_IERC721Metadata *IERC721MetadataTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, _approved bool) (*types.Transaction, error) {
+	return _IERC721Metadata.contract.Transact(opts, "setApprovalForAll", operator, _approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool _approved) returns()
+func (_IERC721Metadata *IERC721MetadataSession) SetApprovalForAll(operator common.Address, _approved bool) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.SetApprovalForAll(&_IERC721Metadata.TransactOpts, operator, _approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool _approved) returns()
+func (_IERC721Metadata *IERC721MetadataTransactorSession) SetApprovalForAll(operator common.Address, _approved bool) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.SetApprovalForAll(&_IERC721Metadata.TransactOpts, operator, _approved)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_IERC721Metadata *IERC721MetadataTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721Metadata.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_IERC721Metadata *IERC721MetadataSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.TransferFrom(&_IERC721Metadata.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_IERC721Metadata *IERC721MetadataTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IERC721Metadata.Contract.TransferFrom(&_IERC721Metadata.TransactOpts, from, to, tokenId)
+}
+
+// IERC721MetadataApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the IERC721Metadata contract.
+type IERC721MetadataApprovalIterator struct {
+	Event *IERC721MetadataApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IERC721MetadataApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IERC721MetadataApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IERC721MetadataApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IERC721MetadataApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IERC721MetadataApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IERC721MetadataApproval represents a Approval event raised by the IERC721Metadata contract.
+type IERC721MetadataApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_IERC721Metadata *IERC721MetadataFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*IERC721MetadataApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _IERC721Metadata.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721MetadataApprovalIterator{contract: _IERC721Metadata.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_IERC721Metadata *IERC721MetadataFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *IERC721MetadataApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _IERC721Metadata.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IERC721MetadataApproval)
+				if err := _IERC721Metadata.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_IERC721Metadata *IERC721MetadataFilterer) ParseApproval(log types.Log) (*IERC721MetadataApproval, error) {
+	event := new(IERC721MetadataApproval)
+	if err := _IERC721Metadata.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IERC721MetadataApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the IERC721Metadata contract.
+type IERC721MetadataApprovalForAllIterator struct {
+	Event *IERC721MetadataApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IERC721MetadataApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IERC721MetadataApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IERC721MetadataApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IERC721MetadataApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IERC721MetadataApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IERC721MetadataApprovalForAll represents a ApprovalForAll event raised by the IERC721Metadata contract.
+type IERC721MetadataApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_IERC721Metadata *IERC721MetadataFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*IERC721MetadataApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IERC721Metadata.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721MetadataApprovalForAllIterator{contract: _IERC721Metadata.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_IERC721Metadata *IERC721MetadataFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *IERC721MetadataApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IERC721Metadata.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IERC721MetadataApprovalForAll)
+				if err := _IERC721Metadata.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_IERC721Metadata *IERC721MetadataFilterer) ParseApprovalForAll(log types.Log) (*IERC721MetadataApprovalForAll, error) {
+	event := new(IERC721MetadataApprovalForAll)
+	if err := _IERC721Metadata.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IERC721MetadataTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the IERC721Metadata contract.
+type IERC721MetadataTransferIterator struct {
+	Event *IERC721MetadataTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IERC721MetadataTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IERC721MetadataTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IERC721MetadataTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IERC721MetadataTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IERC721MetadataTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IERC721MetadataTransfer represents a Transfer event raised by the IERC721Metadata contract.
+type IERC721MetadataTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_IERC721Metadata *IERC721MetadataFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*IERC721MetadataTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _IERC721Metadata.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721MetadataTransferIterator{contract: _IERC721Metadata.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_IERC721Metadata *IERC721MetadataFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *IERC721MetadataTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _IERC721Metadata.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IERC721MetadataTransfer)
+				if err := _IERC721Metadata.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_IERC721Metadata *IERC721MetadataFilterer) ParseTransfer(log types.Log) (*IERC721MetadataTransfer, error) {
+	event := new(IERC721MetadataTransfer)
+	if err := _IERC721Metadata.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IERC721ReceiverABI is the input ABI used to generate the binding from.
+const IERC721ReceiverABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"onERC721Received\",\"outputs\":[{\"internalType\":\"bytes4\",\"name\":\"\",\"type\":\"bytes4\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// IERC721ReceiverFuncSigs maps the 4-byte function signature to its string representation.
+var IERC721ReceiverFuncSigs = map[string]string{
+	"150b7a02": "onERC721Received(address,address,uint256,bytes)",
+}
+
+// IERC721Receiver is an auto generated Go binding around an Ethereum contract.
+type IERC721Receiver struct {
+	IERC721ReceiverCaller     // Read-only binding to the contract
+	IERC721ReceiverTransactor // Write-only binding to the contract
+	IERC721ReceiverFilterer   // Log filterer for contract events
+}
+
+// IERC721ReceiverCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IERC721ReceiverCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IERC721ReceiverTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IERC721ReceiverTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IERC721ReceiverFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IERC721ReceiverFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IERC721ReceiverSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IERC721ReceiverSession struct {
+	Contract     *IERC721Receiver  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IERC721ReceiverCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IERC721ReceiverCallerSession struct {
+	Contract *IERC721ReceiverCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// IERC721ReceiverTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IERC721ReceiverTransactorSession struct {
+	Contract     *IERC721ReceiverTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// IERC721ReceiverRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IERC721ReceiverRaw struct {
+	Contract *IERC721Receiver // Generic contract binding to access the raw methods on
+}
+
+// IERC721ReceiverCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IERC721ReceiverCallerRaw struct {
+	Contract *IERC721ReceiverCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IERC721ReceiverTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IERC721ReceiverTransactorRaw struct {
+	Contract *IERC721ReceiverTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIERC721Receiver creates a new instance of IERC721Receiver, bound to a specific deployed contract.
+func NewIERC721Receiver(address common.Address, backend bind.ContractBackend) (*IERC721Receiver, error) {
+	contract, err := bindIERC721Receiver(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721Receiver{IERC721ReceiverCaller: IERC721ReceiverCaller{contract: contract}, IERC721ReceiverTransactor: IERC721ReceiverTransactor{contract: contract}, IERC721ReceiverFilterer: IERC721ReceiverFilterer{contract: contract}}, nil
+}
+
+// NewIERC721ReceiverCaller creates a new read-only instance of IERC721Receiver, bound to a specific deployed contract.
+func NewIERC721ReceiverCaller(address common.Address, caller bind.ContractCaller) (*IERC721ReceiverCaller, error) {
+	contract, err := bindIERC721Receiver(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721ReceiverCaller{contract: contract}, nil
+}
+
+// NewIERC721ReceiverTransactor creates a new write-only instance of IERC721Receiver, bound to a specific deployed contract.
+func NewIERC721ReceiverTransactor(address common.Address, transactor bind.ContractTransactor) (*IERC721ReceiverTransactor, error) {
+	contract, err := bindIERC721Receiver(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721ReceiverTransactor{contract: contract}, nil
+}
+
+// NewIERC721ReceiverFilterer creates a new log filterer instance of IERC721Receiver, bound to a specific deployed contract.
+func NewIERC721ReceiverFilterer(address common.Address, filterer bind.ContractFilterer) (*IERC721ReceiverFilterer, error) {
+	contract, err := bindIERC721Receiver(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IERC721ReceiverFilterer{contract: contract}, nil
+}
+
+// bindIERC721Receiver binds a generic wrapper to an already deployed contract.
+func bindIERC721Receiver(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(IERC721ReceiverABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IERC721Receiver *IERC721ReceiverRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IERC721Receiver.Contract.IERC721ReceiverCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IERC721Receiver *IERC721ReceiverRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IERC721Receiver.Contract.IERC721ReceiverTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IERC721Receiver *IERC721ReceiverRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IERC721Receiver.Contract.IERC721ReceiverTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IERC721Receiver *IERC721ReceiverCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IERC721Receiver.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IERC721Receiver *IERC721ReceiverTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IERC721Receiver.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IERC721Receiver *IERC721ReceiverTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IERC721Receiver.Contract.contract.Transact(opts, method, params...)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address operator, address from, uint256 tokenId, bytes data) returns(bytes4)
+func (_IERC721Receiver *IERC721ReceiverTransactor) OnERC721Received(opts *bind.TransactOpts, operator common.Address, from common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _IERC721Receiver.contract.Transact(opts, "onERC721Received", operator, from, tokenId, data)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address operator, address from, uint256 tokenId, bytes data) returns(bytes4)
+func (_IERC721Receiver *IERC721ReceiverSession) OnERC721Received(operator common.Address, from common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _IERC721Receiver.Contract.OnERC721Received(&_IERC721Receiver.TransactOpts, operator, from, tokenId, data)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address operator, address from, uint256 tokenId, bytes data) returns(bytes4)
+func (_IERC721Receiver *IERC721ReceiverTransactorSession) OnERC721Received(operator common.Address, from common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _IERC721Receiver.Contract.OnERC721Received(&_IERC721Receiver.TransactOpts, operator, from, tokenId, data)
+}
+
+// OwnableABI is the input ABI used to generate the binding from.
+const OwnableABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// OwnableFuncSigs maps the 4-byte function signature to its string representation.
+var OwnableFuncSigs = map[string]string{
+	"8da5cb5b": "owner()",
+	"715018a6": "renounceOwnership()",
+	"f2fde38b": "transferOwnership(address)",
+}
+
+// Ownable is an auto generated Go binding around an Ethereum contract.
+type Ownable struct {
+	OwnableCaller     // Read-only binding to the contract
+	OwnableTransactor // Write-only binding to the contract
+	OwnableFilterer   // Log filterer for contract events
+}
+
+// OwnableCaller is an auto generated read-only Go binding around an Ethereum contract.
+type OwnableCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OwnableTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type OwnableTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OwnableFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type OwnableFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OwnableSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type OwnableSession struct {
+	Contract     *Ownable          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// OwnableCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type OwnableCallerSession struct {
+	Contract *OwnableCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// OwnableTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type OwnableTransactorSession struct {
+	Contract     *OwnableTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// OwnableRaw is an auto generated low-level Go binding around an Ethereum contract.
+type OwnableRaw struct {
+	Contract *Ownable // Generic contract binding to access the raw methods on
+}
+
+// OwnableCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type OwnableCallerRaw struct {
+	Contract *OwnableCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// OwnableTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type OwnableTransactorRaw struct {
+	Contract *OwnableTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewOwnable creates a new instance of Ownable, bound to a specific deployed contract.
+func NewOwnable(address common.Address, backend bind.ContractBackend) (*Ownable, error) {
+	contract, err := bindOwnable(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Ownable{OwnableCaller: OwnableCaller{contract: contract}, OwnableTransactor: OwnableTransactor{contract: contract}, OwnableFilterer: OwnableFilterer{contract: contract}}, nil
+}
+
+// NewOwnableCaller creates a new read-only instance of Ownable, bound to a specific deployed contract.
+func NewOwnableCaller(address common.Address, caller bind.ContractCaller) (*OwnableCaller, error) {
+	contract, err := bindOwnable(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OwnableCaller{contract: contract}, nil
+}
+
+// NewOwnableTransactor creates a new write-only instance of Ownable, bound to a specific deployed contract.
+func NewOwnableTransactor(address common.Address, transactor bind.ContractTransactor) (*OwnableTransactor, error) {
+	contract, err := bindOwnable(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OwnableTransactor{contract: contract}, nil
+}
+
+// NewOwnableFilterer creates a new log filterer instance of Ownable, bound to a specific deployed contract.
+func NewOwnableFilterer(address common.Address, filterer bind.ContractFilterer) (*OwnableFilterer, error) {
+	contract, err := bindOwnable(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &OwnableFilterer{contract: contract}, nil
+}
+
+// bindOwnable binds a generic wrapper to an already deployed contract.
+func bindOwnable(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(OwnableABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Ownable *OwnableRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Ownable.Contract.OwnableCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Ownable *OwnableRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Ownable.Contract.OwnableTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Ownable *OwnableRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Ownable.Contract.OwnableTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Ownable *OwnableCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Ownable.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Ownable *OwnableTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Ownable.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Ownable *OwnableTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Ownable.Contract.contract.Transact(opts, method, params...)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Ownable *OwnableCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Ownable.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Ownable *OwnableSession) Owner() (common.Address, error) {
+	return _Ownable.Contract.Owner(&_Ownable.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Ownable *OwnableCallerSession) Owner() (common.Address, error) {
+	return _Ownable.Contract.Owner(&_Ownable.CallOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Ownable *OwnableTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Ownable.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Ownable *OwnableSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Ownable.Contract.RenounceOwnership(&_Ownable.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Ownable *OwnableTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Ownable.Contract.RenounceOwnership(&_Ownable.TransactOpts)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Ownable *OwnableTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Ownable.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Ownable *OwnableSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Ownable.Contract.TransferOwnership(&_Ownable.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Ownable *OwnableTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Ownable.Contract.TransferOwnership(&_Ownable.TransactOpts, newOwner)
+}
+
+// OwnableOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Ownable contract.
+type OwnableOwnershipTransferredIterator struct {
+	Event *OwnableOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OwnableOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OwnableOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OwnableOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OwnableOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OwnableOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OwnableOwnershipTransferred represents a OwnershipTransferred event raised by the Ownable contract.
+type OwnableOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Ownable *OwnableFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*OwnableOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Ownable.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OwnableOwnershipTransferredIterator{contract: _Ownable.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Ownable *OwnableFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *OwnableOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Ownable.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OwnableOwnershipTransferred)
+				if err := _Ownable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Ownable *OwnableFilterer) ParseOwnershipTransferred(log types.Log) (*OwnableOwnershipTransferred, error) {
+	event := new(OwnableOwnershipTransferred)
+	if err := _Ownable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SafeCastABI is the input ABI used to generate the binding from.
+const SafeCastABI = "[]"
+
+// SafeCastBin is the compiled bytecode used for deploying new contracts.
+var SafeCastBin = "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea2646970667358221220da935e8edc25f0f8c3215c180415e25b5d660ea582acf828054e470b396d90c264736f6c63430008150033"
+
+// DeploySafeCast deploys a new Ethereum contract, binding an instance of SafeCast to it.
+func DeploySafeCast(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *SafeCast, error) {
+	parsed, err := abi.JSON(strings.NewReader(SafeCastABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(SafeCastBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SafeCast{SafeCastCaller: SafeCastCaller{contract: contract}, SafeCastTransactor: SafeCastTransactor{contract: contract}, SafeCastFilterer: SafeCastFilterer{contract: contract}}, nil
+}
+
+// SafeCast is an auto generated Go binding around an Ethereum contract.
+type SafeCast struct {
+	SafeCastCaller     // Read-only binding to the contract
+	SafeCastTransactor // Write-only binding to the contract
+	SafeCastFilterer   // Log filterer for contract events
+}
+
+// SafeCastCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SafeCastCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SafeCastTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SafeCastTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SafeCastFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SafeCastFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SafeCastSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SafeCastSession struct {
+	Contract     *SafeCast         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// SafeCastCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SafeCastCallerSession struct {
+	Contract *SafeCastCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// SafeCastTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SafeCastTransactorSession struct {
+	Contract     *SafeCastTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// SafeCastRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SafeCastRaw struct {
+	Contract *SafeCast // Generic contract binding to access the raw methods on
+}
+
+// SafeCastCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SafeCastCallerRaw struct {
+	Contract *SafeCastCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SafeCastTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SafeCastTransactorRaw struct {
+	Contract *SafeCastTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSafeCast creates a new instance of SafeCast, bound to a specific deployed contract.
+func NewSafeCast(address common.Address, backend bind.ContractBackend) (*SafeCast, error) {
+	contract, err := bindSafeCast(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SafeCast{SafeCastCaller: SafeCastCaller{contract: contract}, SafeCastTransactor: SafeCastTransactor{contract: contract}, SafeCastFilterer: SafeCastFilterer{contract: contract}}, nil
+}
+
+// NewSafeCastCaller creates a new read-only instance of SafeCast, bound to a specific deployed contract.
+func NewSafeCastCaller(address common.Address, caller bind.ContractCaller) (*SafeCastCaller, error) {
+	contract, err := bindSafeCast(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SafeCastCaller{contract: contract}, nil
+}
+
+// NewSafeCastTransactor creates a new write-only instance of SafeCast, bound to a specific deployed contract.
+func NewSafeCastTransactor(address common.Address, transactor bind.ContractTransactor) (*SafeCastTransactor, error) {
+	contract, err := bindSafeCast(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SafeCastTransactor{contract: contract}, nil
+}
+
+// NewSafeCastFilterer creates a new log filterer instance of SafeCast, bound to a specific deployed contract.
+func NewSafeCastFilterer(address common.Address, filterer bind.ContractFilterer) (*SafeCastFilterer, error) {
+	contract, err := bindSafeCast(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SafeCastFilterer{contract: contract}, nil
+}
+
+// bindSafeCast binds a generic wrapper to an already deployed contract.
+func bindSafeCast(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(SafeCastABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SafeCast *SafeCastRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SafeCast.Contract.SafeCastCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SafeCast *SafeCastRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SafeCast.Contract.SafeCastTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SafeCast *SafeCastRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SafeCast.Contract.SafeCastTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SafeCast *SafeCastCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SafeCast.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SafeCast *SafeCastTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SafeCast.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SafeCast *SafeCastTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SafeCast.Contract.contract.Transact(opts, method, params...)
+}
+
+// SafeMathABI is the input ABI used to generate the binding from.
+const SafeMathABI = "[]"
+
+// SafeMathBin is the compiled bytecode used for deploying new contracts.
+var SafeMathBin = "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea2646970667358221220805808fc3a6829c8aac9bdf98407a6c6038ba92e10b7bcf4fe3aa70c815f268a64736f6c63430008150033"
+
+// DeploySafeMath deploys a new Ethereum contract, binding an instance of SafeMath to it.
+func DeploySafeMath(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *SafeMath, error) {
+	parsed, err := abi.JSON(strings.NewReader(SafeMathABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(SafeMathBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SafeMath{SafeMathCaller: SafeMathCaller{contract: contract}, SafeMathTransactor: SafeMathTransactor{contract: contract}, SafeMathFilterer: SafeMathFilterer{contract: contract}}, nil
+}
+
+// SafeMath is an auto generated Go binding around an Ethereum contract.
+type SafeMath struct {
+	SafeMathCaller     // Read-only binding to the contract
+	SafeMathTransactor // Write-only binding to the contract
+	SafeMathFilterer   // Log filterer for contract events
+}
+
+// SafeMathCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SafeMathCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SafeMathTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SafeMathTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SafeMathFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SafeMathFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SafeMathSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SafeMathSession struct {
+	Contract     *SafeMath         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// SafeMathCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SafeMathCallerSession struct {
+	Contract *SafeMathCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// SafeMathTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SafeMathTransactorSession struct {
+	Contract     *SafeMathTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// SafeMathRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SafeMathRaw struct {
+	Contract *SafeMath // Generic contract binding to access the raw methods on
+}
+
+// SafeMathCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SafeMathCallerRaw struct {
+	Contract *SafeMathCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SafeMathTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SafeMathTransactorRaw struct {
+	Contract *SafeMathTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSafeMath creates a new instance of SafeMath, bound to a specific deployed contract.
+func NewSafeMath(address common.Address, backend bind.ContractBackend) (*SafeMath, error) {
+	contract, err := bindSafeMath(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SafeMath{SafeMathCaller: SafeMathCaller{contract: contract}, SafeMathTransactor: SafeMathTransactor{contract: contract}, SafeMathFilterer: SafeMathFilterer{contract: contract}}, nil
+}
+
+// NewSafeMathCaller creates a new read-only instance of SafeMath, bound to a specific deployed contract.
+func NewSafeMathCaller(address common.Address, caller bind.ContractCaller) (*SafeMathCaller, error) {
+	contract, err := bindSafeMath(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SafeMathCaller{contract: contract}, nil
+}
+
+// NewSafeMathTransactor creates a new write-only instance of SafeMath, bound to a specific deployed contract.
+func NewSafeMathTransactor(address common.Address, transactor bind.ContractTransactor) (*SafeMathTransactor, error) {
+	contract, err := bindSafeMath(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SafeMathTransactor{contract: contract}, nil
+}
+
+// NewSafeMathFilterer creates a new log filterer instance of SafeMath, bound to a specific deployed contract.
+func NewSafeMathFilterer(address common.Address, filterer bind.ContractFilterer) (*SafeMathFilterer, error) {
+	contract, err := bindSafeMath(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SafeMathFilterer{contract: contract}, nil
+}
+
+// bindSafeMath binds a generic wrapper to an already deployed contract.
+func bindSafeMath(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(SafeMathABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SafeMath *SafeMathRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SafeMath.Contract.SafeMathCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SafeMath *SafeMathRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SafeMath.Contract.SafeMathTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SafeMath *SafeMathRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SafeMath.Contract.SafeMathTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SafeMath *SafeMathCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SafeMath.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SafeMath *SafeMathTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SafeMath.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SafeMath *SafeMathTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SafeMath.Contract.contract.Transact(opts, method, params...)
+}
+
+// StringsABI is the input ABI used to generate the binding from.
+const StringsABI = "[]"
+
+// StringsBin is the compiled bytecode used for deploying new contracts.
+var StringsBin = "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea2646970667358221220a94164369a413a5a7e23d8cd377da9bd79b5f10843b16054951d57096e42cb0164736f6c63430008150033"
+
+// DeployStrings deploys a new Ethereum contract, binding an instance of Strings to it.
+func DeployStrings(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Strings, error) {
+	parsed, err := abi.JSON(strings.NewReader(StringsABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(StringsBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Strings{StringsCaller: StringsCaller{contract: contract}, StringsTransactor: StringsTransactor{contract: contract}, StringsFilterer: StringsFilterer{contract: contract}}, nil
+}
+
+// Strings is an auto generated Go binding around an Ethereum contract.
+type Strings struct {
+	StringsCaller     // Read-only binding to the contract
+	StringsTransactor // Write-only binding to the contract
+	StringsFilterer   // Log filterer for contract events
+}
+
+// StringsCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StringsCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StringsTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StringsTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StringsFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StringsFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StringsSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StringsSession struct {
+	Contract     *Strings          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StringsCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StringsCallerSession struct {
+	Contract *StringsCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// StringsTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StringsTransactorSession struct {
+	Contract     *StringsTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// StringsRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StringsRaw struct {
+	Contract *Strings // Generic contract binding to access the raw methods on
+}
+
+// StringsCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StringsCallerRaw struct {
+	Contract *StringsCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StringsTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StringsTransactorRaw struct {
+	Contract *StringsTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStrings creates a new instance of Strings, bound to a specific deployed contract.
+func NewStrings(address common.Address, backend bind.ContractBackend) (*Strings, error) {
+	contract, err := bindStrings(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Strings{StringsCaller: StringsCaller{contract: contract}, StringsTransactor: StringsTransactor{contract: contract}, StringsFilterer: StringsFilterer{contract: contract}}, nil
+}
+
+// NewStringsCaller creates a new read-only instance of Strings, bound to a specific deployed contract.
+func NewStringsCaller(address common.Address, caller bind.ContractCaller) (*StringsCaller, error) {
+	contract, err := bindStrings(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StringsCaller{contract: contract}, nil
+}
+
+// NewStringsTransactor creates a new write-only instance of Strings, bound to a specific deployed contract.
+func NewStringsTransactor(address common.Address, transactor bind.ContractTransactor) (*StringsTransactor, error) {
+	contract, err := bindStrings(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StringsTransactor{contract: contract}, nil
+}
+
+// NewStringsFilterer creates a new log filterer instance of Strings, bound to a specific deployed contract.
+func NewStringsFilterer(address common.Address, filterer bind.ContractFilterer) (*StringsFilterer, error) {
+	contract, err := bindStrings(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StringsFilterer{contract: contract}, nil
+}
+
+// bindStrings binds a generic wrapper to an already deployed contract.
+func bindStrings(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(StringsABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Strings *StringsRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Strings.Contract.StringsCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Strings *StringsRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Strings.Contract.StringsTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Strings *StringsRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Strings.Contract.StringsTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Strings *StringsCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Strings.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Strings *StringsTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Strings.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Strings *StringsTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Strings.Contract.contract.Transact(opts, method, params...)
+}
+
+// USDXABI is the input ABI used to generate the binding from.
+const USDXABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_priceFeed\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"holder\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"usdx\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"eth\",\"type\":\"uint256\"}],\"name\":\"Claimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"usdx\",\"type\":\"uint256\"}],\"name\":\"Collected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"collector\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"}],\"name\":\"CollectorSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"guardian\",\"type\":\"address\"}],\"name\":\"GuardianSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"allowed\",\"type\":\"bool\"}],\"name\":\"LotSenderSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"mint\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"debt\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"surplus\",\"type\":\"uint256\"}],\"name\":\"LotSettled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"locked\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"mint\",\"type\":\"uint256\"}],\"name\":\"LotTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"supplyCap\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"mintLimit\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"mintWindow\",\"type\":\"uint256\"}],\"name\":\"MintLimitsSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"OrderCancelled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"executor\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"OrderExecuted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"enumUSDX.OrderKind\",\"name\":\"kind\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"int256\",\"name\":\"price\",\"type\":\"int256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"OrderPlaced\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"guardian\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"redeemer\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"lot\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"usdx\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"eth\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"surplus\",\"type\":\"uint256\"}],\"name\":\"Redeemed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"int256\",\"name\":\"price\",\"type\":\"int256\"}],\"name\":\"Shutdown\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"guardian\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MAX_LOTS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"}],\"name\":\"acceptsLots\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"accounts\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"locked\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"mint\",\"type\":\"uint256\"},{\"internalType\":\"uint40\",\"name\":\"firstLot\",\"type\":\"uint40\"},{\"internalType\":\"uint40\",\"name\":\"lastLot\",\"type\":\"uint40\"},{\"internalType\":\"uint40\",\"name\":\"lots\",\"type\":\"uint40\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"appreciation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"cancelOrder\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_usdx\",\"type\":\"uint256\"}],\"name\":\"claim\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_limit\",\"type\":\"uint256\"}],\"name\":\"collectAppreciation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_owner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_limit\",\"type\":\"uint256\"}],\"name\":\"collectAppreciationFor\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_limit\",\"type\":\"uint256\"}],\"name\":\"collectLotAppreciation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"collectors\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"collector\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"subtractedValue\",\"type\":\"uint256\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"executeOrder\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"guardian\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastLotId\",\"outputs\":[{\"internalType\":\"uint40\",\"name\":\"\",\"type\":\"uint40\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastOrderId\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"lotAppreciation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lotCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_count\",\"type\":\"uint256\"}],\"name\":\"lotPage\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"internalType\":\"address[]\",\"name\":\"owners\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"locked\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256[]\",\"name\":\"mint\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"next\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"lotSenders\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"lots\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint16\",\"name\":\"bucket\",\"type\":\"uint16\"},{\"internalType\":\"uint40\",\"name\":\"prev\",\"type\":\"uint40\"},{\"internalType\":\"uint40\",\"name\":\"next\",\"type\":\"uint40\"},{\"internalType\":\"uint128\",\"name\":\"locked\",\"type\":\"uint128\"},{\"internalType\":\"uint128\",\"name\":\"mint\",\"type\":\"uint128\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_minUSDX\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_deadline\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_beneficiary\",\"type\":\"address\"}],\"name\":\"mintFor\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"mintHeadroom\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"mintLimit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_minUSDX\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_deadline\",\"type\":\"uint256\"}],\"name\":\"mintTo\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"mintWindow\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"orders\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"enumUSDX.OrderKind\",\"name\":\"kind\",\"type\":\"uint8\"},{\"internalType\":\"int256\",\"name\":\"price\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"enumUSDX.OrderKind\",\"name\":\"_kind\",\"type\":\"uint8\"},{\"internalType\":\"int256\",\"name\":\"_price\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"placeOrder\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"positions\",\"outputs\":[{\"internalType\":\"contractUSDXPositions\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"priceStalenessThreshold\",\"outputs\":[{\"internalType\":\"uint80\",\"name\":\"\",\"type\":\"uint80\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_usdx\",\"type\":\"uint256\"},{\"internalType\":\"uint256[]\",\"name\":\"_ids\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"_minEth\",\"type\":\"uint256\"}],\"name\":\"redeem\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_collector\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_threshold\",\"type\":\"uint256\"}],\"name\":\"setCollector\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_newFeed\",\"type\":\"address\"}],\"name\":\"setFeed\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_guardian\",\"type\":\"address\"}],\"name\":\"setGuardian\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"_allowed\",\"type\":\"bool\"}],\"name\":\"setLotSender\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_supplyCap\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_mintLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_mintWindow\",\"type\":\"uint256\"}],\"name\":\"setMintLimits\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint80\",\"name\":\"_newThreshold\",\"type\":\"uint80\"}],\"name\":\"setStalenessThreshold\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256[]\",\"name\":\"_ids\",\"type\":\"uint256[]\"}],\"name\":\"settleLots\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"settledSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"settlementPool\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"settlementPrice\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"_price\",\"type\":\"int256\"}],\"name\":\"shutdown\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"shutdownStale\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"supplyCap\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"supplyHeadroom\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalLocked\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"}],\"name\":\"transferAcct\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"transferLot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_usdx\",\"type\":\"uint256\"}],\"name\":\"unlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_usdx\",\"type\":\"uint256\"}],\"name\":\"unlockLot\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_usdx\",\"type\":\"uint256\"},{\"internalType\":\"enumUSDX.LotOrder\",\"name\":\"_order\",\"type\":\"uint8\"}],\"name\":\"unlockLots\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"usdPriceFeed\",\"outputs\":[{\"internalType\":\"contractAggregatorV3Interface\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"windowHeadroom\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdraw\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"withdrawable\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]"
+
+// USDXFuncSigs maps the 4-byte function signature to its string representation.
+var USDXFuncSigs = map[string]string{
+	"cec117ba": "MAX_LOTS()",
+	"17618ebe": "acceptsLots(address,address)",
+	"5e5c06e2": "accounts(address)",
+	"dd62ed3e": "allowance(address,address)",
+	"d3980103": "appreciation(address)",
+	"095ea7b3": "approve(address,uint256)",
+	"70a08231": "balanceOf(address)",
+	"514fcac7": "cancelOrder(uint256)",
+	"379607f5": "claim(uint256)",
+	"1a254f12": "collectAppreciation(uint256)",
+	"92cfd7de": "collectAppreciationFor(address,uint256)",
+	"b14ef502": "collectLotAppreciation(uint256,uint256)",
+	"9593b523": "collectors(address)",
+	"313ce567": "decimals()",
+	"a457c2d7": "decreaseAllowance(address,uint256)",
+	"94f61134": "executeOrder(uint256)",
+	"452a9320": "guardian()",
+	"39509351": "increaseAllowance(address,uint256)",
+	"6a52bd45": "lastLotId()",
+	"5662ecc7": "lastOrderId()",
+	"68db5b99": "lotAppreciation(uint256)",
+	"7ae169e9": "lotCount()",
+	"5a84a03a": "lotPage(uint256,uint256)",
+	"8407fb34": "lotSenders(address,address)",
+	"f1648e84": "lots(uint256)",
+	"1b2ef1ca": "mint(uint256,uint256)",
+	"71e578dc": "mintFor(address)",
+	"8b67c1f7": "mintHeadroom()",
+	"996517cf": "mintLimit()",
+	"2baf2acb": "mintTo(address,uint256,uint256)",
+	"9ce93edf": "mintWindow()",
+	"06fdde03": "name()",
+	"a85c38ef": "orders(uint256)",
+	"8da5cb5b": "owner()",
+	"8456cb59": "pause()",
+	"5c975abb": "paused()",
+	"a8216ad4": "placeOrder(uint8,int256,uint256)",
+	"ba5b7982": "positions()",
+	"bd111870": "priceStalenessThreshold()",
+	"8fca761f": "redeem(uint256,uint256[],uint256)",
+	"715018a6": "renounceOwnership()",
+	"7987d323": "setCollector(address,uint256)",
+	"55b775ea": "setFeed(address)",
+	"8a0dac4a": "setGuardian(address)",
+	"faa5732c": "setLotSender(address,bool)",
+	"3cb5bc74": "setMintLimits(uint256,uint256,uint256)",
+	"0f3a72ce": "setStalenessThreshold(uint80)",
+	"9b7df097": "settleLots(uint256[])",
+	"2969d0ed": "settledSupply()",
+	"aac0297a": "settlementPool()",
+	"f348e8b2": "settlementPrice()",
+	"746b67ae": "shutdown(int256)",
+	"fc8241bf": "shutdownStale()",
+	"8f770ad0": "supplyCap()",
+	"7588ea13": "supplyHeadroom()",
+	"95d89b41": "symbol()",
+	"56891412": "totalLocked()",
+	"18160ddd": "totalSupply()",
+	"a9059cbb": "transfer(address,uint256)",
+	"ac660479": "transferAcct(address)",
+	"23b872dd": "transferFrom(address,address,uint256)",
+	"733c1be6": "transferLot(address,address,uint256)",
+	"f2fde38b": "transferOwnership(address)",
+	"6198e339": "unlock(uint256)",
+	"8346864b": "unlockLot(uint256,uint256)",
+	"65c8bb27": "unlockLots(uint256,uint8)",
+	"3f4ba83a": "unpause()",
+	"de4874b0": "usdPriceFeed()",
+	"5a828703": "windowHeadroom()",
+	"3ccfd60b": "withdraw()",
+	"ce513b6f": "withdrawable(address)",
+}
+
+// USDXBin is the compiled bytecode used for deploying new contracts.
+var USDXBin = "0x60a060405260068054600160a01b600160f01b03191690553480156200002457600080fd5b50604051620075b6380380620075b683398101604081905262000047916200026a565b6040518060400160405280600f81526020016e2aa9a22c1029ba30b13632b1b7b4b760891b815250604051806040016040528060048152602001630aaa688b60e31b81525081600390816200009d919062000341565b506004620000ac828262000341565b5050506000620000c16200015960201b60201c565b600580546001600160a01b0319166001600160a01b038316908117909155604051919250906000907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0908290a3506200011a816200015d565b60405162000128906200025c565b604051809103906000f08015801562000145573d6000803e3d6000fd5b506001600160a01b03166080525062000432565b3390565b6005546001600160a01b03163314620001bc5760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604482015260640160405180910390fd5b6000819050600860ff16816001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa15801562000205573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200022b91906200040d565b60ff16146200023957600080fd5b600680546001600160a01b0319166001600160a01b039290921691909117905550565b6118b58062005d0183390190565b6000602082840312156200027d57600080fd5b81516001600160a01b03811681146200029557600080fd5b9392505050565b634e487b7160e01b600052604160045260246000fd5b600181811c90821680620002c757607f821691505b602082108103620002e857634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200033c57600081815260208120601f850160051c81016020861015620003175750805b601f850160051c820191505b81811015620003385782815560010162000323565b5050505b505050565b81516001600160401b038111156200035d576200035d6200029c565b62000375816200036e8454620002b2565b84620002ee565b602080601f831160018114620003ad5760008415620003945750858301515b600019600386901b1c1916600185901b17855562000338565b600085815260208120601f198616915b82811015620003de57888601518255948401946001909101908401620003bd565b5085821015620003fd5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6000602082840312156200042057600080fd5b815160ff811681146200029557600080fd5b6080516158976200046a60003960008181610c3c0152818161125e01528181611f150152818161304d0152614c4f01526158976000f3fe6080604052600436106104145760003560e01c80637987d3231161021e578063a8216ad411610123578063cec117ba116100ab578063f1648e841161007a578063f1648e8414610d65578063f2fde38b14610e2e578063f348e8b214610e4e578063faa5732c14610e64578063fc8241bf14610e8457600080fd5b8063cec117ba14610cca578063d398010314610cdf578063dd62ed3e14610cff578063de4874b014610d4557600080fd5b8063ac660479116100f2578063ac66047914610bea578063b14ef50214610c0a578063ba5b798214610c2a578063bd11187014610c5e578063ce513b6f14610c9d57600080fd5b8063a8216ad414610b33578063a85c38ef14610b53578063a9059cbb14610bb4578063aac0297a14610bd457600080fd5b80638fca761f116101a657806395d89b411161017557806395d89b4114610ab2578063996517cf14610ac75780639b7df09714610add5780639ce93edf14610afd578063a457c2d714610b1357600080fd5b80638fca761f146109f357806392cfd7de14610a1357806394f6113414610a335780639593b52314610a5357600080fd5b80638456cb59116101ed5780638456cb59146109755780638a0dac4a1461098a5780638b67c1f7146109aa5780638da5cb5b146109bf5780638f770ad0146109dd57600080fd5b80637987d323146108e45780637ae169e9146109045780638346864b1461091a5780638407fb341461093a57600080fd5b8063514fcac71161032457806365c8bb27116102ac578063715018a61161027b578063715018a61461086757806371e578dc1461087c578063733c1be61461088f578063746b67ae146108af5780637588ea13146108cf57600080fd5b806365c8bb27146107bd57806368db5b99146107dd5780636a52bd45146107fd57806370a082311461083157600080fd5b80635a828703116102f35780635a828703146106a95780635a84a03a146106be5780635c975abb146106ef5780635e5c06e2146107105780636198e3391461079d57600080fd5b8063514fcac71461063d57806355b775ea1461065d5780635662ecc71461067d578063568914121461069357600080fd5b80632969d0ed116103a75780633950935111610376578063395093511461059b5780633cb5bc74146105bb5780633ccfd60b146105db5780633f4ba83a146105f0578063452a93201461060557600080fd5b80632969d0ed146105365780632baf2acb1461054c578063313ce5671461055f578063379607f51461057b57600080fd5b806318160ddd116103e357806318160ddd146104c45780631a254f12146104e35780631b2ef1ca1461050357806323b872dd1461051657600080fd5b806306fdde0314610429578063095ea7b3146104545780630f3a72ce1461048457806317618ebe146104a457600080fd5b366104245761042233610e99565b005b600080fd5b34801561043557600080fd5b5061043e6112d2565b60405161044b9190614fa0565b60405180910390f35b34801561046057600080fd5b5061047461046f366004615005565b611364565b604051901515815260200161044b565b34801561049057600080fd5b5061042261049f366004615047565b61137b565b3480156104b057600080fd5b506104746104bf366004615064565b6113d4565b3480156104d057600080fd5b506002545b60405190815260200161044b565b3480156104ef57600080fd5b506104d56104fe366004615097565b611450565b6104d56105113660046150b0565b61145c565b34801561052257600080fd5b506104746105313660046150d2565b611469565b34801561054257600080fd5b506104d560155481565b6104d561055a36600461510e565b61151a565b34801561056b57600080fd5b506040516012815260200161044b565b34801561058757600080fd5b506104d5610596366004615097565b6115c0565b3480156105a757600080fd5b506104746105b6366004615005565b611752565b3480156105c757600080fd5b506104226105d6366004615141565b611789565b3480156105e757600080fd5b506104d5611817565b3480156105fc57600080fd5b50610422611909565b34801561061157600080fd5b50601654610625906001600160a01b031681565b6040516001600160a01b03909116815260200161044b565b34801561064957600080fd5b50610422610658366004615097565b6119d2565b34801561066957600080fd5b5061042261067836600461516d565b611a7d565b34801561068957600080fd5b506104d5600f5481565b34801561069f57600080fd5b506104d560115481565b3480156106b557600080fd5b506104d5611b43565b3480156106ca57600080fd5b506106de6106d93660046150b0565b611b83565b60405161044b9594939291906151c3565b3480156106fb57600080fd5b5060165461047490600160a01b900460ff1681565b34801561071c57600080fd5b5061076961072b36600461516d565b60076020526000908152604090208054600182015460029092015490919064ffffffffff80821691600160281b8104821691600160501b9091041685565b60408051958652602086019490945264ffffffffff928316938501939093528116606084015216608082015260a00161044b565b3480156107a957600080fd5b506104d56107b8366004615097565b611e53565b3480156107c957600080fd5b506104d56107d8366004615260565b611e5c565b3480156107e957600080fd5b506104d56107f8366004615097565b611e69565b34801561080957600080fd5b50600b5461081b9064ffffffffff1681565b60405164ffffffffff909116815260200161044b565b34801561083d57600080fd5b506104d561084c36600461516d565b6001600160a01b031660009081526020819052604090205490565b34801561087357600080fd5b50610422611e88565b6104d561088a36600461516d565b611efc565b34801561089b57600080fd5b506104226108aa3660046150d2565b611f0a565b3480156108bb57600080fd5b506104226108ca366004615097565b611fde565b3480156108db57600080fd5b506104d5612083565b3480156108f057600080fd5b506104226108ff366004615005565b6120ac565b34801561091057600080fd5b506104d560145481565b34801561092657600080fd5b506104d56109353660046150b0565b612179565b34801561094657600080fd5b50610474610955366004615064565b600860209081526000928352604080842090915290825290205460ff1681565b34801561098157600080fd5b506104226122a0565b34801561099657600080fd5b506104226109a536600461516d565b612353565b3480156109b657600080fd5b506104d56123c7565b3480156109cb57600080fd5b506005546001600160a01b0316610625565b3480156109e957600080fd5b506104d560175481565b3480156109ff57600080fd5b506104d5610a0e3660046152d5565b6123e1565b348015610a1f57600080fd5b506104d5610a2e366004615005565b612770565b348015610a3f57600080fd5b506104d5610a4e366004615097565b612837565b348015610a5f57600080fd5b50610a93610a6e36600461516d565b601060205260009081526040902080546001909101546001600160a01b039091169082565b604080516001600160a01b03909316835260208301919091520161044b565b348015610abe57600080fd5b5061043e612aaa565b348015610ad357600080fd5b506104d560185481565b348015610ae957600080fd5b50610422610af8366004615328565b612ab9565b348015610b0957600080fd5b506104d560195481565b348015610b1f57600080fd5b50610474610b2e366004615005565b612d89565b348015610b3f57600080fd5b506104d5610b4e36600461535e565b612e24565b348015610b5f57600080fd5b50610ba4610b6e366004615097565b600e602052600090815260409020805460018201546002909201546001600160a01b03821692600160a01b90920460ff16919084565b60405161044b94939291906153b6565b348015610bc057600080fd5b50610474610bcf366004615005565b612f4b565b348015610be057600080fd5b506104d560135481565b348015610bf657600080fd5b50610422610c0536600461516d565b612f58565b348015610c1657600080fd5b506104d5610c253660046150b0565b6130b6565b348015610c3657600080fd5b506106257f000000000000000000000000000000000000000000000000000000000000000081565b348015610c6a57600080fd5b50600654610c8590600160a01b90046001600160501b031681565b6040516001600160501b03909116815260200161044b565b348015610ca957600080fd5b506104d5610cb836600461516d565b60096020526000908152604090205481565b348015610cd657600080fd5b506104d5606481565b348015610ceb57600080fd5b506104d5610cfa36600461516d565b61325a565b348015610d0b57600080fd5b506104d5610d1a366004615064565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205490565b348015610d5157600080fd5b50600654610625906001600160a01b031681565b348015610d7157600080fd5b50610ddd610d80366004615097565b600a60205260009081526040902080546001909101546001600160a01b0382169161ffff600160a01b8204169164ffffffffff600160b01b8304811692600160d81b900416906001600160801b0380821691600160801b90041686565b604080516001600160a01b03909716875261ffff909516602087015264ffffffffff93841694860194909452911660608401526001600160801b0390811660808401521660a082015260c00161044b565b348015610e3a57600080fd5b50610422610e4936600461516d565b6132e3565b348015610e5a57600080fd5b506104d560125481565b348015610e7057600080fd5b50610422610e7f3660046153e5565b6133ce565b348015610e9057600080fd5b50610422613433565b6000601254600014610ec65760405162461bcd60e51b8152600401610ebd90615416565b60405180910390fd5b601654600160a01b900460ff1615610ef05760405162461bcd60e51b8152600401610ebd90615439565b610efa82336113d4565b610f165760405162461bcd60e51b8152600401610ebd90615459565b6000610f206135c5565b90506000610f2e34836136c6565b905034600003610f42575060009392505050565b6001600160a01b0384166000908152600760205260408120805490913491839190610f6e90849061549f565b9250508190555081816001016000828254610f89919061549f565b925050819055503460116000828254610fa2919061549f565b90915550506002810154600160281b900464ffffffffff1680158015906110075750600282015464ffffffffff600160501b90910416606414806110075750610feb34846136ea565b6000828152600a6020526040902054600160a01b900461ffff16145b156110c4576000818152600a60205260409020611023346137b4565b6001820180546000906110409084906001600160801b03166154b2565b92506101000a8154816001600160801b0302191690836001600160801b0316021790555061106d846137b4565b600182018054601090611091908490600160801b90046001600160801b03166154b2565b92506101000a8154816001600160801b0302191690836001600160801b031602179055506110be8261381d565b506112bc565b600b80546000919082906110de9064ffffffffff166154d2565b825464ffffffffff9182166101009390930a838102920219161790915560148054919250600061110d836154f6565b91905055506040518060c00160405280886001600160a01b03168152602001600061ffff168152602001600064ffffffffff168152602001600064ffffffffff16815260200161115c346137b4565b6001600160801b03168152602001611173866137b4565b6001600160801b039081169091526000838152600a6020908152604091829020845181549286015193860151606087015164ffffffffff908116600160d81b026001600160d81b0391909216600160b01b02166001600160b01b0361ffff909616600160a01b026001600160b01b03199095166001600160a01b0390931692909217939093179390931692909217178155608083015160a0909301518216600160801b02929091169190911760019091015561122f8782613873565b611238816139f0565b6040516340c10f1960e01b81526001600160a01b038881166004830152602482018390527f000000000000000000000000000000000000000000000000000000000000000016906340c10f1990604401600060405180830381600087803b1580156112a257600080fd5b505af11580156112b6573d6000803e3d6000fd5b50505050505b6112c68684613a90565b5090925050505b919050565b6060600380546112e19061550f565b80601f016020809104026020016040519081016040528092919081815260200182805461130d9061550f565b801561135a5780601f1061132f5761010080835404028352916020019161135a565b820191906000526020600020905b81548152906001019060200180831161133d57829003601f168201915b5050505050905090565b6000611371338484613b74565b5060015b92915050565b6005546001600160a01b031633146113a55760405162461bcd60e51b8152600401610ebd90615543565b600680546001600160501b03909216600160a01b0269ffffffffffffffffffff60a01b19909216919091179055565b6000816001600160a01b0316836001600160a01b0316148061141b57506001600160a01b0380841660009081526008602090815260408083209386168352929052205460ff165b8061144957506001600160a01b038316600090815260086020908152604080832083805290915290205460ff165b9392505050565b60006113753383613c99565b600061144933848461151a565b6000611476848484613e93565b6001600160a01b0384166000908152600160209081526040808320338452909152902054828110156114fb5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b6064820152608401610ebd565b61150f853361150a8685615578565b613b74565b506001949350505050565b60008142111561155b5760405162461bcd60e51b815260206004820152600c60248201526b1b5a5b9d08195e1c1a5c995960a21b6044820152606401610ebd565b600061156685610e99565b9050838110156115b85760405162461bcd60e51b815260206004820152601860248201527f696e73756666696369656e742075736478206d696e74656400000000000000006044820152606401610ebd565b949350505050565b60006012546000036116045760405162461bcd60e51b815260206004820152600d60248201526c3737ba1039b43aba103237bbb760991b6044820152606401610ebd565b601454156116455760405162461bcd60e51b815260206004820152600e60248201526d1b1bdd1cc81d5b9cd95d1d1b195960921b6044820152606401610ebd565b33600090815260208190526040902054821561166a576116658382614076565b61166c565b805b92506000831161168e5760405162461bcd60e51b8152600401610ebd9061558b565b60006116af61169c60025490565b6013546116a9908761408c565b90614098565b905080601360008282546116c39190615578565b9250508190555083601560008282546116dc9190615578565b909155506116ec905033856140a4565b336000908152600960205260408120805483929061170b90849061549f565b9091555050604080518581526020810183905233917f987d620f307ff6b94d58743cb7a7509f24071586a77759b77c2d4e29f75a2f9a910160405180910390a29392505050565b3360008181526001602090815260408083206001600160a01b0387168452909152812054909161137191859061150a90869061549f565b6005546001600160a01b031633146117b35760405162461bcd60e51b8152600401610ebd90615543565b60195481146117c2576000601b555b60178390556018829055601981905560408051848152602081018490529081018290527fbe5ec496c1a4c7b25eeffe11e78841855103bedc6db9add24216bb919c12f51c9060600160405180910390a1505050565b336000908152600960205260408120548061186a5760405162461bcd60e51b81526020600482015260136024820152726e6f7468696e6720746f20776974686472617760681b6044820152606401610ebd565b336000818152600960205260408082208290555190919083908381818185875af1925050503d80600081146118bb576040519150601f19603f3d011682016040523d82523d6000602084013e6118c0565b606091505b50509050806119035760405162461bcd60e51b815260206004820152600f60248201526e1dda5d1a191c985dc819985a5b1959608a1b6044820152606401610ebd565b50919050565b6016546001600160a01b031633146119525760405162461bcd60e51b815260206004820152600c60248201526b3737ba1033bab0b93234b0b760a11b6044820152606401610ebd565b601654600160a01b900460ff166119985760405162461bcd60e51b815260206004820152600a6024820152691b9bdd081c185d5cd95960b21b6044820152606401610ebd565b6016805460ff60a01b1916905560405133907f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa90600090a2565b6000818152600e60205260409020546001600160a01b03163314611a2a5760405162461bcd60e51b815260206004820152600f60248201526e3737ba1037b93232b91037bbb732b960891b6044820152606401610ebd565b6000818152600e602052604080822080546001600160a81b0319168155600181018390556002018290555182917f61b9399f2f0f32ca39ce8d7be32caed5ec22fe07a6daba3a467ed479ec60658291a250565b6005546001600160a01b03163314611aa75760405162461bcd60e51b8152600401610ebd90615543565b6000819050600860ff16816001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa158015611aef573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611b1391906155b4565b60ff1614611b2057600080fd5b600680546001600160a01b0319166001600160a01b039290921691909117905550565b6000601854600003611b56575060001990565b6000611b606141ff565b601a5414611b6f576000611b73565b601b545b905060006115b860185483614227565b600b546060908190819081906000908190611ba69064ffffffffff16600161549f565b90508088108015611bbf5750611bbc8882615578565b87105b15611bd457611bce878961549f565b90508091505b6000885b82811015611c1d576000818152600a60205260409020546001600160a01b031615611c0b5781611c07816154f6565b9250505b80611c15816154f6565b915050611bd8565b508067ffffffffffffffff811115611c3757611c376155d7565b604051908082528060200260200182016040528015611c60578160200160208202803683370190505b5096508067ffffffffffffffff811115611c7c57611c7c6155d7565b604051908082528060200260200182016040528015611ca5578160200160208202803683370190505b5095508067ffffffffffffffff811115611cc157611cc16155d7565b604051908082528060200260200182016040528015611cea578160200160208202803683370190505b5094508067ffffffffffffffff811115611d0657611d066155d7565b604051908082528060200260200182016040528015611d2f578160200160208202803683370190505b50935060009050885b82811015611e46576000818152600a6020526040902080546001600160a01b031615611e335781898481518110611d7157611d716155ed565b6020908102919091010152805488516001600160a01b0390911690899085908110611d9e57611d9e6155ed565b6001600160a01b0390921660209283029190910190910152600181015487516001600160801b0390911690889085908110611ddb57611ddb6155ed565b6020026020010181815250508060010160109054906101000a90046001600160801b03166001600160801b0316868481518110611e1a57611e1a6155ed565b602090810291909101015282611e2f816154f6565b9350505b5080611e3e816154f6565b915050611d38565b5050509295509295909350565b60006113758260005b600061144933848461424d565b6000818152600a6020526040812061137590611e836135c5565b614443565b6005546001600160a01b03163314611eb25760405162461bcd60e51b8152600401610ebd90615543565b6005546040516000916001600160a01b0316907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0908390a3600580546001600160a01b0319169055565b60006113758260004261151a565b336001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001614611f825760405162461bcd60e51b815260206004820152601760248201527f63616c6c6572206973206e6f7420706f736974696f6e730000000000000000006044820152606401610ebd565b6000818152600a60205260409020546001600160a01b03848116911614611fa857600080fd5b611fb282846113d4565b611fce5760405162461bcd60e51b8152600401610ebd90615459565b611fd9838383614486565b505050565b6005546001600160a01b031633146120085760405162461bcd60e51b8152600401610ebd90615543565b601254156120285760405162461bcd60e51b8152600401610ebd90615416565b600081136120485760405162461bcd60e51b8152600401610ebd90615603565b60128190556040518181527f673fcc005ab668ae0e90521153ad2593ebe24b0ab294c92ef8fa229c8fdd146f9060200160405180910390a150565b6000601754600003612096575060001990565b60006114496017546120a760025490565b614227565b6001600160a01b0382166120e05733600090815260106020526040812080546001600160a01b031916815560010155612128565b6040805180820182526001600160a01b03848116825260208083018581523360009081526010909252939020915182546001600160a01b031916911617815590516001909101555b6001600160a01b038216337f2ea20a90b817ea4d8a1495cb52b4991c9824b4b1684ff9338245eb1dfb48164e82156121605783612163565b60005b6040519081526020015b60405180910390a35050565b600060125460001461219d5760405162461bcd60e51b8152600401610ebd90615416565b6000838152600a6020526040902080546001600160a01b031633146121f45760405162461bcd60e51b815260206004820152600d60248201526c3737ba103637ba1037bbb732b960991b6044820152606401610ebd565b82600003612218576001810154600160801b90046001600160801b0316925061223b565b6001810154612238908490600160801b90046001600160801b0316614076565b92505b3360009081526020819052604090205461225b908490614076565b614076565b92506000831161227d5760405162461bcd60e51b8152600401610ebd9061558b565b6000612289858561465b565b9150506122973385836147aa565b50919392505050565b6016546001600160a01b031633146122e95760405162461bcd60e51b815260206004820152600c60248201526b3737ba1033bab0b93234b0b760a11b6044820152606401610ebd565b601654600160a01b900460ff16156123135760405162461bcd60e51b8152600401610ebd90615439565b6016805460ff60a01b1916600160a01b17905560405133907f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a25890600090a2565b6005546001600160a01b0316331461237d5760405162461bcd60e51b8152600401610ebd90615543565b601680546001600160a01b0319166001600160a01b0383169081179091556040517fe6c09ffe4572dc9ceaa5ddde4ae41befa655d6fdfe8052077af0970f700e942e90600090a250565b60006123dc6123d4612083565b612256611b43565b905090565b60006012546000146124055760405162461bcd60e51b8152600401610ebd90615416565b6000851180156124245750336000908152602081905260409020548511155b6124705760405162461bcd60e51b815260206004820152601960248201527f696e73756666696369656e7420757364782062616c616e6365000000000000006044820152606401610ebd565b600061247a6135c5565b9050856000805b86811080156124905750600083115b15612693576000600a60008a8a858181106124ad576124ad6155ed565b6020908102929092013583525081019190915260400160002080549091506001600160a01b031661250e5760405162461bcd60e51b815260206004820152600b60248201526a1b9bc81cdd58da081b1bdd60aa1b6044820152606401610ebd565b8054600160a01b900461ffff161580159061253b575061252c614898565b8154600160a01b900461ffff16145b6125875760405162461bcd60e51b815260206004820181905260248201527f6c6f74206e6f74206e65787420696e20726564656d7074696f6e206f726465726044820152606401610ebd565b60018101546001600160801b03600160801b82048116916125a99116876136c6565b10156125f75760405162461bcd60e51b815260206004820152601760248201527f6c6f7420756e646572636f6c6c61746572616c697a65640000000000000000006044820152606401610ebd565b600181015460009061261a908690600160801b90046001600160801b0316614076565b9050600061264061262a886148f0565b6116a96126396008600a61570e565b859061408c565b905061264c8287615578565b9550612658818661549f565b945061267d8b8b8681811061266f5761266f6155ed565b905060200201358383614942565b505050808061268b906154f6565b915050612481565b5060006126a0838a615578565b9050600081116126e55760405162461bcd60e51b815260206004820152601060248201526f1b9bdd1a1a5b99c81c995919595b595960821b6044820152606401610ebd565b858210156127355760405162461bcd60e51b815260206004820152601960248201527f696e73756666696369656e74206574682072656465656d6564000000000000006044820152606401610ebd565b61273f33826140a4565b336000908152600960205260408120805484929061275e90849061549f565b90915550909998505050505050505050565b6001600160a01b03808316600090815260106020526040812080549192909116158015906127a7575080546001600160a01b031633145b6127e35760405162461bcd60e51b815260206004820152600d60248201526c3737ba1031b7b63632b1ba37b960991b6044820152606401610ebd565b60006127ef8585613c99565b905081600101548110156115b85760405162461bcd60e51b815260206004820152600f60248201526e18995b1bddc81d1a1c995cda1bdb19608a1b6044820152606401610ebd565b6000818152600e6020908152604080832081516080810190925280546001600160a01b03811683528493830190600160a01b900460ff16600181111561287f5761287f61537e565b60018111156128905761289061537e565b81526001820154602082015260029091015460409091015280519091506001600160a01b03166128f25760405162461bcd60e51b815260206004820152600d60248201526c37379039bab1b41037b93232b960991b6044820152606401610ebd565b60006128fc6135c5565b90506000826020015160018111156129165761291661537e565b0361296a5781604001518113156129655760405162461bcd60e51b81526020600482015260136024820152721bdc99195c881b9bdd081d1c9a59d9d95c9959606a1b6044820152606401610ebd565b6129b4565b81604001518112156129b45760405162461bcd60e51b81526020600482015260136024820152721bdc99195c881b9bdd081d1c9a59d9d95c9959606a1b6044820152606401610ebd565b6000848152600e6020526040812080546001600160a81b03191681556001810182905560020181905580836020015160018111156129f4576129f461537e565b03612a1457612a0d83600001518460600151600061424d565b9050612a6a565b612a2683600001518460600151613c99565b905060008111612a6a5760405162461bcd60e51b815260206004820152600f60248201526e37379030b8383932b1b4b0ba34b7b760891b6044820152606401610ebd565b604051818152339086907f9b32d7714729bdcd899b9c5460b1ec55a813e10e7a51271f146949e4e19f7b99906020015b60405180910390a3949350505050565b6060600480546112e19061550f565b601254600003612afb5760405162461bcd60e51b815260206004820152600d60248201526c3737ba1039b43aba103237bbb760991b6044820152606401610ebd565b60005b81811015611fd9576000838383818110612b1a57612b1a6155ed565b602090810292909201356000818152600a90935260409092208054929350916001600160a01b03169050612b7e5760405162461bcd60e51b815260206004820152600b60248201526a1b9bc81cdd58da081b1bdd60aa1b6044820152606401610ebd565b805460018201546012546001600160a01b03909216916001600160801b0380831692600160801b90041690600090612bef90612bb9906148f0565b6116a96001612bc96012546148f0565b612bd39190615578565b612be9612be26008600a61570e565b879061408c565b90614bf1565b9050612bfb8184614076565b90506000612c098285615578565b6001600160a01b038616600090815260076020526040812080549293509186918391612c36908490615578565b9250508190555083816001016000828254612c519190615578565b925050819055508460116000828254612c6a9190615578565b925050819055508260136000828254612c83919061549f565b925050819055508360156000828254612c9c919061549f565b90915550506001600160a01b03861660009081526009602052604081208054849290612cc990849061549f565b90915550612cd8905088614bfd565b600281015464ffffffffff16600003612d20576001600160a01b0386166000908152600760205260408120818155600181019190915560020180546001600160781b03191690555b60408051858152602081018590529081018390526001600160a01b0387169089907f645f3cf2aee11f097fd4c980846c331f18d2036d6efd7415aec3527f8b9fd3929060600160405180910390a350505050505050508080612d81906154f6565b915050612afe565b3360009081526001602090815260408083206001600160a01b038616845290915281205482811015612e0b5760405162461bcd60e51b815260206004820152602560248201527f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f77604482015264207a65726f60d81b6064820152608401610ebd565b612e1a338561150a8685615578565b5060019392505050565b6000808313612e455760405162461bcd60e51b8152600401610ebd90615603565b6000600f60008154612e56906154f6565b91905081905590506040518060800160405280336001600160a01b03168152602001866001811115612e8a57612e8a61537e565b8152602080820187905260409182018690526000848152600e825291909120825181546001600160a01b039091166001600160a01b031982168117835592840151919283916001600160a81b03191617600160a01b836001811115612ef157612ef161537e565b02179055506040820151816001015560608201518160020155905050336001600160a01b0316817f4a072b3550ddd3fc77f61b059b04f0ab0be382805b9454e720e6aceac4d5ccc4878787604051612a9a9392919061571d565b6000611371338484613e93565b336000908152600760205260408120549003612f7357600080fd5b336001600160a01b03821603612f8857600080fd5b6001600160a01b03811660009081526007602052604090206002015464ffffffffff161580612fbc5750612fbc81336113d4565b612fd85760405162461bcd60e51b8152600401610ebd90615459565b3360009081526007602052604090206002015464ffffffffff165b80156130b2576000818152600a6020526040902054600160d81b900464ffffffffff16613021338484614486565b60405163bb35783b60e01b81523360048201526001600160a01b038481166024830152604482018490527f0000000000000000000000000000000000000000000000000000000000000000169063bb35783b90606401600060405180830381600087803b15801561309157600080fd5b505af11580156130a5573d6000803e3d6000fd5b5050505080915050612ff3565b5050565b60006012546000146130da5760405162461bcd60e51b8152600401610ebd90615416565b601654600160a01b900460ff16156131045760405162461bcd60e51b8152600401610ebd90615439565b6000838152600a6020526040902080546001600160a01b0316331461315b5760405162461bcd60e51b815260206004820152600d60248201526c3737ba103637ba1037bbb732b960991b6044820152606401610ebd565b600061316982611e836135c5565b9050831561317e5761317b8482614076565b90505b8060000361319157600092505050611375565b61319a816137b4565b6001830180546010906131be908490600160801b90046001600160801b03166154b2565b92506101000a8154816001600160801b0302191690836001600160801b031602179055506131eb8561381d565b336000908152600760205260408120600101805483929061320d90849061549f565b9091555061321d90503382613a90565b60405181815233907f8c22f554c81b54107cd95e734e4ef45767214974a540f34ea4a4f8c3fc7b13c39060200160405180910390a2949350505050565b6000806132656135c5565b6001600160a01b0384166000908152600760205260408120600201549192509064ffffffffff165b80156132db576000818152600a602052604090206132ab9084614443565b6132b5908361549f565b6000918252600a602052604090912054909150600160d81b900464ffffffffff1661328d565b509392505050565b6005546001600160a01b0316331461330d5760405162461bcd60e51b8152600401610ebd90615543565b6001600160a01b0381166133725760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610ebd565b6005546040516001600160a01b038084169216907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3600580546001600160a01b0319166001600160a01b0392909216919091179055565b3360008181526008602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915590519081529192917ff68cdd116472abb1ae46c94dee2f2f5259f5b1a57c1ca29ad7704cbc498d5e15910161216d565b601254156134535760405162461bcd60e51b8152600401610ebd90615416565b600080600660009054906101000a90046001600160a01b03166001600160a01b031663feaf968c6040518163ffffffff1660e01b815260040160a060405180830381865afa1580156134a9573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906134cd919061573c565b50935050925050806000036135175760405162461bcd60e51b815260206004820152601060248201526f1a5b98dbdb5c1b195d19481c9bdd5b9960821b6044820152606401610ebd565b6135246203f4808261549f565b42116135695760405162461bcd60e51b815260206004820152601460248201527370726963652066656564206e6f74207374616c6560601b6044820152606401610ebd565b600082136135895760405162461bcd60e51b8152600401610ebd90615603565b60128290556040518281527f673fcc005ab668ae0e90521153ad2593ebe24b0ab294c92ef8fa229c8fdd146f9060200160405180910390a15050565b600080600080600660009054906101000a90046001600160a01b03166001600160a01b031663feaf968c6040518163ffffffff1660e01b815260040160a060405180830381865afa15801561361e573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190613642919061573c565b9450505092509250600660149054906101000a90046001600160501b03166001600160501b031681846136759190615794565b6001600160501b031611156136bf5760405162461bcd60e51b815260206004820152601060248201526f1cdd185b19481c1c9a58d9481999595960821b6044820152606401610ebd565b5092915050565b60006114496136d76008600a61570e565b6116a96136e3856148f0565b869061408c565b6000816000036136fc57506000611375565b8260000361370d5750612000611375565b6000613722846116a96136e36008600a61570e565b905080600003613736576001915050611375565b600061374182614cb6565b9050608081106137575761200092505050611375565b600060068210156137755761376d826006615578565b83901b613784565b613780600683615578565b83901c5b9050603f81166137958360406157b4565b61379f919061549f565b6137aa90600161549f565b9695505050505050565b6000600160801b82106138195760405162461bcd60e51b815260206004820152602760248201527f53616665436173743a2076616c756520646f65736e27742066697420696e20316044820152663238206269747360c81b6064820152608401610ebd565b5090565b6000818152600a6020526040902080546001820154600160a01b90910461ffff169061385c906001600160801b0380821691600160801b9004166136ea565b146130b25761386a82614ce2565b6130b2826139f0565b6001600160a01b038216600090815260076020526040902060028101546064600160501b90910464ffffffffff16106138de5760405162461bcd60e51b815260206004820152600d60248201526c746f6f206d616e79206c6f747360981b6044820152606401610ebd565b600281018054600160501b900464ffffffffff1690600a6138fe836154d2565b82546101009290920a64ffffffffff8181021990931691831602179091556002830180546000868152600a6020526040812080546001600160b01b0316600160281b938490048616600160b01b026001600160d81b0316179055915404909116900390506139845760028101805464ffffffffff191664ffffffffff84161790556139c4565b600281015464ffffffffff600160281b90910481166000908152600a602052604090208054918416600160d81b026001600160d81b039092169190911790555b600201805464ffffffffff909216600160281b0269ffffffffff00000000001990921691909117905550565b6000818152600a602052604081206001810154909190613a23906001600160801b0380821691600160801b9004166136ea565b825461ffff60a01b1916600160a01b61ffff83160217835590508015801590613a6757506000818152600c60205260408120805491613a61836154f6565b90915550155b15611fd957600881901c6000908152600d602052604090208054600160ff84161b179055505050565b6001600160a01b038216613ae65760405162461bcd60e51b815260206004820152601f60248201527f45524332303a206d696e7420746f20746865207a65726f2061646472657373006044820152606401610ebd565b613af260008383614d52565b8060026000828254613b04919061549f565b90915550506001600160a01b03821660009081526020819052604081208054839290613b3190849061549f565b90915550506040518181526001600160a01b038316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200161216d565b6001600160a01b038316613bd65760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b6064820152608401610ebd565b6001600160a01b038216613c375760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b6064820152608401610ebd565b6001600160a01b0383811660008181526001602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6000601254600014613cbd5760405162461bcd60e51b8152600401610ebd90615416565b601654600160a01b900460ff1615613ce75760405162461bcd60e51b8152600401610ebd90615439565b6001600160a01b038316600090815260076020526040812090613d086135c5565b600283015490915060009064ffffffffff165b8015613e0e576000818152600a60205260408120613d399085614443565b90508615613d5757613d54613d4e8489615578565b82614076565b90505b8015613dd157613d66816137b4565b6000838152600a602052604090206001018054601090613d97908490600160801b90046001600160801b03166154b2565b92506101000a8154816001600160801b0302191690836001600160801b03160217905550613dc48261381d565b613dce818461549f565b92505b600087118015613de057508683145b15613deb5750613e0e565b506000908152600a6020526040902054600160d81b900464ffffffffff16613d1b565b5080600003613e235760009350505050611375565b80836001016000828254613e37919061549f565b90915550613e4790508682613a90565b856001600160a01b03167f8c22f554c81b54107cd95e734e4ef45767214974a540f34ea4a4f8c3fc7b13c382604051613e8291815260200190565b60405180910390a295945050505050565b6001600160a01b038316613ef75760405162461bcd60e51b815260206004820152602560248201527f45524332303a207472616e736665722066726f6d20746865207a65726f206164604482015264647265737360d81b6064820152608401610ebd565b6001600160a01b038216613f595760405162461bcd60e51b815260206004820152602360248201527f45524332303a207472616e7366657220746f20746865207a65726f206164647260448201526265737360e81b6064820152608401610ebd565b613f64838383614d52565b6001600160a01b03831660009081526020819052604090205481811015613fdc5760405162461bcd60e51b815260206004820152602660248201527f45524332303a207472616e7366657220616d6f756e7420657863656564732062604482015265616c616e636560d01b6064820152608401610ebd565b613fe68282615578565b6001600160a01b03808616600090815260208190526040808220939093559085168152908120805484929061401c90849061549f565b92505081905550826001600160a01b0316846001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8460405161406891815260200190565b60405180910390a350505050565b60008183106140855781611449565b5090919050565b600061144982846157b4565b600061144982846157e1565b6001600160a01b0382166141045760405162461bcd60e51b815260206004820152602160248201527f45524332303a206275726e2066726f6d20746865207a65726f206164647265736044820152607360f81b6064820152608401610ebd565b61411082600083614d52565b6001600160a01b038216600090815260208190526040902054818110156141845760405162461bcd60e51b815260206004820152602260248201527f45524332303a206275726e20616d6f756e7420657863656564732062616c616e604482015261636560f01b6064820152608401610ebd565b61418e8282615578565b6001600160a01b038416600090815260208190526040812091909155600280548492906141bc908490615578565b90915550506040518281526000906001600160a01b038516907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001613c8c565b600060195460000361421057504390565b60195461421d90426157f5565b6123dc9042615578565b6000808383111561423d57506000905080614246565b50600190508183035b9250929050565b60006012546000146142715760405162461bcd60e51b8152600401610ebd90615416565b6001600160a01b038416600090815260076020526040902080546142cb5760405162461bcd60e51b81526020600482015260116024820152706e6f7468696e6720746f2072656465656d60781b6044820152606401610ebd565b836000036142df57806001015493506142f0565b6142ed848260010154614076565b93505b61431384612256876001600160a01b031660009081526020819052604090205490565b9350600084116143355760405162461bcd60e51b8152600401610ebd9061558b565b836000808086600181111561434c5761434c61537e565b14614369576002840154600160281b900464ffffffffff16614376565b600284015464ffffffffff165b64ffffffffff1690505b60008311801561438f57508015155b1561442c576000808760018111156143a9576143a961537e565b146143d0576000828152600a6020526040902054600160b01b900464ffffffffff166143ee565b6000828152600a6020526040902054600160d81b900464ffffffffff165b64ffffffffff169050600080614404848761465b565b90925090506144138287615578565b955061441f818661549f565b9450829350505050614380565b6144378888846147aa565b50949695505050505050565b60018201546000908190614460906001600160801b0316846136c6565b60018501549091506000906137aa908390600160801b90046001600160801b0316614227565b6000818152600a6020526040902061449d82614e32565b6001600160a01b03841660009081526007602052604081206002810154909164ffffffffff9091169003614504576001600160a01b0385166000908152600760205260408120818155600181019190915560020180546001600160781b031916905561455d565b600182015481546001600160801b03909116908290600090614527908490615578565b90915550506001828101549082018054600160801b9092046001600160801b031691600090614557908490615578565b90915550505b6001600160a01b03841660009081526007602052604081206001840154815491926001600160801b039091169183919061459890849061549f565b90915550506001838101549082018054600160801b9092046001600160801b0316916000906145c890849061549f565b909155505082546001600160a01b0319166001600160a01b0386161783556145f08585613873565b6001830154604080516001600160801b038084168252600160801b909304909216602083015285916001600160a01b0388811692908a16917f30901e212b278fc3f83f7e6e7f0d84b5b0998d5d2a630c6373f7ca8861631a27910160405180910390a4505050505050565b6000828152600a602052604081206001810154829190829061468e908690600160801b90046001600160801b0316614076565b6001830154909150600160801b90046001600160801b031681036146cf5760018201546001600160801b03166146c387614bfd565b90935091506142469050565b60018201546000906146f8906001600160801b03600160801b82048116916116a991168561408c565b9050614703826137b4565b600184018054601090614727908490600160801b90046001600160801b0316615809565b92506101000a8154816001600160801b0302191690836001600160801b03160217905550614754816137b4565b6001840180546000906147719084906001600160801b0316615809565b92506101000a8154816001600160801b0302191690836001600160801b0316021790555061479e8761381d565b90969095509350505050565b6147b483836140a4565b6001600160a01b03831660009081526007602052604081206002810154909164ffffffffff909116900361481b576001600160a01b0384166000908152600760205260408120818155600181019190915560020180546001600160781b031916905561484e565b8281600101600082825461482f9190615578565b9091555050805482908290600090614848908490615578565b90915550505b81601160008282546148609190615578565b90915550506001600160a01b0384166000908152600960205260408120805484929061488d90849061549f565b909155505050505050565b6000805b602081116148e8576000818152600d602052604090205480156148d5576148c881196001018216614cb6565b600883901b019250505090565b50806148e0816154f6565b91505061489c565b506000905090565b6000808212156138195760405162461bcd60e51b815260206004820181905260248201527f53616665436173743a2076616c7565206d75737420626520706f7369746976656044820152606401610ebd565b6000838152600a6020908152604080832080546001600160a01b03168085526007909352908320600182015491939091600160801b90046001600160801b03168603614aa65760018401546149a19086906001600160801b0316615578565b600185015483549192506001600160801b03169083906000906149c5908490615578565b90915550506001848101549083018054600160801b9092046001600160801b0316916000906149f5908490615578565b90915550506001840154601180546001600160801b0390921691600090614a1d908490615578565b90915550506001600160a01b03831660009081526009602052604081208054839290614a4a90849061549f565b90915550614a59905087614bfd565b600282015464ffffffffff16600003614aa1576001600160a01b0383166000908152600760205260408120818155600181019190915560020180546001600160781b03191690555b614b98565b614aaf856137b4565b600185018054600090614acc9084906001600160801b0316615809565b92506101000a8154816001600160801b0302191690836001600160801b03160217905550614af9866137b4565b600185018054601090614b1d908490600160801b90046001600160801b0316615809565b92506101000a8154816001600160801b0302191690836001600160801b0316021790555084826000016000828254614b559190615578565b9250508190555085826001016000828254614b709190615578565b925050819055508460116000828254614b899190615578565b90915550614b9890508761381d565b604080518781526020810187905290810182905287906001600160a01b0385169033907f9098b5d2df18e362430218b79a4098cb0081120ca9fa44e94891dcbefe5204de9060600160405180910390a450505050505050565b6000611449828461549f565b614c0681614ce2565b614c0f81614e32565b6000818152600a602052604081208181556001018190556014805491614c3483615829565b9091555050604051630852cd8d60e31b8152600481018290527f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316906342966c6890602401600060405180830381600087803b158015614c9b57600080fd5b505af1158015614caf573d6000803e3d6000fd5b5050505050565b600060805b80156119035782811c15614cda5791821c91614cd7818361549f565b91505b60011c614cbb565b6000818152600a6020526040902054600160a01b900461ffff168015801590614d2957506000818152600c602052604081208054909190614d2290615829565b9182905550155b156130b257600881901c6000908152600d602052604090208054600160ff84161b191690555050565b6001600160a01b03831615614d6657505050565b614d6e612083565b811115614db35760405162461bcd60e51b81526020600482015260136024820152721cdd5c1c1b1e4818d85c08195e18d959591959606a1b6044820152606401610ebd565b614dbb611b43565b811115614e005760405162461bcd60e51b81526020600482015260136024820152721b5a5b9d081b1a5b5a5d08195e18d959591959606a1b6044820152606401610ebd565b6000614e0a6141ff565b9050601a548114614e2057601a8190556000601b555b81601b600082825461488d919061549f565b6000818152600a6020818152604080842080546001600160a01b0316855260079092529092206002810180549192600160501b90920464ffffffffff1691614e7983615840565b82546101009290920a64ffffffffff8181021990931691831602179091558354600160b01b9004166000039050614ed6578154600282018054600160d81b90920464ffffffffff1664ffffffffff19909216919091179055614f13565b815464ffffffffff600160b01b820481166000908152600a6020526040902080546001600160d81b0316600160d81b938490049092169092021790555b8154600160d81b900464ffffffffff16600003614f5f579054600291909101805469ffffffffff00000000001916600160b01b90920464ffffffffff16600160281b0291909117905550565b505464ffffffffff600160d81b820481166000908152600a60205260409020805464ffffffffff60b01b1916600160b01b9384900490921690920217905550565b600060208083528351808285015260005b81811015614fcd57858101830151858201604001528201614fb1565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b03811681146112cd57600080fd5b6000806040838503121561501857600080fd5b61502183614fee565b946020939093013593505050565b6001600160501b038116811461504457600080fd5b50565b60006020828403121561505957600080fd5b81356114498161502f565b6000806040838503121561507757600080fd5b61508083614fee565b915061508e60208401614fee565b90509250929050565b6000602082840312156150a957600080fd5b5035919050565b600080604083850312156150c357600080fd5b50508035926020909101359150565b6000806000606084860312156150e757600080fd5b6150f084614fee565b92506150fe60208501614fee565b9150604084013590509250925092565b60008060006060848603121561512357600080fd5b61512c84614fee565b95602085013595506040909401359392505050565b60008060006060848603121561515657600080fd5b505081359360208301359350604090920135919050565b60006020828403121561517f57600080fd5b61144982614fee565b600081518084526020808501945080840160005b838110156151b85781518752958201959082019060010161519c565b509495945050505050565b60a0815260006151d660a0830188615188565b82810360208481019190915287518083528882019282019060005b818110156152165784516001600160a01b0316835293830193918301916001016151f1565b5050848103604086015261522a8189615188565b9250505082810360608401526152408186615188565b9150508260808301529695505050505050565b6002811061504457600080fd5b6000806040838503121561527357600080fd5b82359150602083013561528581615253565b809150509250929050565b60008083601f8401126152a257600080fd5b50813567ffffffffffffffff8111156152ba57600080fd5b6020830191508360208260051b850101111561424657600080fd5b600080600080606085870312156152eb57600080fd5b84359350602085013567ffffffffffffffff81111561530957600080fd5b61531587828801615290565b9598909750949560400135949350505050565b6000806020838503121561533b57600080fd5b823567ffffffffffffffff81111561535257600080fd5b61479e85828601615290565b60008060006060848603121561537357600080fd5b833561512c81615253565b634e487b7160e01b600052602160045260246000fd5b600281106153b257634e487b7160e01b600052602160045260246000fd5b9052565b6001600160a01b0385168152608081016153d36020830186615394565b60408201939093526060015292915050565b600080604083850312156153f857600080fd5b61540183614fee565b91506020830135801515811461528557600080fd5b60208082526009908201526839b43aba103237bbb760b91b604082015260600190565b6020808252600690820152651c185d5cd95960d21b604082015260600190565b6020808252601690820152751b1bdd081cd95b99195c881b9bdd08185b1b1bddd95960521b604082015260600190565b634e487b7160e01b600052601160045260246000fd5b8082018082111561137557611375615489565b6001600160801b038181168382160190808211156136bf576136bf615489565b600064ffffffffff8083168181036154ec576154ec615489565b6001019392505050565b60006001820161550857615508615489565b5060010190565b600181811c9082168061552357607f821691505b60208210810361190357634e487b7160e01b600052602260045260246000fd5b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b8181038181111561137557611375615489565b6020808252600f908201526e6e6f20757364782062616c616e636560881b604082015260600190565b6000602082840312156155c657600080fd5b815160ff8116811461144957600080fd5b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b6020808252600d908201526c696e76616c696420707269636560981b604082015260600190565b600181815b8085111561566557816000190482111561564b5761564b615489565b8085161561565857918102915b93841c939080029061562f565b509250929050565b60008261567c57506001611375565b8161568957506000611375565b816001811461569f57600281146156a9576156c5565b6001915050611375565b60ff8411156156ba576156ba615489565b50506001821b611375565b5060208310610133831016604e8410600b84101617156156e8575081810a611375565b6156f2838361562a565b806000190482111561570657615706615489565b029392505050565b600061144960ff84168361566d565b6060810161572b8286615394565b602082019390935260400152919050565b600080600080600060a0868803121561575457600080fd5b855161575f8161502f565b8095505060208601519350604086015192506060860151915060808601516157868161502f565b809150509295509295909350565b6001600160501b038281168282160390808211156136bf576136bf615489565b808202811582820484141761137557611375615489565b634e487b7160e01b600052601260045260246000fd5b6000826157f0576157f06157cb565b500490565b600082615804576158046157cb565b500690565b6001600160801b038281168282160390808211156136bf576136bf615489565b60008161583857615838615489565b506000190190565b600064ffffffffff82168061585757615857615489565b600019019291505056fea26469706673582212204654fb7c27ecad8834ec66cdbffcd7def4a2718750524937071b9fa4808c950664736f6c6343000815003360a06040523480156200001157600080fd5b506040518060400160405280600d81526020016c2aa9a22c102837b9b4ba34b7b760991b81525060405180604001604052806005815260200164055534458560dc1b815250816000908162000067919062000128565b50600162000076828262000128565b50503360805250620001f4565b634e487b7160e01b600052604160045260246000fd5b600181811c90821680620000ae57607f821691505b602082108103620000cf57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200012357600081815260208120601f850160051c81016020861015620000fe5750805b601f850160051c820191505b818110156200011f578281556001016200010a565b5050505b505050565b81516001600160401b0381111562000144576200014462000083565b6200015c8162000155845462000099565b84620000d5565b602080601f8311600181146200019457600084156200017b5750858301515b600019600386901b1c1916600185901b1785556200011f565b600085815260208120601f198616915b82811015620001c557888601518255948401946001909101908401620001a4565b5085821015620001e45787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60805161168262000233600039600081816101a50152818161056a015281816105db0152818161083801528181610faf015261100201526116826000f3fe608060405234801561001057600080fd5b506004361061010b5760003560e01c806342966c68116100a2578063a22cb46511610071578063a22cb4651461023c578063b88d4fde1461024f578063bb35783b14610262578063c87b56dd14610275578063e985e9c51461028857600080fd5b806342966c68146101ed5780636352211e1461020057806370a082311461021357806395d89b411461023457600080fd5b806323b872dd116100de57806323b872dd1461018d578063311176d7146101a057806340c10f19146101c757806342842e0e146101da57600080fd5b806301ffc9a71461011057806306fdde0314610138578063081812fc1461014d578063095ea7b314610178575b600080fd5b61012361011e36600461117a565b61029b565b60405190151581526020015b60405180910390f35b6101406102ed565b60405161012f91906111e7565b61016061015b3660046111fa565b61037f565b6040516001600160a01b03909116815260200161012f565b61018b61018636600461122f565b610419565b005b61018b61019b366004611259565b61052e565b6101607f000000000000000000000000000000000000000000000000000000000000000081565b61018b6101d536600461122f565b61055f565b61018b6101e8366004611259565b6105b5565b61018b6101fb3660046111fa565b6105d0565b61016061020e3660046111fa565b610624565b610226610221366004611295565b61069b565b60405190815260200161012f565b610140610722565b61018b61024a3660046112b0565b610731565b61018b61025d366004611302565b6107f5565b61018b610270366004611259565b61082d565b6101406102833660046111fa565b610875565b6101236102963660046113de565b61095d565b60006001600160e01b031982166380ac58cd60e01b14806102cc57506001600160e01b03198216635b5e139f60e01b145b806102e757506301ffc9a760e01b6001600160e01b03198316145b92915050565b6060600080546102fc90611411565b80601f016020809104026020016040519081016040528092919081815260200182805461032890611411565b80156103755780601f1061034a57610100808354040283529160200191610375565b820191906000526020600020905b81548152906001019060200180831161035857829003601f168201915b5050505050905090565b6000818152600260205260408120546001600160a01b03166103fd5760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a20617070726f76656420717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b60648201526084015b60405180910390fd5b506000908152600460205260409020546001600160a01b031690565b600061042482610624565b9050806001600160a01b0316836001600160a01b0316036104915760405162461bcd60e51b815260206004820152602160248201527f4552433732313a20617070726f76616c20746f2063757272656e74206f776e656044820152603960f91b60648201526084016103f4565b336001600160a01b03821614806104ad57506104ad813361095d565b61051f5760405162461bcd60e51b815260206004820152603860248201527f4552433732313a20617070726f76652063616c6c6572206973206e6f74206f7760448201527f6e6572206e6f7220617070726f76656420666f7220616c6c000000000000000060648201526084016103f4565b610529838361098b565b505050565b61053833826109f9565b6105545760405162461bcd60e51b81526004016103f49061144b565b610529838383610ad0565b336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146105a75760405162461bcd60e51b81526004016103f49061149c565b6105b18282610c7b565b5050565b610529838383604051806020016040528060008152506107f5565b336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146106185760405162461bcd60e51b81526004016103f49061149c565b61062181610dc9565b50565b6000818152600260205260408120546001600160a01b0316806102e75760405162461bcd60e51b815260206004820152602960248201527f4552433732313a206f776e657220717565727920666f72206e6f6e657869737460448201526832b73a103a37b5b2b760b91b60648201526084016103f4565b60006001600160a01b0382166107065760405162461bcd60e51b815260206004820152602a60248201527f4552433732313a2062616c616e636520717565727920666f7220746865207a65604482015269726f206164647265737360b01b60648201526084016103f4565b506001600160a01b031660009081526003602052604090205490565b6060600180546102fc90611411565b336001600160a01b038316036107895760405162461bcd60e51b815260206004820152601960248201527f4552433732313a20617070726f766520746f2063616c6c65720000000000000060448201526064016103f4565b3360008181526005602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915590519081529192917f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a35050565b6107ff33836109f9565b61081b5760405162461bcd60e51b81526004016103f49061144b565b61082784848484610e70565b50505050565b336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146105545760405162461bcd60e51b81526004016103f49061149c565b6000818152600260205260409020546060906001600160a01b03166108f45760405162461bcd60e51b815260206004820152602f60248201527f4552433732314d657461646174613a2055524920717565727920666f72206e6f60448201526e3732bc34b9ba32b73a103a37b5b2b760891b60648201526084016103f4565b600061090b60408051602081019091526000815290565b9050600081511161092b5760405180602001604052806000815250610956565b8061093584610ea3565b6040516020016109469291906114c8565b6040516020818303038152906040525b9392505050565b6001600160a01b03918216600090815260056020908152604080832093909416825291909152205460ff1690565b600081815260046020526040902080546001600160a01b0319166001600160a01b03841690811790915581906109c082610624565b6001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45050565b6000818152600260205260408120546001600160a01b0316610a725760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a206f70657261746f7220717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b60648201526084016103f4565b6000610a7d83610624565b9050806001600160a01b0316846001600160a01b03161480610ab85750836001600160a01b0316610aad8461037f565b6001600160a01b0316145b80610ac85750610ac8818561095d565b949350505050565b826001600160a01b0316610ae382610624565b6001600160a01b031614610b4b5760405162461bcd60e51b815260206004820152602960248201527f4552433732313a207472616e73666572206f6620746f6b656e2074686174206960448201526839903737ba1037bbb760b91b60648201526084016103f4565b6001600160a01b038216610bad5760405162461bcd60e51b8152602060048201526024808201527f4552433732313a207472616e7366657220746f20746865207a65726f206164646044820152637265737360e01b60648201526084016103f4565b610bb8838383610fa4565b610bc360008261098b565b6001600160a01b0383166000908152600360205260408120805460019290610bec90849061150d565b90915550506001600160a01b0382166000908152600360205260408120805460019290610c1a908490611520565b909155505060008181526002602052604080822080546001600160a01b0319166001600160a01b0386811691821790925591518493918716917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4505050565b6001600160a01b038216610cd15760405162461bcd60e51b815260206004820181905260248201527f4552433732313a206d696e7420746f20746865207a65726f206164647265737360448201526064016103f4565b6000818152600260205260409020546001600160a01b031615610d365760405162461bcd60e51b815260206004820152601c60248201527f4552433732313a20746f6b656e20616c7265616479206d696e7465640000000060448201526064016103f4565b610d4260008383610fa4565b6001600160a01b0382166000908152600360205260408120805460019290610d6b908490611520565b909155505060008181526002602052604080822080546001600160a01b0319166001600160a01b03861690811790915590518392907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef908290a45050565b6000610dd482610624565b9050610de281600084610fa4565b610ded60008361098b565b6001600160a01b0381166000908152600360205260408120805460019290610e1690849061150d565b909155505060008281526002602052604080822080546001600160a01b0319169055518391906001600160a01b038416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef908390a45050565b610e7b848484610ad0565b610e8784848484611063565b6108275760405162461bcd60e51b81526004016103f490611533565b606081600003610eca5750506040805180820190915260018152600360fc1b602082015290565b8160005b8115610ef45780610ede81611585565b9150610eed9050600a836115b4565b9150610ece565b60008167ffffffffffffffff811115610f0f57610f0f6112ec565b6040519080825280601f01601f191660200182016040528015610f39576020820181803683370190505b5090505b8415610ac857610f4e60018361150d565b9150610f5b600a866115c8565b610f66906030611520565b60f81b818381518110610f7b57610f7b6115dc565b60200101906001600160f81b031916908160001a905350610f9d600a866115b4565b9450610f3d565b336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146105295760405163399e0df360e11b81526001600160a01b0384811660048301528381166024830152604482018390527f0000000000000000000000000000000000000000000000000000000000000000169063733c1be690606401600060405180830381600087803b15801561104657600080fd5b505af115801561105a573d6000803e3d6000fd5b50505050505050565b60006001600160a01b0384163b1561115957604051630a85bd0160e11b81526001600160a01b0385169063150b7a02906110a79033908990889088906004016115f2565b6020604051808303816000875af19250505080156110e2575060408051601f3d908101601f191682019092526110df9181019061162f565b60015b61113f573d808015611110576040519150601f19603f3d011682016040523d82523d6000602084013e611115565b606091505b5080516000036111375760405162461bcd60e51b81526004016103f490611533565b805181602001fd5b6001600160e01b031916630a85bd0160e11b149050610ac8565b506001949350505050565b6001600160e01b03198116811461062157600080fd5b60006020828403121561118c57600080fd5b813561095681611164565b60005b838110156111b257818101518382015260200161119a565b50506000910152565b600081518084526111d3816020860160208601611197565b601f01601f19169290920160200192915050565b60208152600061095660208301846111bb565b60006020828403121561120c57600080fd5b5035919050565b80356001600160a01b038116811461122a57600080fd5b919050565b6000806040838503121561124257600080fd5b61124b83611213565b946020939093013593505050565b60008060006060848603121561126e57600080fd5b61127784611213565b925061128560208501611213565b9150604084013590509250925092565b6000602082840312156112a757600080fd5b61095682611213565b600080604083850312156112c357600080fd5b6112cc83611213565b9150602083013580151581146112e157600080fd5b809150509250929050565b634e487b7160e01b600052604160045260246000fd5b6000806000806080858703121561131857600080fd5b61132185611213565b935061132f60208601611213565b925060408501359150606085013567ffffffffffffffff8082111561135357600080fd5b818701915087601f83011261136757600080fd5b813581811115611379576113796112ec565b604051601f8201601f19908116603f011681019083821181831017156113a1576113a16112ec565b816040528281528a60208487010111156113ba57600080fd5b82602086016020830137600060208483010152809550505050505092959194509250565b600080604083850312156113f157600080fd5b6113fa83611213565b915061140860208401611213565b90509250929050565b600181811c9082168061142557607f821691505b60208210810361144557634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526031908201527f4552433732313a207472616e736665722063616c6c6572206973206e6f74206f6040820152701ddb995c881b9bdc88185c1c1c9bdd9959607a1b606082015260800190565b6020808252601290820152710c6c2d8d8cae440d2e640dcdee840eae6c8f60731b604082015260600190565b600083516114da818460208801611197565b8351908301906114ee818360208801611197565b01949350505050565b634e487b7160e01b600052601160045260246000fd5b818103818111156102e7576102e76114f7565b808201808211156102e7576102e76114f7565b60208082526032908201527f4552433732313a207472616e7366657220746f206e6f6e20455243373231526560408201527131b2b4bb32b91034b6b83632b6b2b73a32b960711b606082015260800190565b600060018201611597576115976114f7565b5060010190565b634e487b7160e01b600052601260045260246000fd5b6000826115c3576115c361159e565b500490565b6000826115d7576115d761159e565b500690565b634e487b7160e01b600052603260045260246000fd5b6001600160a01b0385811682528416602082015260408101839052608060608201819052600090611625908301846111bb565b9695505050505050565b60006020828403121561164157600080fd5b81516109568161116456fea2646970667358221220ea594754ad1673a55322dbc8802984097b691dc3153e9d47b6bb7177bc1a3c7064736f6c63430008150033"
+
+// DeployUSDX deploys a new Ethereum contract, binding an instance of USDX to it.
+func DeployUSDX(auth *bind.TransactOpts, backend bind.ContractBackend, _priceFeed common.Address) (common.Address, *types.Transaction, *USDX, error) {
+	parsed, err := abi.JSON(strings.NewReader(USDXABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(USDXBin), backend, _priceFeed)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &USDX{USDXCaller: USDXCaller{contract: contract}, USDXTransactor: USDXTransactor{contract: contract}, USDXFilterer: USDXFilterer{contract: contract}}, nil
+}
+
+// USDX is an auto generated Go binding around an Ethereum contract.
+type USDX struct {
+	USDXCaller     // Read-only binding to the contract
+	USDXTransactor // Write-only binding to the contract
+	USDXFilterer   // Log filterer for contract events
+}
+
+// USDXCaller is an auto generated read-only Go binding around an Ethereum contract.
+type USDXCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// USDXTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type USDXTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// USDXFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type USDXFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
 // USDXSession is an auto generated Go binding around an Ethereum contract,
@@ -3060,200 +6865,5010 @@ type USDXSession struct {
 	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
 }
 
-// USDXCallerSession is an auto generated read-only Go binding around an Ethereum contract,
-// with pre-set call options.
-type USDXCallerSession struct {
-	Contract *USDXCaller   // Generic contract caller binding to set the session for
-	CallOpts bind.CallOpts // Call options to use throughout this session
+// USDXCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type USDXCallerSession struct {
+	Contract *USDXCaller   // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// USDXTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type USDXTransactorSession struct {
+	Contract     *USDXTransactor   // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// USDXRaw is an auto generated low-level Go binding around an Ethereum contract.
+type USDXRaw struct {
+	Contract *USDX // Generic contract binding to access the raw methods on
+}
+
+// USDXCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type USDXCallerRaw struct {
+	Contract *USDXCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// USDXTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type USDXTransactorRaw struct {
+	Contract *USDXTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewUSDX creates a new instance of USDX, bound to a specific deployed contract.
+func NewUSDX(address common.Address, backend bind.ContractBackend) (*USDX, error) {
+	contract, err := bindUSDX(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &USDX{USDXCaller: USDXCaller{contract: contract}, USDXTransactor: USDXTransactor{contract: contract}, USDXFilterer: USDXFilterer{contract: contract}}, nil
+}
+
+// NewUSDXCaller creates a new read-only instance of USDX, bound to a specific deployed contract.
+func NewUSDXCaller(address common.Address, caller bind.ContractCaller) (*USDXCaller, error) {
+	contract, err := bindUSDX(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXCaller{contract: contract}, nil
+}
+
+// NewUSDXTransactor creates a new write-only instance of USDX, bound to a specific deployed contract.
+func NewUSDXTransactor(address common.Address, transactor bind.ContractTransactor) (*USDXTransactor, error) {
+	contract, err := bindUSDX(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXTransactor{contract: contract}, nil
+}
+
+// NewUSDXFilterer creates a new log filterer instance of USDX, bound to a specific deployed contract.
+func NewUSDXFilterer(address common.Address, filterer bind.ContractFilterer) (*USDXFilterer, error) {
+	contract, err := bindUSDX(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXFilterer{contract: contract}, nil
+}
+
+// bindUSDX binds a generic wrapper to an already deployed contract.
+func bindUSDX(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(USDXABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_USDX *USDXRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _USDX.Contract.USDXCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_USDX *USDXRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _USDX.Contract.USDXTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_USDX *USDXRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _USDX.Contract.USDXTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_USDX *USDXCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _USDX.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_USDX *USDXTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _USDX.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_USDX *USDXTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _USDX.Contract.contract.Transact(opts, method, params...)
+}
+
+// MAXLOTS is a free data retrieval call binding the contract method 0xcec117ba.
+//
+// Solidity: function MAX_LOTS() view returns(uint256)
+func (_USDX *USDXCaller) MAXLOTS(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "MAX_LOTS")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MAXLOTS is a free data retrieval call binding the contract method 0xcec117ba.
+//
+// Solidity: function MAX_LOTS() view returns(uint256)
+func (_USDX *USDXSession) MAXLOTS() (*big.Int, error) {
+	return _USDX.Contract.MAXLOTS(&_USDX.CallOpts)
+}
+
+// MAXLOTS is a free data retrieval call binding the contract method 0xcec117ba.
+//
+// Solidity: function MAX_LOTS() view returns(uint256)
+func (_USDX *USDXCallerSession) MAXLOTS() (*big.Int, error) {
+	return _USDX.Contract.MAXLOTS(&_USDX.CallOpts)
+}
+
+// AcceptsLots is a free data retrieval call binding the contract method 0x17618ebe.
+//
+// Solidity: function acceptsLots(address _owner, address _sender) view returns(bool)
+func (_USDX *USDXCaller) AcceptsLots(opts *bind.CallOpts, _owner common.Address, _sender common.Address) (bool, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "acceptsLots", _owner, _sender)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// AcceptsLots is a free data retrieval call binding the contract method 0x17618ebe.
+//
+// Solidity: function acceptsLots(address _owner, address _sender) view returns(bool)
+func (_USDX *USDXSession) AcceptsLots(_owner common.Address, _sender common.Address) (bool, error) {
+	return _USDX.Contract.AcceptsLots(&_USDX.CallOpts, _owner, _sender)
+}
+
+// AcceptsLots is a free data retrieval call binding the contract method 0x17618ebe.
+//
+// Solidity: function acceptsLots(address _owner, address _sender) view returns(bool)
+func (_USDX *USDXCallerSession) AcceptsLots(_owner common.Address, _sender common.Address) (bool, error) {
+	return _USDX.Contract.AcceptsLots(&_USDX.CallOpts, _owner, _sender)
+}
+
+// Accounts is a free data retrieval call binding the contract method 0x5e5c06e2.
+//
+// Solidity: function accounts(address ) view returns(uint256 locked, uint256 mint, uint40 firstLot, uint40 lastLot, uint40 lots)
+func (_USDX *USDXCaller) Accounts(opts *bind.CallOpts, arg0 common.Address) (struct {
+	Locked   *big.Int
+	Mint     *big.Int
+	FirstLot *big.Int
+	LastLot  *big.Int
+	Lots     *big.Int
+}, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "accounts", arg0)
+
+	outstruct := new(struct {
+		Locked   *big.Int
+		Mint     *big.Int
+		FirstLot *big.Int
+		LastLot  *big.Int
+		Lots     *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Locked = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Mint = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.FirstLot = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.LastLot = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.Lots = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Accounts is a free data retrieval call binding the contract method 0x5e5c06e2.
+//
+// Solidity: function accounts(address ) view returns(uint256 locked, uint256 mint, uint40 firstLot, uint40 lastLot, uint40 lots)
+func (_USDX *USDXSession) Accounts(arg0 common.Address) (struct {
+	Locked   *big.Int
+	Mint     *big.Int
+	FirstLot *big.Int
+	LastLot  *big.Int
+	Lots     *big.Int
+}, error) {
+	return _USDX.Contract.Accounts(&_USDX.CallOpts, arg0)
+}
+
+// Accounts is a free data retrieval call binding the contract method 0x5e5c06e2.
+//
+// Solidity: function accounts(address ) view returns(uint256 locked, uint256 mint, uint40 firstLot, uint40 lastLot, uint40 lots)
+func (_USDX *USDXCallerSession) Accounts(arg0 common.Address) (struct {
+	Locked   *big.Int
+	Mint     *big.Int
+	FirstLot *big.Int
+	LastLot  *big.Int
+	Lots     *big.Int
+}, error) {
+	return _USDX.Contract.Accounts(&_USDX.CallOpts, arg0)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_USDX *USDXCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_USDX *USDXSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _USDX.Contract.Allowance(&_USDX.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_USDX *USDXCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _USDX.Contract.Allowance(&_USDX.CallOpts, owner, spender)
+}
+
+// Appreciation is a free data retrieval call binding the contract method 0xd3980103.
+//
+// Solidity: function appreciation(address _account) view returns(uint256)
+func (_USDX *USDXCaller) Appreciation(opts *bind.CallOpts, _account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "appreciation", _account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Appreciation is a free data retrieval call binding the contract method 0xd3980103.
+//
+// Solidity: function appreciation(address _account) view returns(uint256)
+func (_USDX *USDXSession) Appreciation(_account common.Address) (*big.Int, error) {
+	return _USDX.Contract.Appreciation(&_USDX.CallOpts, _account)
+}
+
+// Appreciation is a free data retrieval call binding the contract method 0xd3980103.
+//
+// Solidity: function appreciation(address _account) view returns(uint256)
+func (_USDX *USDXCallerSession) Appreciation(_account common.Address) (*big.Int, error) {
+	return _USDX.Contract.Appreciation(&_USDX.CallOpts, _account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_USDX *USDXCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_USDX *USDXSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _USDX.Contract.BalanceOf(&_USDX.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_USDX *USDXCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _USDX.Contract.BalanceOf(&_USDX.CallOpts, account)
+}
+
+// Collectors is a free data retrieval call binding the contract method 0x9593b523.
+//
+// Solidity: function collectors(address ) view returns(address collector, uint256 threshold)
+func (_USDX *USDXCaller) Collectors(opts *bind.CallOpts, arg0 common.Address) (struct {
+	Collector common.Address
+	Threshold *big.Int
+}, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "collectors", arg0)
+
+	outstruct := new(struct {
+		Collector common.Address
+		Threshold *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Collector = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Threshold = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Collectors is a free data retrieval call binding the contract method 0x9593b523.
+//
+// Solidity: function collectors(address ) view returns(address collector, uint256 threshold)
+func (_USDX *USDXSession) Collectors(arg0 common.Address) (struct {
+	Collector common.Address
+	Threshold *big.Int
+}, error) {
+	return _USDX.Contract.Collectors(&_USDX.CallOpts, arg0)
+}
+
+// Collectors is a free data retrieval call binding the contract method 0x9593b523.
+//
+// Solidity: function collectors(address ) view returns(address collector, uint256 threshold)
+func (_USDX *USDXCallerSession) Collectors(arg0 common.Address) (struct {
+	Collector common.Address
+	Threshold *big.Int
+}, error) {
+	return _USDX.Contract.Collectors(&_USDX.CallOpts, arg0)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_USDX *USDXCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_USDX *USDXSession) Decimals() (uint8, error) {
+	return _USDX.Contract.Decimals(&_USDX.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_USDX *USDXCallerSession) Decimals() (uint8, error) {
+	return _USDX.Contract.Decimals(&_USDX.CallOpts)
+}
+
+// Guardian is a free data retrieval call binding the contract method 0x452a9320.
+//
+// Solidity: function guardian() view returns(address)
+func (_USDX *USDXCaller) Guardian(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "guardian")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Guardian is a free data retrieval call binding the contract method 0x452a9320.
+//
+// Solidity: function guardian() view returns(address)
+func (_USDX *USDXSession) Guardian() (common.Address, error) {
+	return _USDX.Contract.Guardian(&_USDX.CallOpts)
+}
+
+// Guardian is a free data retrieval call binding the contract method 0x452a9320.
+//
+// Solidity: function guardian() view returns(address)
+func (_USDX *USDXCallerSession) Guardian() (common.Address, error) {
+	return _USDX.Contract.Guardian(&_USDX.CallOpts)
+}
+
+// LastLotId is a free data retrieval call binding the contract method 0x6a52bd45.
+//
+// Solidity: function lastLotId() view returns(uint40)
+func (_USDX *USDXCaller) LastLotId(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "lastLotId")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LastLotId is a free data retrieval call binding the contract method 0x6a52bd45.
+//
+// Solidity: function lastLotId() view returns(uint40)
+func (_USDX *USDXSession) LastLotId() (*big.Int, error) {
+	return _USDX.Contract.LastLotId(&_USDX.CallOpts)
+}
+
+// LastLotId is a free data retrieval call binding the contract method 0x6a52bd45.
+//
+// Solidity: function lastLotId() view returns(uint40)
+func (_USDX *USDXCallerSession) LastLotId() (*big.Int, error) {
+	return _USDX.Contract.LastLotId(&_USDX.CallOpts)
+}
+
+// LastOrderId is a free data retrieval call binding the contract method 0x5662ecc7.
+//
+// Solidity: function lastOrderId() view returns(uint256)
+func (_USDX *USDXCaller) LastOrderId(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "lastOrderId")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LastOrderId is a free data retrieval call binding the contract method 0x5662ecc7.
+//
+// Solidity: function lastOrderId() view returns(uint256)
+func (_USDX *USDXSession) LastOrderId() (*big.Int, error) {
+	return _USDX.Contract.LastOrderId(&_USDX.CallOpts)
+}
+
+// LastOrderId is a free data retrieval call binding the contract method 0x5662ecc7.
+//
+// Solidity: function lastOrderId() view returns(uint256)
+func (_USDX *USDXCallerSession) LastOrderId() (*big.Int, error) {
+	return _USDX.Contract.LastOrderId(&_USDX.CallOpts)
+}
+
+// LotAppreciation is a free data retrieval call binding the contract method 0x68db5b99.
+//
+// Solidity: function lotAppreciation(uint256 _id) view returns(uint256)
+func (_USDX *USDXCaller) LotAppreciation(opts *bind.CallOpts, _id *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "lotAppreciation", _id)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LotAppreciation is a free data retrieval call binding the contract method 0x68db5b99.
+//
+// Solidity: function lotAppreciation(uint256 _id) view returns(uint256)
+func (_USDX *USDXSession) LotAppreciation(_id *big.Int) (*big.Int, error) {
+	return _USDX.Contract.LotAppreciation(&_USDX.CallOpts, _id)
+}
+
+// LotAppreciation is a free data retrieval call binding the contract method 0x68db5b99.
+//
+// Solidity: function lotAppreciation(uint256 _id) view returns(uint256)
+func (_USDX *USDXCallerSession) LotAppreciation(_id *big.Int) (*big.Int, error) {
+	return _USDX.Contract.LotAppreciation(&_USDX.CallOpts, _id)
+}
+
+// LotCount is a free data retrieval call binding the contract method 0x7ae169e9.
+//
+// Solidity: function lotCount() view returns(uint256)
+func (_USDX *USDXCaller) LotCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "lotCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LotCount is a free data retrieval call binding the contract method 0x7ae169e9.
+//
+// Solidity: function lotCount() view returns(uint256)
+func (_USDX *USDXSession) LotCount() (*big.Int, error) {
+	return _USDX.Contract.LotCount(&_USDX.CallOpts)
+}
+
+// LotCount is a free data retrieval call binding the contract method 0x7ae169e9.
+//
+// Solidity: function lotCount() view returns(uint256)
+func (_USDX *USDXCallerSession) LotCount() (*big.Int, error) {
+	return _USDX.Contract.LotCount(&_USDX.CallOpts)
+}
+
+// LotPage is a free data retrieval call binding the contract method 0x5a84a03a.
+//
+// Solidity: function lotPage(uint256 _start, uint256 _count) view returns(uint256[] ids, address[] owners, uint256[] locked, uint256[] mint, uint256 next)
+func (_USDX *USDXCaller) LotPage(opts *bind.CallOpts, _start *big.Int, _count *big.Int) (struct {
+	Ids    []*big.Int
+	Owners []common.Address
+	Locked []*big.Int
+	Mint   []*big.Int
+	Next   *big.Int
+}, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "lotPage", _start, _count)
+
+	outstruct := new(struct {
+		Ids    []*big.Int
+		Owners []common.Address
+		Locked []*big.Int
+		Mint   []*big.Int
+		Next   *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Ids = *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+	outstruct.Owners = *abi.ConvertType(out[1], new([]common.Address)).(*[]common.Address)
+	outstruct.Locked = *abi.ConvertType(out[2], new([]*big.Int)).(*[]*big.Int)
+	outstruct.Mint = *abi.ConvertType(out[3], new([]*big.Int)).(*[]*big.Int)
+	outstruct.Next = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// LotPage is a free data retrieval call binding the contract method 0x5a84a03a.
+//
+// Solidity: function lotPage(uint256 _start, uint256 _count) view returns(uint256[] ids, address[] owners, uint256[] locked, uint256[] mint, uint256 next)
+func (_USDX *USDXSession) LotPage(_start *big.Int, _count *big.Int) (struct {
+	Ids    []*big.Int
+	Owners []common.Address
+	Locked []*big.Int
+	Mint   []*big.Int
+	Next   *big.Int
+}, error) {
+	return _USDX.Contract.LotPage(&_USDX.CallOpts, _start, _count)
+}
+
+// LotPage is a free data retrieval call binding the contract method 0x5a84a03a.
+//
+// Solidity: function lotPage(uint256 _start, uint256 _count) view returns(uint256[] ids, address[] owners, uint256[] locked, uint256[] mint, uint256 next)
+func (_USDX *USDXCallerSession) LotPage(_start *big.Int, _count *big.Int) (struct {
+	Ids    []*big.Int
+	Owners []common.Address
+	Locked []*big.Int
+	Mint   []*big.Int
+	Next   *big.Int
+}, error) {
+	return _USDX.Contract.LotPage(&_USDX.CallOpts, _start, _count)
+}
+
+// LotSenders is a free data retrieval call binding the contract method 0x8407fb34.
+//
+// Solidity: function lotSenders(address , address ) view returns(bool)
+func (_USDX *USDXCaller) LotSenders(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (bool, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "lotSenders", arg0, arg1)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// LotSenders is a free data retrieval call binding the contract method 0x8407fb34.
+//
+// Solidity: function lotSenders(address , address ) view returns(bool)
+func (_USDX *USDXSession) LotSenders(arg0 common.Address, arg1 common.Address) (bool, error) {
+	return _USDX.Contract.LotSenders(&_USDX.CallOpts, arg0, arg1)
+}
+
+// LotSenders is a free data retrieval call binding the contract method 0x8407fb34.
+//
+// Solidity: function lotSenders(address , address ) view returns(bool)
+func (_USDX *USDXCallerSession) LotSenders(arg0 common.Address, arg1 common.Address) (bool, error) {
+	return _USDX.Contract.LotSenders(&_USDX.CallOpts, arg0, arg1)
+}
+
+// Lots is a free data retrieval call binding the contract method 0xf1648e84.
+//
+// Solidity: function lots(uint256 ) view returns(address owner, uint16 bucket, uint40 prev, uint40 next, uint128 locked, uint128 mint)
+func (_USDX *USDXCaller) Lots(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Owner  common.Address
+	Bucket uint16
+	Prev   *big.Int
+	Next   *big.Int
+	Locked *big.Int
+	Mint   *big.Int
+}, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "lots", arg0)
+
+	outstruct := new(struct {
+		Owner  common.Address
+		Bucket uint16
+		Prev   *big.Int
+		Next   *big.Int
+		Locked *big.Int
+		Mint   *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Owner = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Bucket = *abi.ConvertType(out[1], new(uint16)).(*uint16)
+	outstruct.Prev = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.Next = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.Locked = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.Mint = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Lots is a free data retrieval call binding the contract method 0xf1648e84.
+//
+// Solidity: function lots(uint256 ) view returns(address owner, uint16 bucket, uint40 prev, uint40 next, uint128 locked, uint128 mint)
+func (_USDX *USDXSession) Lots(arg0 *big.Int) (struct {
+	Owner  common.Address
+	Bucket uint16
+	Prev   *big.Int
+	Next   *big.Int
+	Locked *big.Int
+	Mint   *big.Int
+}, error) {
+	return _USDX.Contract.Lots(&_USDX.CallOpts, arg0)
+}
+
+// Lots is a free data retrieval call binding the contract method 0xf1648e84.
+//
+// Solidity: function lots(uint256 ) view returns(address owner, uint16 bucket, uint40 prev, uint40 next, uint128 locked, uint128 mint)
+func (_USDX *USDXCallerSession) Lots(arg0 *big.Int) (struct {
+	Owner  common.Address
+	Bucket uint16
+	Prev   *big.Int
+	Next   *big.Int
+	Locked *big.Int
+	Mint   *big.Int
+}, error) {
+	return _USDX.Contract.Lots(&_USDX.CallOpts, arg0)
+}
+
+// MintHeadroom is a free data retrieval call binding the contract method 0x8b67c1f7.
+//
+// Solidity: function mintHeadroom() view returns(uint256)
+func (_USDX *USDXCaller) MintHeadroom(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "mintHeadroom")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MintHeadroom is a free data retrieval call binding the contract method 0x8b67c1f7.
+//
+// Solidity: function mintHeadroom() view returns(uint256)
+func (_USDX *USDXSession) MintHeadroom() (*big.Int, error) {
+	return _USDX.Contract.MintHeadroom(&_USDX.CallOpts)
+}
+
+// MintHeadroom is a free data retrieval call binding the contract method 0x8b67c1f7.
+//
+// Solidity: function mintHeadroom() view returns(uint256)
+func (_USDX *USDXCallerSession) MintHeadroom() (*big.Int, error) {
+	return _USDX.Contract.MintHeadroom(&_USDX.CallOpts)
+}
+
+// MintLimit is a free data retrieval call binding the contract method 0x996517cf.
+//
+// Solidity: function mintLimit() view returns(uint256)
+func (_USDX *USDXCaller) MintLimit(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "mintLimit")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MintLimit is a free data retrieval call binding the contract method 0x996517cf.
+//
+// Solidity: function mintLimit() view returns(uint256)
+func (_USDX *USDXSession) MintLimit() (*big.Int, error) {
+	return _USDX.Contract.MintLimit(&_USDX.CallOpts)
+}
+
+// MintLimit is a free data retrieval call binding the contract method 0x996517cf.
+//
+// Solidity: function mintLimit() view returns(uint256)
+func (_USDX *USDXCallerSession) MintLimit() (*big.Int, error) {
+	return _USDX.Contract.MintLimit(&_USDX.CallOpts)
+}
+
+// MintWindow is a free data retrieval call binding the contract method 0x9ce93edf.
+//
+// Solidity: function mintWindow() view returns(uint256)
+func (_USDX *USDXCaller) MintWindow(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "mintWindow")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MintWindow is a free data retrieval call binding the contract method 0x9ce93edf.
+//
+// Solidity: function mintWindow() view returns(uint256)
+func (_USDX *USDXSession) MintWindow() (*big.Int, error) {
+	return _USDX.Contract.MintWindow(&_USDX.CallOpts)
+}
+
+// MintWindow is a free data retrieval call binding the contract method 0x9ce93edf.
+//
+// Solidity: function mintWindow() view returns(uint256)
+func (_USDX *USDXCallerSession) MintWindow() (*big.Int, error) {
+	return _USDX.Contract.MintWindow(&_USDX.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_USDX *USDXCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_USDX *USDXSession) Name() (string, error) {
+	return _USDX.Contract.Name(&_USDX.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_USDX *USDXCallerSession) Name() (string, error) {
+	return _USDX.Contract.Name(&_USDX.CallOpts)
+}
+
+// Orders is a free data retrieval call binding the contract method 0xa85c38ef.
+//
+// Solidity: function orders(uint256 ) view returns(address owner, uint8 kind, int256 price, uint256 amount)
+func (_USDX *USDXCaller) Orders(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Owner  common.Address
+	Kind   uint8
+	Price  *big.Int
+	Amount *big.Int
+}, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "orders", arg0)
+
+	outstruct := new(struct {
+		Owner  common.Address
+		Kind   uint8
+		Price  *big.Int
+		Amount *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Owner = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Kind = *abi.ConvertType(out[1], new(uint8)).(*uint8)
+	outstruct.Price = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.Amount = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Orders is a free data retrieval call binding the contract method 0xa85c38ef.
+//
+// Solidity: function orders(uint256 ) view returns(address owner, uint8 kind, int256 price, uint256 amount)
+func (_USDX *USDXSession) Orders(arg0 *big.Int) (struct {
+	Owner  common.Address
+	Kind   uint8
+	Price  *big.Int
+	Amount *big.Int
+}, error) {
+	return _USDX.Contract.Orders(&_USDX.CallOpts, arg0)
+}
+
+// Orders is a free data retrieval call binding the contract method 0xa85c38ef.
+//
+// Solidity: function orders(uint256 ) view returns(address owner, uint8 kind, int256 price, uint256 amount)
+func (_USDX *USDXCallerSession) Orders(arg0 *big.Int) (struct {
+	Owner  common.Address
+	Kind   uint8
+	Price  *big.Int
+	Amount *big.Int
+}, error) {
+	return _USDX.Contract.Orders(&_USDX.CallOpts, arg0)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_USDX *USDXCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_USDX *USDXSession) Owner() (common.Address, error) {
+	return _USDX.Contract.Owner(&_USDX.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_USDX *USDXCallerSession) Owner() (common.Address, error) {
+	return _USDX.Contract.Owner(&_USDX.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_USDX *USDXCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_USDX *USDXSession) Paused() (bool, error) {
+	return _USDX.Contract.Paused(&_USDX.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_USDX *USDXCallerSession) Paused() (bool, error) {
+	return _USDX.Contract.Paused(&_USDX.CallOpts)
+}
+
+// Positions is a free data retrieval call binding the contract method 0xba5b7982.
+//
+// Solidity: function positions() view returns(address)
+func (_USDX *USDXCaller) Positions(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "positions")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Positions is a free data retrieval call binding the contract method 0xba5b7982.
+//
+// Solidity: function positions() view returns(address)
+func (_USDX *USDXSession) Positions() (common.Address, error) {
+	return _USDX.Contract.Positions(&_USDX.CallOpts)
+}
+
+// Positions is a free data retrieval call binding the contract method 0xba5b7982.
+//
+// Solidity: function positions() view returns(address)
+func (_USDX *USDXCallerSession) Positions() (common.Address, error) {
+	return _USDX.Contract.Positions(&_USDX.CallOpts)
+}
+
+// PriceStalenessThreshold is a free data retrieval call binding the contract method 0xbd111870.
+//
+// Solidity: function priceStalenessThreshold() view returns(uint80)
+func (_USDX *USDXCaller) PriceStalenessThreshold(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "priceStalenessThreshold")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PriceStalenessThreshold is a free data retrieval call binding the contract method 0xbd111870.
+//
+// Solidity: function priceStalenessThreshold() view returns(uint80)
+func (_USDX *USDXSession) PriceStalenessThreshold() (*big.Int, error) {
+	return _USDX.Contract.PriceStalenessThreshold(&_USDX.CallOpts)
+}
+
+// PriceStalenessThreshold is a free data retrieval call binding the contract method 0xbd111870.
+//
+// Solidity: function priceStalenessThreshold() view returns(uint80)
+func (_USDX *USDXCallerSession) PriceStalenessThreshold() (*big.Int, error) {
+	return _USDX.Contract.PriceStalenessThreshold(&_USDX.CallOpts)
+}
+
+// SettledSupply is a free data retrieval call binding the contract method 0x2969d0ed.
+//
+// Solidity: function settledSupply() view returns(uint256)
+func (_USDX *USDXCaller) SettledSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "settledSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SettledSupply is a free data retrieval call binding the contract method 0x2969d0ed.
+//
+// Solidity: function settledSupply() view returns(uint256)
+func (_USDX *USDXSession) SettledSupply() (*big.Int, error) {
+	return _USDX.Contract.SettledSupply(&_USDX.CallOpts)
+}
+
+// SettledSupply is a free data retrieval call binding the contract method 0x2969d0ed.
+//
+// Solidity: function settledSupply() view returns(uint256)
+func (_USDX *USDXCallerSession) SettledSupply() (*big.Int, error) {
+	return _USDX.Contract.SettledSupply(&_USDX.CallOpts)
+}
+
+// SettlementPool is a free data retrieval call binding the contract method 0xaac0297a.
+//
+// Solidity: function settlementPool() view returns(uint256)
+func (_USDX *USDXCaller) SettlementPool(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "settlementPool")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SettlementPool is a free data retrieval call binding the contract method 0xaac0297a.
+//
+// Solidity: function settlementPool() view returns(uint256)
+func (_USDX *USDXSession) SettlementPool() (*big.Int, error) {
+	return _USDX.Contract.SettlementPool(&_USDX.CallOpts)
+}
+
+// SettlementPool is a free data retrieval call binding the contract method 0xaac0297a.
+//
+// Solidity: function settlementPool() view returns(uint256)
+func (_USDX *USDXCallerSession) SettlementPool() (*big.Int, error) {
+	return _USDX.Contract.SettlementPool(&_USDX.CallOpts)
+}
+
+// SettlementPrice is a free data retrieval call binding the contract method 0xf348e8b2.
+//
+// Solidity: function settlementPrice() view returns(int256)
+func (_USDX *USDXCaller) SettlementPrice(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "settlementPrice")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SettlementPrice is a free data retrieval call binding the contract method 0xf348e8b2.
+//
+// Solidity: function settlementPrice() view returns(int256)
+func (_USDX *USDXSession) SettlementPrice() (*big.Int, error) {
+	return _USDX.Contract.SettlementPrice(&_USDX.CallOpts)
+}
+
+// SettlementPrice is a free data retrieval call binding the contract method 0xf348e8b2.
+//
+// Solidity: function settlementPrice() view returns(int256)
+func (_USDX *USDXCallerSession) SettlementPrice() (*big.Int, error) {
+	return _USDX.Contract.SettlementPrice(&_USDX.CallOpts)
+}
+
+// SupplyCap is a free data retrieval call binding the contract method 0x8f770ad0.
+//
+// Solidity: function supplyCap() view returns(uint256)
+func (_USDX *USDXCaller) SupplyCap(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "supplyCap")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SupplyCap is a free data retrieval call binding the contract method 0x8f770ad0.
+//
+// Solidity: function supplyCap() view returns(uint256)
+func (_USDX *USDXSession) SupplyCap() (*big.Int, error) {
+	return _USDX.Contract.SupplyCap(&_USDX.CallOpts)
+}
+
+// SupplyCap is a free data retrieval call binding the contract method 0x8f770ad0.
+//
+// Solidity: function supplyCap() view returns(uint256)
+func (_USDX *USDXCallerSession) SupplyCap() (*big.Int, error) {
+	return _USDX.Contract.SupplyCap(&_USDX.CallOpts)
+}
+
+// SupplyHeadroom is a free data retrieval call binding the contract method 0x7588ea13.
+//
+// Solidity: function supplyHeadroom() view returns(uint256)
+func (_USDX *USDXCaller) SupplyHeadroom(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "supplyHeadroom")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SupplyHeadroom is a free data retrieval call binding the contract method 0x7588ea13.
+//
+// Solidity: function supplyHeadroom() view returns(uint256)
+func (_USDX *USDXSession) SupplyHeadroom() (*big.Int, error) {
+	return _USDX.Contract.SupplyHeadroom(&_USDX.CallOpts)
+}
+
+// SupplyHeadroom is a free data retrieval call binding the contract method 0x7588ea13.
+//
+// Solidity: function supplyHeadroom() view returns(uint256)
+func (_USDX *USDXCallerSession) SupplyHeadroom() (*big.Int, error) {
+	return _USDX.Contract.SupplyHeadroom(&_USDX.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_USDX *USDXCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_USDX *USDXSession) Symbol() (string, error) {
+	return _USDX.Contract.Symbol(&_USDX.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_USDX *USDXCallerSession) Symbol() (string, error) {
+	return _USDX.Contract.Symbol(&_USDX.CallOpts)
+}
+
+// TotalLocked is a free data retrieval call binding the contract method 0x56891412.
+//
+// Solidity: function totalLocked() view returns(uint256)
+func (_USDX *USDXCaller) TotalLocked(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "totalLocked")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalLocked is a free data retrieval call binding the contract method 0x56891412.
+//
+// Solidity: function totalLocked() view returns(uint256)
+func (_USDX *USDXSession) TotalLocked() (*big.Int, error) {
+	return _USDX.Contract.TotalLocked(&_USDX.CallOpts)
+}
+
+// TotalLocked is a free data retrieval call binding the contract method 0x56891412.
+//
+// Solidity: function totalLocked() view returns(uint256)
+func (_USDX *USDXCallerSession) TotalLocked() (*big.Int, error) {
+	return _USDX.Contract.TotalLocked(&_USDX.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_USDX *USDXCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_USDX *USDXSession) TotalSupply() (*big.Int, error) {
+	return _USDX.Contract.TotalSupply(&_USDX.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_USDX *USDXCallerSession) TotalSupply() (*big.Int, error) {
+	return _USDX.Contract.TotalSupply(&_USDX.CallOpts)
+}
+
+// UsdPriceFeed is a free data retrieval call binding the contract method 0xde4874b0.
+//
+// Solidity: function usdPriceFeed() view returns(address)
+func (_USDX *USDXCaller) UsdPriceFeed(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "usdPriceFeed")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// UsdPriceFeed is a free data retrieval call binding the contract method 0xde4874b0.
+//
+// Solidity: function usdPriceFeed() view returns(address)
+func (_USDX *USDXSession) UsdPriceFeed() (common.Address, error) {
+	return _USDX.Contract.UsdPriceFeed(&_USDX.CallOpts)
+}
+
+// UsdPriceFeed is a free data retrieval call binding the contract method 0xde4874b0.
+//
+// Solidity: function usdPriceFeed() view returns(address)
+func (_USDX *USDXCallerSession) UsdPriceFeed() (common.Address, error) {
+	return _USDX.Contract.UsdPriceFeed(&_USDX.CallOpts)
+}
+
+// WindowHeadroom is a free data retrieval call binding the contract method 0x5a828703.
+//
+// Solidity: function windowHeadroom() view returns(uint256)
+func (_USDX *USDXCaller) WindowHeadroom(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "windowHeadroom")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// WindowHeadroom is a free data retrieval call binding the contract method 0x5a828703.
+//
+// Solidity: function windowHeadroom() view returns(uint256)
+func (_USDX *USDXSession) WindowHeadroom() (*big.Int, error) {
+	return _USDX.Contract.WindowHeadroom(&_USDX.CallOpts)
+}
+
+// WindowHeadroom is a free data retrieval call binding the contract method 0x5a828703.
+//
+// Solidity: function windowHeadroom() view returns(uint256)
+func (_USDX *USDXCallerSession) WindowHeadroom() (*big.Int, error) {
+	return _USDX.Contract.WindowHeadroom(&_USDX.CallOpts)
+}
+
+// Withdrawable is a free data retrieval call binding the contract method 0xce513b6f.
+//
+// Solidity: function withdrawable(address ) view returns(uint256)
+func (_USDX *USDXCaller) Withdrawable(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _USDX.contract.Call(opts, &out, "withdrawable", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Withdrawable is a free data retrieval call binding the contract method 0xce513b6f.
+//
+// Solidity: function withdrawable(address ) view returns(uint256)
+func (_USDX *USDXSession) Withdrawable(arg0 common.Address) (*big.Int, error) {
+	return _USDX.Contract.Withdrawable(&_USDX.CallOpts, arg0)
+}
+
+// Withdrawable is a free data retrieval call binding the contract method 0xce513b6f.
+//
+// Solidity: function withdrawable(address ) view returns(uint256)
+func (_USDX *USDXCallerSession) Withdrawable(arg0 common.Address) (*big.Int, error) {
+	return _USDX.Contract.Withdrawable(&_USDX.CallOpts, arg0)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_USDX *USDXTransactor) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "approve", spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_USDX *USDXSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Approve(&_USDX.TransactOpts, spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_USDX *USDXTransactorSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Approve(&_USDX.TransactOpts, spender, amount)
+}
+
+// CancelOrder is a paid mutator transaction binding the contract method 0x514fcac7.
+//
+// Solidity: function cancelOrder(uint256 _id) returns()
+func (_USDX *USDXTransactor) CancelOrder(opts *bind.TransactOpts, _id *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "cancelOrder", _id)
+}
+
+// CancelOrder is a paid mutator transaction binding the contract method 0x514fcac7.
+//
+// Solidity: function cancelOrder(uint256 _id) returns()
+func (_USDX *USDXSession) CancelOrder(_id *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.CancelOrder(&_USDX.TransactOpts, _id)
+}
+
+// CancelOrder is a paid mutator transaction binding the contract method 0x514fcac7.
+//
+// Solidity: function cancelOrder(uint256 _id) returns()
+func (_USDX *USDXTransactorSession) CancelOrder(_id *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.CancelOrder(&_USDX.TransactOpts, _id)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0x379607f5.
+//
+// Solidity: function claim(uint256 _usdx) returns(uint256)
+func (_USDX *USDXTransactor) Claim(opts *bind.TransactOpts, _usdx *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "claim", _usdx)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0x379607f5.
+//
+// Solidity: function claim(uint256 _usdx) returns(uint256)
+func (_USDX *USDXSession) Claim(_usdx *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Claim(&_USDX.TransactOpts, _usdx)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0x379607f5.
+//
+// Solidity: function claim(uint256 _usdx) returns(uint256)
+func (_USDX *USDXTransactorSession) Claim(_usdx *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Claim(&_USDX.TransactOpts, _usdx)
+}
+
+// CollectAppreciation is a paid mutator transaction binding the contract method 0x1a254f12.
+//
+// Solidity: function collectAppreciation(uint256 _limit) returns(uint256)
+func (_USDX *USDXTransactor) CollectAppreciation(opts *bind.TransactOpts, _limit *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "collectAppreciation", _limit)
+}
+
+// CollectAppreciation is a paid mutator transaction binding the contract method 0x1a254f12.
+//
+// Solidity: function collectAppreciation(uint256 _limit) returns(uint256)
+func (_USDX *USDXSession) CollectAppreciation(_limit *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.CollectAppreciation(&_USDX.TransactOpts, _limit)
+}
+
+// CollectAppreciation is a paid mutator transaction binding the contract method 0x1a254f12.
+//
+// Solidity: function collectAppreciation(uint256 _limit) returns(uint256)
+func (_USDX *USDXTransactorSession) CollectAppreciation(_limit *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.CollectAppreciation(&_USDX.TransactOpts, _limit)
+}
+
+// CollectAppreciationFor is a paid mutator transaction binding the contract method 0x92cfd7de.
+//
+// Solidity: function collectAppreciationFor(address _owner, uint256 _limit) returns(uint256)
+func (_USDX *USDXTransactor) CollectAppreciationFor(opts *bind.TransactOpts, _owner common.Address, _limit *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "collectAppreciationFor", _owner, _limit)
+}
+
+// CollectAppreciationFor is a paid mutator transaction binding the contract method 0x92cfd7de.
+//
+// Solidity: function collectAppreciationFor(address _owner, uint256 _limit) returns(uint256)
+func (_USDX *USDXSession) CollectAppreciationFor(_owner common.Address, _limit *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.CollectAppreciationFor(&_USDX.TransactOpts, _owner, _limit)
+}
+
+// CollectAppreciationFor is a paid mutator transaction binding the contract method 0x92cfd7de.
+//
+// Solidity: function collectAppreciationFor(address _owner, uint256 _limit) returns(uint256)
+func (_USDX *USDXTransactorSession) CollectAppreciationFor(_owner common.Address, _limit *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.CollectAppreciationFor(&_USDX.TransactOpts, _owner, _limit)
+}
+
+// CollectLotAppreciation is a paid mutator transaction binding the contract method 0xb14ef502.
+//
+// Solidity: function collectLotAppreciation(uint256 _id, uint256 _limit) returns(uint256)
+func (_USDX *USDXTransactor) CollectLotAppreciation(opts *bind.TransactOpts, _id *big.Int, _limit *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "collectLotAppreciation", _id, _limit)
+}
+
+// CollectLotAppreciation is a paid mutator transaction binding the contract method 0xb14ef502.
+//
+// Solidity: function collectLotAppreciation(uint256 _id, uint256 _limit) returns(uint256)
+func (_USDX *USDXSession) CollectLotAppreciation(_id *big.Int, _limit *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.CollectLotAppreciation(&_USDX.TransactOpts, _id, _limit)
+}
+
+// CollectLotAppreciation is a paid mutator transaction binding the contract method 0xb14ef502.
+//
+// Solidity: function collectLotAppreciation(uint256 _id, uint256 _limit) returns(uint256)
+func (_USDX *USDXTransactorSession) CollectLotAppreciation(_id *big.Int, _limit *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.CollectLotAppreciation(&_USDX.TransactOpts, _id, _limit)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_USDX *USDXTransactor) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "decreaseAllowance", spender, subtractedValue)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_USDX *USDXSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.DecreaseAllowance(&_USDX.TransactOpts, spender, subtractedValue)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_USDX *USDXTransactorSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.DecreaseAllowance(&_USDX.TransactOpts, spender, subtractedValue)
+}
+
+// ExecuteOrder is a paid mutator transaction binding the contract method 0x94f61134.
+//
+// Solidity: function executeOrder(uint256 _id) returns(uint256)
+func (_USDX *USDXTransactor) ExecuteOrder(opts *bind.TransactOpts, _id *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "executeOrder", _id)
+}
+
+// ExecuteOrder is a paid mutator transaction binding the contract method 0x94f61134.
+//
+// Solidity: function executeOrder(uint256 _id) returns(uint256)
+func (_USDX *USDXSession) ExecuteOrder(_id *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.ExecuteOrder(&_USDX.TransactOpts, _id)
+}
+
+// ExecuteOrder is a paid mutator transaction binding the contract method 0x94f61134.
+//
+// Solidity: function executeOrder(uint256 _id) returns(uint256)
+func (_USDX *USDXTransactorSession) ExecuteOrder(_id *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.ExecuteOrder(&_USDX.TransactOpts, _id)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_USDX *USDXTransactor) IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "increaseAllowance", spender, addedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_USDX *USDXSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.IncreaseAllowance(&_USDX.TransactOpts, spender, addedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_USDX *USDXTransactorSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.IncreaseAllowance(&_USDX.TransactOpts, spender, addedValue)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x1b2ef1ca.
+//
+// Solidity: function mint(uint256 _minUSDX, uint256 _deadline) payable returns(uint256)
+func (_USDX *USDXTransactor) Mint(opts *bind.TransactOpts, _minUSDX *big.Int, _deadline *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "mint", _minUSDX, _deadline)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x1b2ef1ca.
+//
+// Solidity: function mint(uint256 _minUSDX, uint256 _deadline) payable returns(uint256)
+func (_USDX *USDXSession) Mint(_minUSDX *big.Int, _deadline *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Mint(&_USDX.TransactOpts, _minUSDX, _deadline)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x1b2ef1ca.
+//
+// Solidity: function mint(uint256 _minUSDX, uint256 _deadline) payable returns(uint256)
+func (_USDX *USDXTransactorSession) Mint(_minUSDX *big.Int, _deadline *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Mint(&_USDX.TransactOpts, _minUSDX, _deadline)
+}
+
+// MintFor is a paid mutator transaction binding the contract method 0x71e578dc.
+//
+// Solidity: function mintFor(address _beneficiary) payable returns(uint256)
+func (_USDX *USDXTransactor) MintFor(opts *bind.TransactOpts, _beneficiary common.Address) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "mintFor", _beneficiary)
+}
+
+// MintFor is a paid mutator transaction binding the contract method 0x71e578dc.
+//
+// Solidity: function mintFor(address _beneficiary) payable returns(uint256)
+func (_USDX *USDXSession) MintFor(_beneficiary common.Address) (*types.Transaction, error) {
+	return _USDX.Contract.MintFor(&_USDX.TransactOpts, _beneficiary)
+}
+
+// MintFor is a paid mutator transaction binding the contract method 0x71e578dc.
+//
+// Solidity: function mintFor(address _beneficiary) payable returns(uint256)
+func (_USDX *USDXTransactorSession) MintFor(_beneficiary common.Address) (*types.Transaction, error) {
+	return _USDX.Contract.MintFor(&_USDX.TransactOpts, _beneficiary)
+}
+
+// MintTo is a paid mutator transaction binding the contract method 0x2baf2acb.
+//
+// Solidity: function mintTo(address _to, uint256 _minUSDX, uint256 _deadline) payable returns(uint256)
+func (_USDX *USDXTransactor) MintTo(opts *bind.TransactOpts, _to common.Address, _minUSDX *big.Int, _deadline *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "mintTo", _to, _minUSDX, _deadline)
+}
+
+// MintTo is a paid mutator transaction binding the contract method 0x2baf2acb.
+//
+// Solidity: function mintTo(address _to, uint256 _minUSDX, uint256 _deadline) payable returns(uint256)
+func (_USDX *USDXSession) MintTo(_to common.Address, _minUSDX *big.Int, _deadline *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.MintTo(&_USDX.TransactOpts, _to, _minUSDX, _deadline)
+}
+
+// MintTo is a paid mutator transaction binding the contract method 0x2baf2acb.
+//
+// Solidity: function mintTo(address _to, uint256 _minUSDX, uint256 _deadline) payable returns(uint256)
+func (_USDX *USDXTransactorSession) MintTo(_to common.Address, _minUSDX *big.Int, _deadline *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.MintTo(&_USDX.TransactOpts, _to, _minUSDX, _deadline)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_USDX *USDXTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_USDX *USDXSession) Pause() (*types.Transaction, error) {
+	return _USDX.Contract.Pause(&_USDX.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_USDX *USDXTransactorSession) Pause() (*types.Transaction, error) {
+	return _USDX.Contract.Pause(&_USDX.TransactOpts)
+}
+
+// PlaceOrder is a paid mutator transaction binding the contract method 0xa8216ad4.
+//
+// Solidity: function placeOrder(uint8 _kind, int256 _price, uint256 _amount) returns(uint256)
+func (_USDX *USDXTransactor) PlaceOrder(opts *bind.TransactOpts, _kind uint8, _price *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "placeOrder", _kind, _price, _amount)
+}
+
+// PlaceOrder is a paid mutator transaction binding the contract method 0xa8216ad4.
+//
+// Solidity: function placeOrder(uint8 _kind, int256 _price, uint256 _amount) returns(uint256)
+func (_USDX *USDXSession) PlaceOrder(_kind uint8, _price *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.PlaceOrder(&_USDX.TransactOpts, _kind, _price, _amount)
+}
+
+// PlaceOrder is a paid mutator transaction binding the contract method 0xa8216ad4.
+//
+// Solidity: function placeOrder(uint8 _kind, int256 _price, uint256 _amount) returns(uint256)
+func (_USDX *USDXTransactorSession) PlaceOrder(_kind uint8, _price *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.PlaceOrder(&_USDX.TransactOpts, _kind, _price, _amount)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0x8fca761f.
+//
+// Solidity: function redeem(uint256 _usdx, uint256[] _ids, uint256 _minEth) returns(uint256)
+func (_USDX *USDXTransactor) Redeem(opts *bind.TransactOpts, _usdx *big.Int, _ids []*big.Int, _minEth *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "redeem", _usdx, _ids, _minEth)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0x8fca761f.
+//
+// Solidity: function redeem(uint256 _usdx, uint256[] _ids, uint256 _minEth) returns(uint256)
+func (_USDX *USDXSession) Redeem(_usdx *big.Int, _ids []*big.Int, _minEth *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Redeem(&_USDX.TransactOpts, _usdx, _ids, _minEth)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0x8fca761f.
+//
+// Solidity: function redeem(uint256 _usdx, uint256[] _ids, uint256 _minEth) returns(uint256)
+func (_USDX *USDXTransactorSession) Redeem(_usdx *big.Int, _ids []*big.Int, _minEth *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Redeem(&_USDX.TransactOpts, _usdx, _ids, _minEth)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_USDX *USDXTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_USDX *USDXSession) RenounceOwnership() (*types.Transaction, error) {
+	return _USDX.Contract.RenounceOwnership(&_USDX.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_USDX *USDXTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _USDX.Contract.RenounceOwnership(&_USDX.TransactOpts)
+}
+
+// SetCollector is a paid mutator transaction binding the contract method 0x7987d323.
+//
+// Solidity: function setCollector(address _collector, uint256 _threshold) returns()
+func (_USDX *USDXTransactor) SetCollector(opts *bind.TransactOpts, _collector common.Address, _threshold *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "setCollector", _collector, _threshold)
+}
+
+// SetCollector is a paid mutator transaction binding the contract method 0x7987d323.
+//
+// Solidity: function setCollector(address _collector, uint256 _threshold) returns()
+func (_USDX *USDXSession) SetCollector(_collector common.Address, _threshold *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.SetCollector(&_USDX.TransactOpts, _collector, _threshold)
+}
+
+// SetCollector is a paid mutator transaction binding the contract method 0x7987d323.
+//
+// Solidity: function setCollector(address _collector, uint256 _threshold) returns()
+func (_USDX *USDXTransactorSession) SetCollector(_collector common.Address, _threshold *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.SetCollector(&_USDX.TransactOpts, _collector, _threshold)
+}
+
+// SetFeed is a paid mutator transaction binding the contract method 0x55b775ea.
+//
+// Solidity: function setFeed(address _newFeed) returns()
+func (_USDX *USDXTransactor) SetFeed(opts *bind.TransactOpts, _newFeed common.Address) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "setFeed", _newFeed)
+}
+
+// SetFeed is a paid mutator transaction binding the contract method 0x55b775ea.
+//
+// Solidity: function setFeed(address _newFeed) returns()
+func (_USDX *USDXSession) SetFeed(_newFeed common.Address) (*types.Transaction, error) {
+	return _USDX.Contract.SetFeed(&_USDX.TransactOpts, _newFeed)
+}
+
+// SetFeed is a paid mutator transaction binding the contract method 0x55b775ea.
+//
+// Solidity: function setFeed(address _newFeed) returns()
+func (_USDX *USDXTransactorSession) SetFeed(_newFeed common.Address) (*types.Transaction, error) {
+	return _USDX.Contract.SetFeed(&_USDX.TransactOpts, _newFeed)
+}
+
+// SetGuardian is a paid mutator transaction binding the contract method 0x8a0dac4a.
+//
+// Solidity: function setGuardian(address _guardian) returns()
+func (_USDX *USDXTransactor) SetGuardian(opts *bind.TransactOpts, _guardian common.Address) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "setGuardian", _guardian)
+}
+
+// SetGuardian is a paid mutator transaction binding the contract method 0x8a0dac4a.
+//
+// Solidity: function setGuardian(address _guardian) returns()
+func (_USDX *USDXSession) SetGuardian(_guardian common.Address) (*types.Transaction, error) {
+	return _USDX.Contract.SetGuardian(&_USDX.TransactOpts, _guardian)
+}
+
+// SetGuardian is a paid mutator transaction binding the contract method 0x8a0dac4a.
+//
+// Solidity: function setGuardian(address _guardian) returns()
+func (_USDX *USDXTransactorSession) SetGuardian(_guardian common.Address) (*types.Transaction, error) {
+	return _USDX.Contract.SetGuardian(&_USDX.TransactOpts, _guardian)
+}
+
+// SetLotSender is a paid mutator transaction binding the contract method 0xfaa5732c.
+//
+// Solidity: function setLotSender(address _sender, bool _allowed) returns()
+func (_USDX *USDXTransactor) SetLotSender(opts *bind.TransactOpts, _sender common.Address, _allowed bool) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "setLotSender", _sender, _allowed)
+}
+
+// SetLotSender is a paid mutator transaction binding the contract method 0xfaa5732c.
+//
+// Solidity: function setLotSender(address _sender, bool _allowed) returns()
+func (_USDX *USDXSession) SetLotSender(_sender common.Address, _allowed bool) (*types.Transaction, error) {
+	return _USDX.Contract.SetLotSender(&_USDX.TransactOpts, _sender, _allowed)
+}
+
+// SetLotSender is a paid mutator transaction binding the contract method 0xfaa5732c.
+//
+// Solidity: function setLotSender(address _sender, bool _allowed) returns()
+func (_USDX *USDXTransactorSession) SetLotSender(_sender common.Address, _allowed bool) (*types.Transaction, error) {
+	return _USDX.Contract.SetLotSender(&_USDX.TransactOpts, _sender, _allowed)
+}
+
+// SetMintLimits is a paid mutator transaction binding the contract method 0x3cb5bc74.
+//
+// Solidity: function setMintLimits(uint256 _supplyCap, uint256 _mintLimit, uint256 _mintWindow) returns()
+func (_USDX *USDXTransactor) SetMintLimits(opts *bind.TransactOpts, _supplyCap *big.Int, _mintLimit *big.Int, _mintWindow *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "setMintLimits", _supplyCap, _mintLimit, _mintWindow)
+}
+
+// SetMintLimits is a paid mutator transaction binding the contract method 0x3cb5bc74.
+//
+// Solidity: function setMintLimits(uint256 _supplyCap, uint256 _mintLimit, uint256 _mintWindow) returns()
+func (_USDX *USDXSession) SetMintLimits(_supplyCap *big.Int, _mintLimit *big.Int, _mintWindow *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.SetMintLimits(&_USDX.TransactOpts, _supplyCap, _mintLimit, _mintWindow)
+}
+
+// SetMintLimits is a paid mutator transaction binding the contract method 0x3cb5bc74.
+//
+// Solidity: function setMintLimits(uint256 _supplyCap, uint256 _mintLimit, uint256 _mintWindow) returns()
+func (_USDX *USDXTransactorSession) SetMintLimits(_supplyCap *big.Int, _mintLimit *big.Int, _mintWindow *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.SetMintLimits(&_USDX.TransactOpts, _supplyCap, _mintLimit, _mintWindow)
+}
+
+// SetStalenessThreshold is a paid mutator transaction binding the contract method 0x0f3a72ce.
+//
+// Solidity: function setStalenessThreshold(uint80 _newThreshold) returns()
+func (_USDX *USDXTransactor) SetStalenessThreshold(opts *bind.TransactOpts, _newThreshold *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "setStalenessThreshold", _newThreshold)
+}
+
+// SetStalenessThreshold is a paid mutator transaction binding the contract method 0x0f3a72ce.
+//
+// Solidity: function setStalenessThreshold(uint80 _newThreshold) returns()
+func (_USDX *USDXSession) SetStalenessThreshold(_newThreshold *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.SetStalenessThreshold(&_USDX.TransactOpts, _newThreshold)
+}
+
+// SetStalenessThreshold is a paid mutator transaction binding the contract method 0x0f3a72ce.
+//
+// Solidity: function setStalenessThreshold(uint80 _newThreshold) returns()
+func (_USDX *USDXTransactorSession) SetStalenessThreshold(_newThreshold *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.SetStalenessThreshold(&_USDX.TransactOpts, _newThreshold)
+}
+
+// SettleLots is a paid mutator transaction binding the contract method 0x9b7df097.
+//
+// Solidity: function settleLots(uint256[] _ids) returns()
+func (_USDX *USDXTransactor) SettleLots(opts *bind.TransactOpts, _ids []*big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "settleLots", _ids)
+}
+
+// SettleLots is a paid mutator transaction binding the contract method 0x9b7df097.
+//
+// Solidity: function settleLots(uint256[] _ids) returns()
+func (_USDX *USDXSession) SettleLots(_ids []*big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.SettleLots(&_USDX.TransactOpts, _ids)
+}
+
+// SettleLots is a paid mutator transaction binding the contract method 0x9b7df097.
+//
+// Solidity: function settleLots(uint256[] _ids) returns()
+func (_USDX *USDXTransactorSession) SettleLots(_ids []*big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.SettleLots(&_USDX.TransactOpts, _ids)
+}
+
+// Shutdown is a paid mutator transaction binding the contract method 0x746b67ae.
+//
+// Solidity: function shutdown(int256 _price) returns()
+func (_USDX *USDXTransactor) Shutdown(opts *bind.TransactOpts, _price *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "shutdown", _price)
+}
+
+// Shutdown is a paid mutator transaction binding the contract method 0x746b67ae.
+//
+// Solidity: function shutdown(int256 _price) returns()
+func (_USDX *USDXSession) Shutdown(_price *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Shutdown(&_USDX.TransactOpts, _price)
+}
+
+// Shutdown is a paid mutator transaction binding the contract method 0x746b67ae.
+//
+// Solidity: function shutdown(int256 _price) returns()
+func (_USDX *USDXTransactorSession) Shutdown(_price *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Shutdown(&_USDX.TransactOpts, _price)
+}
+
+// ShutdownStale is a paid mutator transaction binding the contract method 0xfc8241bf.
+//
+// Solidity: function shutdownStale() returns()
+func (_USDX *USDXTransactor) ShutdownStale(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "shutdownStale")
+}
+
+// ShutdownStale is a paid mutator transaction binding the contract method 0xfc8241bf.
+//
+// Solidity: function shutdownStale() returns()
+func (_USDX *USDXSession) ShutdownStale() (*types.Transaction, error) {
+	return _USDX.Contract.ShutdownStale(&_USDX.TransactOpts)
+}
+
+// ShutdownStale is a paid mutator transaction binding the contract method 0xfc8241bf.
+//
+// Solidity: function shutdownStale() returns()
+func (_USDX *USDXTransactorSession) ShutdownStale() (*types.Transaction, error) {
+	return _USDX.Contract.ShutdownStale(&_USDX.TransactOpts)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
+func (_USDX *USDXTransactor) Transfer(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "transfer", recipient, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
+func (_USDX *USDXSession) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Transfer(&_USDX.TransactOpts, recipient, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
+func (_USDX *USDXTransactorSession) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Transfer(&_USDX.TransactOpts, recipient, amount)
+}
+
+// TransferAcct is a paid mutator transaction binding the contract method 0xac660479.
+//
+// Solidity: function transferAcct(address _to) returns()
+func (_USDX *USDXTransactor) TransferAcct(opts *bind.TransactOpts, _to common.Address) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "transferAcct", _to)
+}
+
+// TransferAcct is a paid mutator transaction binding the contract method 0xac660479.
+//
+// Solidity: function transferAcct(address _to) returns()
+func (_USDX *USDXSession) TransferAcct(_to common.Address) (*types.Transaction, error) {
+	return _USDX.Contract.TransferAcct(&_USDX.TransactOpts, _to)
+}
+
+// TransferAcct is a paid mutator transaction binding the contract method 0xac660479.
+//
+// Solidity: function transferAcct(address _to) returns()
+func (_USDX *USDXTransactorSession) TransferAcct(_to common.Address) (*types.Transaction, error) {
+	return _USDX.Contract.TransferAcct(&_USDX.TransactOpts, _to)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
+func (_USDX *USDXTransactor) TransferFrom(opts *bind.TransactOpts, sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "transferFrom", sender, recipient, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
+func (_USDX *USDXSession) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.TransferFrom(&_USDX.TransactOpts, sender, recipient, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
+func (_USDX *USDXTransactorSession) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.TransferFrom(&_USDX.TransactOpts, sender, recipient, amount)
+}
+
+// TransferLot is a paid mutator transaction binding the contract method 0x733c1be6.
+//
+// Solidity: function transferLot(address _from, address _to, uint256 _id) returns()
+func (_USDX *USDXTransactor) TransferLot(opts *bind.TransactOpts, _from common.Address, _to common.Address, _id *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "transferLot", _from, _to, _id)
+}
+
+// TransferLot is a paid mutator transaction binding the contract method 0x733c1be6.
+//
+// Solidity: function transferLot(address _from, address _to, uint256 _id) returns()
+func (_USDX *USDXSession) TransferLot(_from common.Address, _to common.Address, _id *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.TransferLot(&_USDX.TransactOpts, _from, _to, _id)
+}
+
+// TransferLot is a paid mutator transaction binding the contract method 0x733c1be6.
+//
+// Solidity: function transferLot(address _from, address _to, uint256 _id) returns()
+func (_USDX *USDXTransactorSession) TransferLot(_from common.Address, _to common.Address, _id *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.TransferLot(&_USDX.TransactOpts, _from, _to, _id)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_USDX *USDXTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_USDX *USDXSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _USDX.Contract.TransferOwnership(&_USDX.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_USDX *USDXTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _USDX.Contract.TransferOwnership(&_USDX.TransactOpts, newOwner)
+}
+
+// Unlock is a paid mutator transaction binding the contract method 0x6198e339.
+//
+// Solidity: function unlock(uint256 _usdx) returns(uint256)
+func (_USDX *USDXTransactor) Unlock(opts *bind.TransactOpts, _usdx *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "unlock", _usdx)
+}
+
+// Unlock is a paid mutator transaction binding the contract method 0x6198e339.
+//
+// Solidity: function unlock(uint256 _usdx) returns(uint256)
+func (_USDX *USDXSession) Unlock(_usdx *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Unlock(&_USDX.TransactOpts, _usdx)
+}
+
+// Unlock is a paid mutator transaction binding the contract method 0x6198e339.
+//
+// Solidity: function unlock(uint256 _usdx) returns(uint256)
+func (_USDX *USDXTransactorSession) Unlock(_usdx *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.Unlock(&_USDX.TransactOpts, _usdx)
+}
+
+// UnlockLot is a paid mutator transaction binding the contract method 0x8346864b.
+//
+// Solidity: function unlockLot(uint256 _id, uint256 _usdx) returns(uint256)
+func (_USDX *USDXTransactor) UnlockLot(opts *bind.TransactOpts, _id *big.Int, _usdx *big.Int) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "unlockLot", _id, _usdx)
+}
+
+// UnlockLot is a paid mutator transaction binding the contract method 0x8346864b.
+//
+// Solidity: function unlockLot(uint256 _id, uint256 _usdx) returns(uint256)
+func (_USDX *USDXSession) UnlockLot(_id *big.Int, _usdx *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.UnlockLot(&_USDX.TransactOpts, _id, _usdx)
+}
+
+// UnlockLot is a paid mutator transaction binding the contract method 0x8346864b.
+//
+// Solidity: function unlockLot(uint256 _id, uint256 _usdx) returns(uint256)
+func (_USDX *USDXTransactorSession) UnlockLot(_id *big.Int, _usdx *big.Int) (*types.Transaction, error) {
+	return _USDX.Contract.UnlockLot(&_USDX.TransactOpts, _id, _usdx)
+}
+
+// UnlockLots is a paid mutator transaction binding the contract method 0x65c8bb27.
+//
+// Solidity: function unlockLots(uint256 _usdx, uint8 _order) returns(uint256)
+func (_USDX *USDXTransactor) UnlockLots(opts *bind.TransactOpts, _usdx *big.Int, _order uint8) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "unlockLots", _usdx, _order)
+}
+
+// UnlockLots is a paid mutator transaction binding the contract method 0x65c8bb27.
+//
+// Solidity: function unlockLots(uint256 _usdx, uint8 _order) returns(uint256)
+func (_USDX *USDXSession) UnlockLots(_usdx *big.Int, _order uint8) (*types.Transaction, error) {
+	return _USDX.Contract.UnlockLots(&_USDX.TransactOpts, _usdx, _order)
+}
+
+// UnlockLots is a paid mutator transaction binding the contract method 0x65c8bb27.
+//
+// Solidity: function unlockLots(uint256 _usdx, uint8 _order) returns(uint256)
+func (_USDX *USDXTransactorSession) UnlockLots(_usdx *big.Int, _order uint8) (*types.Transaction, error) {
+	return _USDX.Contract.UnlockLots(&_USDX.TransactOpts, _usdx, _order)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_USDX *USDXTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_USDX *USDXSession) Unpause() (*types.Transaction, error) {
+	return _USDX.Contract.Unpause(&_USDX.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_USDX *USDXTransactorSession) Unpause() (*types.Transaction, error) {
+	return _USDX.Contract.Unpause(&_USDX.TransactOpts)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns(uint256)
+func (_USDX *USDXTransactor) Withdraw(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _USDX.contract.Transact(opts, "withdraw")
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns(uint256)
+func (_USDX *USDXSession) Withdraw() (*types.Transaction, error) {
+	return _USDX.Contract.Withdraw(&_USDX.TransactOpts)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns(uint256)
+func (_USDX *USDXTransactorSession) Withdraw() (*types.Transaction, error) {
+	return _USDX.Contract.Withdraw(&_USDX.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_USDX *USDXTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _USDX.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_USDX *USDXSession) Receive() (*types.Transaction, error) {
+	return _USDX.Contract.Receive(&_USDX.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_USDX *USDXTransactorSession) Receive() (*types.Transaction, error) {
+	return _USDX.Contract.Receive(&_USDX.TransactOpts)
+}
+
+// USDXApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the USDX contract.
+type USDXApprovalIterator struct {
+	Event *USDXApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXApproval represents a Approval event raised by the USDX contract.
+type USDXApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_USDX *USDXFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*USDXApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXApprovalIterator{contract: _USDX.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_USDX *USDXFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *USDXApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXApproval)
+				if err := _USDX.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_USDX *USDXFilterer) ParseApproval(log types.Log) (*USDXApproval, error) {
+	event := new(USDXApproval)
+	if err := _USDX.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXClaimedIterator is returned from FilterClaimed and is used to iterate over the raw logs and unpacked data for Claimed events raised by the USDX contract.
+type USDXClaimedIterator struct {
+	Event *USDXClaimed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXClaimedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXClaimedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXClaimed represents a Claimed event raised by the USDX contract.
+type USDXClaimed struct {
+	Holder common.Address
+	Usdx   *big.Int
+	Eth    *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterClaimed is a free log retrieval operation binding the contract event 0x987d620f307ff6b94d58743cb7a7509f24071586a77759b77c2d4e29f75a2f9a.
+//
+// Solidity: event Claimed(address indexed holder, uint256 usdx, uint256 eth)
+func (_USDX *USDXFilterer) FilterClaimed(opts *bind.FilterOpts, holder []common.Address) (*USDXClaimedIterator, error) {
+
+	var holderRule []interface{}
+	for _, holderItem := range holder {
+		holderRule = append(holderRule, holderItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "Claimed", holderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXClaimedIterator{contract: _USDX.contract, event: "Claimed", logs: logs, sub: sub}, nil
+}
+
+// WatchClaimed is a free log subscription operation binding the contract event 0x987d620f307ff6b94d58743cb7a7509f24071586a77759b77c2d4e29f75a2f9a.
+//
+// Solidity: event Claimed(address indexed holder, uint256 usdx, uint256 eth)
+func (_USDX *USDXFilterer) WatchClaimed(opts *bind.WatchOpts, sink chan<- *USDXClaimed, holder []common.Address) (event.Subscription, error) {
+
+	var holderRule []interface{}
+	for _, holderItem := range holder {
+		holderRule = append(holderRule, holderItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "Claimed", holderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXClaimed)
+				if err := _USDX.contract.UnpackLog(event, "Claimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseClaimed is a log parse operation binding the contract event 0x987d620f307ff6b94d58743cb7a7509f24071586a77759b77c2d4e29f75a2f9a.
+//
+// Solidity: event Claimed(address indexed holder, uint256 usdx, uint256 eth)
+func (_USDX *USDXFilterer) ParseClaimed(log types.Log) (*USDXClaimed, error) {
+	event := new(USDXClaimed)
+	if err := _USDX.contract.UnpackLog(event, "Claimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXCollectedIterator is returned from FilterCollected and is used to iterate over the raw logs and unpacked data for Collected events raised by the USDX contract.
+type USDXCollectedIterator struct {
+	Event *USDXCollected // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXCollectedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXCollected)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXCollected)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXCollectedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXCollectedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXCollected represents a Collected event raised by the USDX contract.
+type USDXCollected struct {
+	Owner common.Address
+	Usdx  *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterCollected is a free log retrieval operation binding the contract event 0x8c22f554c81b54107cd95e734e4ef45767214974a540f34ea4a4f8c3fc7b13c3.
+//
+// Solidity: event Collected(address indexed owner, uint256 usdx)
+func (_USDX *USDXFilterer) FilterCollected(opts *bind.FilterOpts, owner []common.Address) (*USDXCollectedIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "Collected", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXCollectedIterator{contract: _USDX.contract, event: "Collected", logs: logs, sub: sub}, nil
+}
+
+// WatchCollected is a free log subscription operation binding the contract event 0x8c22f554c81b54107cd95e734e4ef45767214974a540f34ea4a4f8c3fc7b13c3.
+//
+// Solidity: event Collected(address indexed owner, uint256 usdx)
+func (_USDX *USDXFilterer) WatchCollected(opts *bind.WatchOpts, sink chan<- *USDXCollected, owner []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "Collected", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXCollected)
+				if err := _USDX.contract.UnpackLog(event, "Collected", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCollected is a log parse operation binding the contract event 0x8c22f554c81b54107cd95e734e4ef45767214974a540f34ea4a4f8c3fc7b13c3.
+//
+// Solidity: event Collected(address indexed owner, uint256 usdx)
+func (_USDX *USDXFilterer) ParseCollected(log types.Log) (*USDXCollected, error) {
+	event := new(USDXCollected)
+	if err := _USDX.contract.UnpackLog(event, "Collected", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXCollectorSetIterator is returned from FilterCollectorSet and is used to iterate over the raw logs and unpacked data for CollectorSet events raised by the USDX contract.
+type USDXCollectorSetIterator struct {
+	Event *USDXCollectorSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXCollectorSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXCollectorSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXCollectorSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXCollectorSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXCollectorSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXCollectorSet represents a CollectorSet event raised by the USDX contract.
+type USDXCollectorSet struct {
+	Owner     common.Address
+	Collector common.Address
+	Threshold *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterCollectorSet is a free log retrieval operation binding the contract event 0x2ea20a90b817ea4d8a1495cb52b4991c9824b4b1684ff9338245eb1dfb48164e.
+//
+// Solidity: event CollectorSet(address indexed owner, address indexed collector, uint256 threshold)
+func (_USDX *USDXFilterer) FilterCollectorSet(opts *bind.FilterOpts, owner []common.Address, collector []common.Address) (*USDXCollectorSetIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var collectorRule []interface{}
+	for _, collectorItem := range collector {
+		collectorRule = append(collectorRule, collectorItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "CollectorSet", ownerRule, collectorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXCollectorSetIterator{contract: _USDX.contract, event: "CollectorSet", logs: logs, sub: sub}, nil
+}
+
+// WatchCollectorSet is a free log subscription operation binding the contract event 0x2ea20a90b817ea4d8a1495cb52b4991c9824b4b1684ff9338245eb1dfb48164e.
+//
+// Solidity: event CollectorSet(address indexed owner, address indexed collector, uint256 threshold)
+func (_USDX *USDXFilterer) WatchCollectorSet(opts *bind.WatchOpts, sink chan<- *USDXCollectorSet, owner []common.Address, collector []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var collectorRule []interface{}
+	for _, collectorItem := range collector {
+		collectorRule = append(collectorRule, collectorItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "CollectorSet", ownerRule, collectorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXCollectorSet)
+				if err := _USDX.contract.UnpackLog(event, "CollectorSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCollectorSet is a log parse operation binding the contract event 0x2ea20a90b817ea4d8a1495cb52b4991c9824b4b1684ff9338245eb1dfb48164e.
+//
+// Solidity: event CollectorSet(address indexed owner, address indexed collector, uint256 threshold)
+func (_USDX *USDXFilterer) ParseCollectorSet(log types.Log) (*USDXCollectorSet, error) {
+	event := new(USDXCollectorSet)
+	if err := _USDX.contract.UnpackLog(event, "CollectorSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXGuardianSetIterator is returned from FilterGuardianSet and is used to iterate over the raw logs and unpacked data for GuardianSet events raised by the USDX contract.
+type USDXGuardianSetIterator struct {
+	Event *USDXGuardianSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXGuardianSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXGuardianSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXGuardianSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXGuardianSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXGuardianSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXGuardianSet represents a GuardianSet event raised by the USDX contract.
+type USDXGuardianSet struct {
+	Guardian common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterGuardianSet is a free log retrieval operation binding the contract event 0xe6c09ffe4572dc9ceaa5ddde4ae41befa655d6fdfe8052077af0970f700e942e.
+//
+// Solidity: event GuardianSet(address indexed guardian)
+func (_USDX *USDXFilterer) FilterGuardianSet(opts *bind.FilterOpts, guardian []common.Address) (*USDXGuardianSetIterator, error) {
+
+	var guardianRule []interface{}
+	for _, guardianItem := range guardian {
+		guardianRule = append(guardianRule, guardianItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "GuardianSet", guardianRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXGuardianSetIterator{contract: _USDX.contract, event: "GuardianSet", logs: logs, sub: sub}, nil
+}
+
+// WatchGuardianSet is a free log subscription operation binding the contract event 0xe6c09ffe4572dc9ceaa5ddde4ae41befa655d6fdfe8052077af0970f700e942e.
+//
+// Solidity: event GuardianSet(address indexed guardian)
+func (_USDX *USDXFilterer) WatchGuardianSet(opts *bind.WatchOpts, sink chan<- *USDXGuardianSet, guardian []common.Address) (event.Subscription, error) {
+
+	var guardianRule []interface{}
+	for _, guardianItem := range guardian {
+		guardianRule = append(guardianRule, guardianItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "GuardianSet", guardianRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXGuardianSet)
+				if err := _USDX.contract.UnpackLog(event, "GuardianSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseGuardianSet is a log parse operation binding the contract event 0xe6c09ffe4572dc9ceaa5ddde4ae41befa655d6fdfe8052077af0970f700e942e.
+//
+// Solidity: event GuardianSet(address indexed guardian)
+func (_USDX *USDXFilterer) ParseGuardianSet(log types.Log) (*USDXGuardianSet, error) {
+	event := new(USDXGuardianSet)
+	if err := _USDX.contract.UnpackLog(event, "GuardianSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXLotSenderSetIterator is returned from FilterLotSenderSet and is used to iterate over the raw logs and unpacked data for LotSenderSet events raised by the USDX contract.
+type USDXLotSenderSetIterator struct {
+	Event *USDXLotSenderSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXLotSenderSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXLotSenderSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXLotSenderSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXLotSenderSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXLotSenderSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXLotSenderSet represents a LotSenderSet event raised by the USDX contract.
+type USDXLotSenderSet struct {
+	Owner   common.Address
+	Sender  common.Address
+	Allowed bool
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterLotSenderSet is a free log retrieval operation binding the contract event 0xf68cdd116472abb1ae46c94dee2f2f5259f5b1a57c1ca29ad7704cbc498d5e15.
+//
+// Solidity: event LotSenderSet(address indexed owner, address indexed sender, bool allowed)
+func (_USDX *USDXFilterer) FilterLotSenderSet(opts *bind.FilterOpts, owner []common.Address, sender []common.Address) (*USDXLotSenderSetIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "LotSenderSet", ownerRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXLotSenderSetIterator{contract: _USDX.contract, event: "LotSenderSet", logs: logs, sub: sub}, nil
+}
+
+// WatchLotSenderSet is a free log subscription operation binding the contract event 0xf68cdd116472abb1ae46c94dee2f2f5259f5b1a57c1ca29ad7704cbc498d5e15.
+//
+// Solidity: event LotSenderSet(address indexed owner, address indexed sender, bool allowed)
+func (_USDX *USDXFilterer) WatchLotSenderSet(opts *bind.WatchOpts, sink chan<- *USDXLotSenderSet, owner []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "LotSenderSet", ownerRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXLotSenderSet)
+				if err := _USDX.contract.UnpackLog(event, "LotSenderSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLotSenderSet is a log parse operation binding the contract event 0xf68cdd116472abb1ae46c94dee2f2f5259f5b1a57c1ca29ad7704cbc498d5e15.
+//
+// Solidity: event LotSenderSet(address indexed owner, address indexed sender, bool allowed)
+func (_USDX *USDXFilterer) ParseLotSenderSet(log types.Log) (*USDXLotSenderSet, error) {
+	event := new(USDXLotSenderSet)
+	if err := _USDX.contract.UnpackLog(event, "LotSenderSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXLotSettledIterator is returned from FilterLotSettled and is used to iterate over the raw logs and unpacked data for LotSettled events raised by the USDX contract.
+type USDXLotSettledIterator struct {
+	Event *USDXLotSettled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXLotSettledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXLotSettled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXLotSettled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXLotSettledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXLotSettledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXLotSettled represents a LotSettled event raised by the USDX contract.
+type USDXLotSettled struct {
+	Id      *big.Int
+	Owner   common.Address
+	Mint    *big.Int
+	Debt    *big.Int
+	Surplus *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterLotSettled is a free log retrieval operation binding the contract event 0x645f3cf2aee11f097fd4c980846c331f18d2036d6efd7415aec3527f8b9fd392.
+//
+// Solidity: event LotSettled(uint256 indexed id, address indexed owner, uint256 mint, uint256 debt, uint256 surplus)
+func (_USDX *USDXFilterer) FilterLotSettled(opts *bind.FilterOpts, id []*big.Int, owner []common.Address) (*USDXLotSettledIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "LotSettled", idRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXLotSettledIterator{contract: _USDX.contract, event: "LotSettled", logs: logs, sub: sub}, nil
+}
+
+// WatchLotSettled is a free log subscription operation binding the contract event 0x645f3cf2aee11f097fd4c980846c331f18d2036d6efd7415aec3527f8b9fd392.
+//
+// Solidity: event LotSettled(uint256 indexed id, address indexed owner, uint256 mint, uint256 debt, uint256 surplus)
+func (_USDX *USDXFilterer) WatchLotSettled(opts *bind.WatchOpts, sink chan<- *USDXLotSettled, id []*big.Int, owner []common.Address) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "LotSettled", idRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXLotSettled)
+				if err := _USDX.contract.UnpackLog(event, "LotSettled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLotSettled is a log parse operation binding the contract event 0x645f3cf2aee11f097fd4c980846c331f18d2036d6efd7415aec3527f8b9fd392.
+//
+// Solidity: event LotSettled(uint256 indexed id, address indexed owner, uint256 mint, uint256 debt, uint256 surplus)
+func (_USDX *USDXFilterer) ParseLotSettled(log types.Log) (*USDXLotSettled, error) {
+	event := new(USDXLotSettled)
+	if err := _USDX.contract.UnpackLog(event, "LotSettled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXLotTransferredIterator is returned from FilterLotTransferred and is used to iterate over the raw logs and unpacked data for LotTransferred events raised by the USDX contract.
+type USDXLotTransferredIterator struct {
+	Event *USDXLotTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXLotTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXLotTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXLotTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXLotTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXLotTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXLotTransferred represents a LotTransferred event raised by the USDX contract.
+type USDXLotTransferred struct {
+	From   common.Address
+	To     common.Address
+	Id     *big.Int
+	Locked *big.Int
+	Mint   *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterLotTransferred is a free log retrieval operation binding the contract event 0x30901e212b278fc3f83f7e6e7f0d84b5b0998d5d2a630c6373f7ca8861631a27.
+//
+// Solidity: event LotTransferred(address indexed from, address indexed to, uint256 indexed id, uint256 locked, uint256 mint)
+func (_USDX *USDXFilterer) FilterLotTransferred(opts *bind.FilterOpts, from []common.Address, to []common.Address, id []*big.Int) (*USDXLotTransferredIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "LotTransferred", fromRule, toRule, idRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXLotTransferredIterator{contract: _USDX.contract, event: "LotTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchLotTransferred is a free log subscription operation binding the contract event 0x30901e212b278fc3f83f7e6e7f0d84b5b0998d5d2a630c6373f7ca8861631a27.
+//
+// Solidity: event LotTransferred(address indexed from, address indexed to, uint256 indexed id, uint256 locked, uint256 mint)
+func (_USDX *USDXFilterer) WatchLotTransferred(opts *bind.WatchOpts, sink chan<- *USDXLotTransferred, from []common.Address, to []common.Address, id []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "LotTransferred", fromRule, toRule, idRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXLotTransferred)
+				if err := _USDX.contract.UnpackLog(event, "LotTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLotTransferred is a log parse operation binding the contract event 0x30901e212b278fc3f83f7e6e7f0d84b5b0998d5d2a630c6373f7ca8861631a27.
+//
+// Solidity: event LotTransferred(address indexed from, address indexed to, uint256 indexed id, uint256 locked, uint256 mint)
+func (_USDX *USDXFilterer) ParseLotTransferred(log types.Log) (*USDXLotTransferred, error) {
+	event := new(USDXLotTransferred)
+	if err := _USDX.contract.UnpackLog(event, "LotTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXMintLimitsSetIterator is returned from FilterMintLimitsSet and is used to iterate over the raw logs and unpacked data for MintLimitsSet events raised by the USDX contract.
+type USDXMintLimitsSetIterator struct {
+	Event *USDXMintLimitsSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXMintLimitsSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXMintLimitsSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXMintLimitsSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXMintLimitsSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXMintLimitsSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXMintLimitsSet represents a MintLimitsSet event raised by the USDX contract.
+type USDXMintLimitsSet struct {
+	SupplyCap  *big.Int
+	MintLimit  *big.Int
+	MintWindow *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterMintLimitsSet is a free log retrieval operation binding the contract event 0xbe5ec496c1a4c7b25eeffe11e78841855103bedc6db9add24216bb919c12f51c.
+//
+// Solidity: event MintLimitsSet(uint256 supplyCap, uint256 mintLimit, uint256 mintWindow)
+func (_USDX *USDXFilterer) FilterMintLimitsSet(opts *bind.FilterOpts) (*USDXMintLimitsSetIterator, error) {
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "MintLimitsSet")
+	if err != nil {
+		return nil, err
+	}
+	return &USDXMintLimitsSetIterator{contract: _USDX.contract, event: "MintLimitsSet", logs: logs, sub: sub}, nil
+}
+
+// WatchMintLimitsSet is a free log subscription operation binding the contract event 0xbe5ec496c1a4c7b25eeffe11e78841855103bedc6db9add24216bb919c12f51c.
+//
+// Solidity: event MintLimitsSet(uint256 supplyCap, uint256 mintLimit, uint256 mintWindow)
+func (_USDX *USDXFilterer) WatchMintLimitsSet(opts *bind.WatchOpts, sink chan<- *USDXMintLimitsSet) (event.Subscription, error) {
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "MintLimitsSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXMintLimitsSet)
+				if err := _USDX.contract.UnpackLog(event, "MintLimitsSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMintLimitsSet is a log parse operation binding the contract event 0xbe5ec496c1a4c7b25eeffe11e78841855103bedc6db9add24216bb919c12f51c.
+//
+// Solidity: event MintLimitsSet(uint256 supplyCap, uint256 mintLimit, uint256 mintWindow)
+func (_USDX *USDXFilterer) ParseMintLimitsSet(log types.Log) (*USDXMintLimitsSet, error) {
+	event := new(USDXMintLimitsSet)
+	if err := _USDX.contract.UnpackLog(event, "MintLimitsSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXOrderCancelledIterator is returned from FilterOrderCancelled and is used to iterate over the raw logs and unpacked data for OrderCancelled events raised by the USDX contract.
+type USDXOrderCancelledIterator struct {
+	Event *USDXOrderCancelled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXOrderCancelledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXOrderCancelled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXOrderCancelled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXOrderCancelledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXOrderCancelledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXOrderCancelled represents a OrderCancelled event raised by the USDX contract.
+type USDXOrderCancelled struct {
+	Id  *big.Int
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterOrderCancelled is a free log retrieval operation binding the contract event 0x61b9399f2f0f32ca39ce8d7be32caed5ec22fe07a6daba3a467ed479ec606582.
+//
+// Solidity: event OrderCancelled(uint256 indexed id)
+func (_USDX *USDXFilterer) FilterOrderCancelled(opts *bind.FilterOpts, id []*big.Int) (*USDXOrderCancelledIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "OrderCancelled", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXOrderCancelledIterator{contract: _USDX.contract, event: "OrderCancelled", logs: logs, sub: sub}, nil
+}
+
+// WatchOrderCancelled is a free log subscription operation binding the contract event 0x61b9399f2f0f32ca39ce8d7be32caed5ec22fe07a6daba3a467ed479ec606582.
+//
+// Solidity: event OrderCancelled(uint256 indexed id)
+func (_USDX *USDXFilterer) WatchOrderCancelled(opts *bind.WatchOpts, sink chan<- *USDXOrderCancelled, id []*big.Int) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "OrderCancelled", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXOrderCancelled)
+				if err := _USDX.contract.UnpackLog(event, "OrderCancelled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOrderCancelled is a log parse operation binding the contract event 0x61b9399f2f0f32ca39ce8d7be32caed5ec22fe07a6daba3a467ed479ec606582.
+//
+// Solidity: event OrderCancelled(uint256 indexed id)
+func (_USDX *USDXFilterer) ParseOrderCancelled(log types.Log) (*USDXOrderCancelled, error) {
+	event := new(USDXOrderCancelled)
+	if err := _USDX.contract.UnpackLog(event, "OrderCancelled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXOrderExecutedIterator is returned from FilterOrderExecuted and is used to iterate over the raw logs and unpacked data for OrderExecuted events raised by the USDX contract.
+type USDXOrderExecutedIterator struct {
+	Event *USDXOrderExecuted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXOrderExecutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXOrderExecuted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXOrderExecuted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXOrderExecutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXOrderExecutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXOrderExecuted represents a OrderExecuted event raised by the USDX contract.
+type USDXOrderExecuted struct {
+	Id       *big.Int
+	Executor common.Address
+	Amount   *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterOrderExecuted is a free log retrieval operation binding the contract event 0x9b32d7714729bdcd899b9c5460b1ec55a813e10e7a51271f146949e4e19f7b99.
+//
+// Solidity: event OrderExecuted(uint256 indexed id, address indexed executor, uint256 amount)
+func (_USDX *USDXFilterer) FilterOrderExecuted(opts *bind.FilterOpts, id []*big.Int, executor []common.Address) (*USDXOrderExecutedIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var executorRule []interface{}
+	for _, executorItem := range executor {
+		executorRule = append(executorRule, executorItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "OrderExecuted", idRule, executorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXOrderExecutedIterator{contract: _USDX.contract, event: "OrderExecuted", logs: logs, sub: sub}, nil
+}
+
+// WatchOrderExecuted is a free log subscription operation binding the contract event 0x9b32d7714729bdcd899b9c5460b1ec55a813e10e7a51271f146949e4e19f7b99.
+//
+// Solidity: event OrderExecuted(uint256 indexed id, address indexed executor, uint256 amount)
+func (_USDX *USDXFilterer) WatchOrderExecuted(opts *bind.WatchOpts, sink chan<- *USDXOrderExecuted, id []*big.Int, executor []common.Address) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var executorRule []interface{}
+	for _, executorItem := range executor {
+		executorRule = append(executorRule, executorItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "OrderExecuted", idRule, executorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXOrderExecuted)
+				if err := _USDX.contract.UnpackLog(event, "OrderExecuted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOrderExecuted is a log parse operation binding the contract event 0x9b32d7714729bdcd899b9c5460b1ec55a813e10e7a51271f146949e4e19f7b99.
+//
+// Solidity: event OrderExecuted(uint256 indexed id, address indexed executor, uint256 amount)
+func (_USDX *USDXFilterer) ParseOrderExecuted(log types.Log) (*USDXOrderExecuted, error) {
+	event := new(USDXOrderExecuted)
+	if err := _USDX.contract.UnpackLog(event, "OrderExecuted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXOrderPlacedIterator is returned from FilterOrderPlaced and is used to iterate over the raw logs and unpacked data for OrderPlaced events raised by the USDX contract.
+type USDXOrderPlacedIterator struct {
+	Event *USDXOrderPlaced // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXOrderPlacedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXOrderPlaced)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXOrderPlaced)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXOrderPlacedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXOrderPlacedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXOrderPlaced represents a OrderPlaced event raised by the USDX contract.
+type USDXOrderPlaced struct {
+	Id     *big.Int
+	Owner  common.Address
+	Kind   uint8
+	Price  *big.Int
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterOrderPlaced is a free log retrieval operation binding the contract event 0x4a072b3550ddd3fc77f61b059b04f0ab0be382805b9454e720e6aceac4d5ccc4.
+//
+// Solidity: event OrderPlaced(uint256 indexed id, address indexed owner, uint8 kind, int256 price, uint256 amount)
+func (_USDX *USDXFilterer) FilterOrderPlaced(opts *bind.FilterOpts, id []*big.Int, owner []common.Address) (*USDXOrderPlacedIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "OrderPlaced", idRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXOrderPlacedIterator{contract: _USDX.contract, event: "OrderPlaced", logs: logs, sub: sub}, nil
+}
+
+// WatchOrderPlaced is a free log subscription operation binding the contract event 0x4a072b3550ddd3fc77f61b059b04f0ab0be382805b9454e720e6aceac4d5ccc4.
+//
+// Solidity: event OrderPlaced(uint256 indexed id, address indexed owner, uint8 kind, int256 price, uint256 amount)
+func (_USDX *USDXFilterer) WatchOrderPlaced(opts *bind.WatchOpts, sink chan<- *USDXOrderPlaced, id []*big.Int, owner []common.Address) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "OrderPlaced", idRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXOrderPlaced)
+				if err := _USDX.contract.UnpackLog(event, "OrderPlaced", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOrderPlaced is a log parse operation binding the contract event 0x4a072b3550ddd3fc77f61b059b04f0ab0be382805b9454e720e6aceac4d5ccc4.
+//
+// Solidity: event OrderPlaced(uint256 indexed id, address indexed owner, uint8 kind, int256 price, uint256 amount)
+func (_USDX *USDXFilterer) ParseOrderPlaced(log types.Log) (*USDXOrderPlaced, error) {
+	event := new(USDXOrderPlaced)
+	if err := _USDX.contract.UnpackLog(event, "OrderPlaced", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the USDX contract.
+type USDXOwnershipTransferredIterator struct {
+	Event *USDXOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXOwnershipTransferred represents a OwnershipTransferred event raised by the USDX contract.
+type USDXOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_USDX *USDXFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*USDXOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXOwnershipTransferredIterator{contract: _USDX.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_USDX *USDXFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *USDXOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXOwnershipTransferred)
+				if err := _USDX.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_USDX *USDXFilterer) ParseOwnershipTransferred(log types.Log) (*USDXOwnershipTransferred, error) {
+	event := new(USDXOwnershipTransferred)
+	if err := _USDX.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the USDX contract.
+type USDXPausedIterator struct {
+	Event *USDXPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXPaused represents a Paused event raised by the USDX contract.
+type USDXPaused struct {
+	Guardian common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address indexed guardian)
+func (_USDX *USDXFilterer) FilterPaused(opts *bind.FilterOpts, guardian []common.Address) (*USDXPausedIterator, error) {
+
+	var guardianRule []interface{}
+	for _, guardianItem := range guardian {
+		guardianRule = append(guardianRule, guardianItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "Paused", guardianRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXPausedIterator{contract: _USDX.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address indexed guardian)
+func (_USDX *USDXFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *USDXPaused, guardian []common.Address) (event.Subscription, error) {
+
+	var guardianRule []interface{}
+	for _, guardianItem := range guardian {
+		guardianRule = append(guardianRule, guardianItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "Paused", guardianRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXPaused)
+				if err := _USDX.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address indexed guardian)
+func (_USDX *USDXFilterer) ParsePaused(log types.Log) (*USDXPaused, error) {
+	event := new(USDXPaused)
+	if err := _USDX.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXRedeemedIterator is returned from FilterRedeemed and is used to iterate over the raw logs and unpacked data for Redeemed events raised by the USDX contract.
+type USDXRedeemedIterator struct {
+	Event *USDXRedeemed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXRedeemedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXRedeemed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXRedeemed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXRedeemedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXRedeemedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXRedeemed represents a Redeemed event raised by the USDX contract.
+type USDXRedeemed struct {
+	Redeemer common.Address
+	Owner    common.Address
+	Lot      *big.Int
+	Usdx     *big.Int
+	Eth      *big.Int
+	Surplus  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterRedeemed is a free log retrieval operation binding the contract event 0x9098b5d2df18e362430218b79a4098cb0081120ca9fa44e94891dcbefe5204de.
+//
+// Solidity: event Redeemed(address indexed redeemer, address indexed owner, uint256 indexed lot, uint256 usdx, uint256 eth, uint256 surplus)
+func (_USDX *USDXFilterer) FilterRedeemed(opts *bind.FilterOpts, redeemer []common.Address, owner []common.Address, lot []*big.Int) (*USDXRedeemedIterator, error) {
+
+	var redeemerRule []interface{}
+	for _, redeemerItem := range redeemer {
+		redeemerRule = append(redeemerRule, redeemerItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var lotRule []interface{}
+	for _, lotItem := range lot {
+		lotRule = append(lotRule, lotItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "Redeemed", redeemerRule, ownerRule, lotRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXRedeemedIterator{contract: _USDX.contract, event: "Redeemed", logs: logs, sub: sub}, nil
+}
+
+// WatchRedeemed is a free log subscription operation binding the contract event 0x9098b5d2df18e362430218b79a4098cb0081120ca9fa44e94891dcbefe5204de.
+//
+// Solidity: event Redeemed(address indexed redeemer, address indexed owner, uint256 indexed lot, uint256 usdx, uint256 eth, uint256 surplus)
+func (_USDX *USDXFilterer) WatchRedeemed(opts *bind.WatchOpts, sink chan<- *USDXRedeemed, redeemer []common.Address, owner []common.Address, lot []*big.Int) (event.Subscription, error) {
+
+	var redeemerRule []interface{}
+	for _, redeemerItem := range redeemer {
+		redeemerRule = append(redeemerRule, redeemerItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var lotRule []interface{}
+	for _, lotItem := range lot {
+		lotRule = append(lotRule, lotItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "Redeemed", redeemerRule, ownerRule, lotRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXRedeemed)
+				if err := _USDX.contract.UnpackLog(event, "Redeemed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRedeemed is a log parse operation binding the contract event 0x9098b5d2df18e362430218b79a4098cb0081120ca9fa44e94891dcbefe5204de.
+//
+// Solidity: event Redeemed(address indexed redeemer, address indexed owner, uint256 indexed lot, uint256 usdx, uint256 eth, uint256 surplus)
+func (_USDX *USDXFilterer) ParseRedeemed(log types.Log) (*USDXRedeemed, error) {
+	event := new(USDXRedeemed)
+	if err := _USDX.contract.UnpackLog(event, "Redeemed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXShutdownIterator is returned from FilterShutdown and is used to iterate over the raw logs and unpacked data for Shutdown events raised by the USDX contract.
+type USDXShutdownIterator struct {
+	Event *USDXShutdown // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXShutdownIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXShutdown)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXShutdown)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXShutdownIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXShutdownIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXShutdown represents a Shutdown event raised by the USDX contract.
+type USDXShutdown struct {
+	Price *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterShutdown is a free log retrieval operation binding the contract event 0x673fcc005ab668ae0e90521153ad2593ebe24b0ab294c92ef8fa229c8fdd146f.
+//
+// Solidity: event Shutdown(int256 price)
+func (_USDX *USDXFilterer) FilterShutdown(opts *bind.FilterOpts) (*USDXShutdownIterator, error) {
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "Shutdown")
+	if err != nil {
+		return nil, err
+	}
+	return &USDXShutdownIterator{contract: _USDX.contract, event: "Shutdown", logs: logs, sub: sub}, nil
+}
+
+// WatchShutdown is a free log subscription operation binding the contract event 0x673fcc005ab668ae0e90521153ad2593ebe24b0ab294c92ef8fa229c8fdd146f.
+//
+// Solidity: event Shutdown(int256 price)
+func (_USDX *USDXFilterer) WatchShutdown(opts *bind.WatchOpts, sink chan<- *USDXShutdown) (event.Subscription, error) {
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "Shutdown")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXShutdown)
+				if err := _USDX.contract.UnpackLog(event, "Shutdown", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseShutdown is a log parse operation binding the contract event 0x673fcc005ab668ae0e90521153ad2593ebe24b0ab294c92ef8fa229c8fdd146f.
+//
+// Solidity: event Shutdown(int256 price)
+func (_USDX *USDXFilterer) ParseShutdown(log types.Log) (*USDXShutdown, error) {
+	event := new(USDXShutdown)
+	if err := _USDX.contract.UnpackLog(event, "Shutdown", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// USDXTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the USDX contract.
+type USDXTransferIterator struct {
+	Event *USDXTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// USDXTransfer represents a Transfer event raised by the USDX contract.
+type USDXTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_USDX *USDXFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*USDXTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXTransferIterator{contract: _USDX.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_USDX *USDXFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *USDXTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXTransfer)
+				if err := _USDX.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_USDX *USDXFilterer) ParseTransfer(log types.Log) (*USDXTransfer, error) {
+	event := new(USDXTransfer)
+	if err := _USDX.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
 }
 
-// USDXTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
-// with pre-set transact options.
-type USDXTransactorSession struct {
-	Contract     *USDXTransactor   // Generic contract transactor binding to set the session for
-	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+// USDXUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the USDX contract.
+type USDXUnpausedIterator struct {
+	Event *USDXUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
 }
 
-// USDXRaw is an auto generated low-level Go binding around an Ethereum contract.
-type USDXRaw struct {
-	Contract *USDX // Generic contract binding to access the raw methods on
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *USDXUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(USDXUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(USDXUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
 }
 
-// USDXCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
-type USDXCallerRaw struct {
-	Contract *USDXCaller // Generic read-only contract binding to access the raw methods on
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *USDXUnpausedIterator) Error() error {
+	return it.fail
 }
 
-// USDXTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
-type USDXTransactorRaw struct {
-	Contract *USDXTransactor // Generic write-only contract binding to access the raw methods on
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *USDXUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
 }
 
-// NewUSDX creates a new instance of USDX, bound to a specific deployed contract.
-func NewUSDX(address common.Address, backend bind.ContractBackend) (*USDX, error) {
-	contract, err := bindUSDX(address, backend, backend, backend)
+// USDXUnpaused represents a Unpaused event raised by the USDX contract.
+type USDXUnpaused struct {
+	Guardian common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address indexed guardian)
+func (_USDX *USDXFilterer) FilterUnpaused(opts *bind.FilterOpts, guardian []common.Address) (*USDXUnpausedIterator, error) {
+
+	var guardianRule []interface{}
+	for _, guardianItem := range guardian {
+		guardianRule = append(guardianRule, guardianItem)
+	}
+
+	logs, sub, err := _USDX.contract.FilterLogs(opts, "Unpaused", guardianRule)
 	if err != nil {
 		return nil, err
 	}
-	return &USDX{USDXCaller: USDXCaller{contract: contract}, USDXTransactor: USDXTransactor{contract: contract}, USDXFilterer: USDXFilterer{contract: contract}}, nil
+	return &USDXUnpausedIterator{contract: _USDX.contract, event: "Unpaused", logs: logs, sub: sub}, nil
 }
 
-// NewUSDXCaller creates a new read-only instance of USDX, bound to a specific deployed contract.
-func NewUSDXCaller(address common.Address, caller bind.ContractCaller) (*USDXCaller, error) {
-	contract, err := bindUSDX(address, caller, nil, nil)
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address indexed guardian)
+func (_USDX *USDXFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *USDXUnpaused, guardian []common.Address) (event.Subscription, error) {
+
+	var guardianRule []interface{}
+	for _, guardianItem := range guardian {
+		guardianRule = append(guardianRule, guardianItem)
+	}
+
+	logs, sub, err := _USDX.contract.WatchLogs(opts, "Unpaused", guardianRule)
 	if err != nil {
 		return nil, err
 	}
-	return &USDXCaller{contract: contract}, nil
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(USDXUnpaused)
+				if err := _USDX.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
 }
 
-// NewUSDXTransactor creates a new write-only instance of USDX, bound to a specific deployed contract.
-func NewUSDXTransactor(address common.Address, transactor bind.ContractTransactor) (*USDXTransactor, error) {
-	contract, err := bindUSDX(address, nil, transactor, nil)
-	if err != nil {
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address indexed guardian)
+func (_USDX *USDXFilterer) ParseUnpaused(log types.Log) (*USDXUnpaused, error) {
+	event := new(USDXUnpaused)
+	if err := _USDX.contract.UnpackLog(event, "Unpaused", log); err != nil {
 		return nil, err
 	}
-	return &USDXTransactor{contract: contract}, nil
+	event.Raw = log
+	return event, nil
 }
 
-// NewUSDXFilterer creates a new log filterer instance of USDX, bound to a specific deployed contract.
-func NewUSDXFilterer(address common.Address, filterer bind.ContractFilterer) (*USDXFilterer, error) {
-	contract, err := bindUSDX(address, nil, nil, filterer)
+// USDXPositionsABI is the input ABI used to generate the binding from.
+const USDXPositionsABI = "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"move\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"usdx\",\"outputs\":[{\"internalType\":\"contractUSDX\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// USDXPositionsFuncSigs maps the 4-byte function signature to its string representation.
+var USDXPositionsFuncSigs = map[string]string{
+	"095ea7b3": "approve(address,uint256)",
+	"70a08231": "balanceOf(address)",
+	"42966c68": "burn(uint256)",
+	"081812fc": "getApproved(uint256)",
+	"e985e9c5": "isApprovedForAll(address,address)",
+	"40c10f19": "mint(address,uint256)",
+	"bb35783b": "move(address,address,uint256)",
+	"06fdde03": "name()",
+	"6352211e": "ownerOf(uint256)",
+	"42842e0e": "safeTransferFrom(address,address,uint256)",
+	"b88d4fde": "safeTransferFrom(address,address,uint256,bytes)",
+	"a22cb465": "setApprovalForAll(address,bool)",
+	"01ffc9a7": "supportsInterface(bytes4)",
+	"95d89b41": "symbol()",
+	"c87b56dd": "tokenURI(uint256)",
+	"23b872dd": "transferFrom(address,address,uint256)",
+	"311176d7": "usdx()",
+}
+
+// USDXPositionsBin is the compiled bytecode used for deploying new contracts.
+var USDXPositionsBin = "0x60a06040523480156200001157600080fd5b506040518060400160405280600d81526020016c2aa9a22c102837b9b4ba34b7b760991b81525060405180604001604052806005815260200164055534458560dc1b815250816000908162000067919062000128565b50600162000076828262000128565b50503360805250620001f4565b634e487b7160e01b600052604160045260246000fd5b600181811c90821680620000ae57607f821691505b602082108103620000cf57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200012357600081815260208120601f850160051c81016020861015620000fe5750805b601f850160051c820191505b818110156200011f578281556001016200010a565b5050505b505050565b81516001600160401b0381111562000144576200014462000083565b6200015c8162000155845462000099565b84620000d5565b602080601f8311600181146200019457600084156200017b5750858301515b600019600386901b1c1916600185901b1785556200011f565b600085815260208120601f198616915b82811015620001c557888601518255948401946001909101908401620001a4565b5085821015620001e45787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60805161168262000233600039600081816101a50152818161056a015281816105db0152818161083801528181610faf015261100201526116826000f3fe608060405234801561001057600080fd5b506004361061010b5760003560e01c806342966c68116100a2578063a22cb46511610071578063a22cb4651461023c578063b88d4fde1461024f578063bb35783b14610262578063c87b56dd14610275578063e985e9c51461028857600080fd5b806342966c68146101ed5780636352211e1461020057806370a082311461021357806395d89b411461023457600080fd5b806323b872dd116100de57806323b872dd1461018d578063311176d7146101a057806340c10f19146101c757806342842e0e146101da57600080fd5b806301ffc9a71461011057806306fdde0314610138578063081812fc1461014d578063095ea7b314610178575b600080fd5b61012361011e36600461117a565b61029b565b60405190151581526020015b60405180910390f35b6101406102ed565b60405161012f91906111e7565b61016061015b3660046111fa565b61037f565b6040516001600160a01b03909116815260200161012f565b61018b61018636600461122f565b610419565b005b61018b61019b366004611259565b61052e565b6101607f000000000000000000000000000000000000000000000000000000000000000081565b61018b6101d536600461122f565b61055f565b61018b6101e8366004611259565b6105b5565b61018b6101fb3660046111fa565b6105d0565b61016061020e3660046111fa565b610624565b610226610221366004611295565b61069b565b60405190815260200161012f565b610140610722565b61018b61024a3660046112b0565b610731565b61018b61025d366004611302565b6107f5565b61018b610270366004611259565b61082d565b6101406102833660046111fa565b610875565b6101236102963660046113de565b61095d565b60006001600160e01b031982166380ac58cd60e01b14806102cc57506001600160e01b03198216635b5e139f60e01b145b806102e757506301ffc9a760e01b6001600160e01b03198316145b92915050565b6060600080546102fc90611411565b80601f016020809104026020016040519081016040528092919081815260200182805461032890611411565b80156103755780601f1061034a57610100808354040283529160200191610375565b820191906000526020600020905b81548152906001019060200180831161035857829003601f168201915b5050505050905090565b6000818152600260205260408120546001600160a01b03166103fd5760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a20617070726f76656420717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b60648201526084015b60405180910390fd5b506000908152600460205260409020546001600160a01b031690565b600061042482610624565b9050806001600160a01b0316836001600160a01b0316036104915760405162461bcd60e51b815260206004820152602160248201527f4552433732313a20617070726f76616c20746f2063757272656e74206f776e656044820152603960f91b60648201526084016103f4565b336001600160a01b03821614806104ad57506104ad813361095d565b61051f5760405162461bcd60e51b815260206004820152603860248201527f4552433732313a20617070726f76652063616c6c6572206973206e6f74206f7760448201527f6e6572206e6f7220617070726f76656420666f7220616c6c000000000000000060648201526084016103f4565b610529838361098b565b505050565b61053833826109f9565b6105545760405162461bcd60e51b81526004016103f49061144b565b610529838383610ad0565b336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146105a75760405162461bcd60e51b81526004016103f49061149c565b6105b18282610c7b565b5050565b610529838383604051806020016040528060008152506107f5565b336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146106185760405162461bcd60e51b81526004016103f49061149c565b61062181610dc9565b50565b6000818152600260205260408120546001600160a01b0316806102e75760405162461bcd60e51b815260206004820152602960248201527f4552433732313a206f776e657220717565727920666f72206e6f6e657869737460448201526832b73a103a37b5b2b760b91b60648201526084016103f4565b60006001600160a01b0382166107065760405162461bcd60e51b815260206004820152602a60248201527f4552433732313a2062616c616e636520717565727920666f7220746865207a65604482015269726f206164647265737360b01b60648201526084016103f4565b506001600160a01b031660009081526003602052604090205490565b6060600180546102fc90611411565b336001600160a01b038316036107895760405162461bcd60e51b815260206004820152601960248201527f4552433732313a20617070726f766520746f2063616c6c65720000000000000060448201526064016103f4565b3360008181526005602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915590519081529192917f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a35050565b6107ff33836109f9565b61081b5760405162461bcd60e51b81526004016103f49061144b565b61082784848484610e70565b50505050565b336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146105545760405162461bcd60e51b81526004016103f49061149c565b6000818152600260205260409020546060906001600160a01b03166108f45760405162461bcd60e51b815260206004820152602f60248201527f4552433732314d657461646174613a2055524920717565727920666f72206e6f60448201526e3732bc34b9ba32b73a103a37b5b2b760891b60648201526084016103f4565b600061090b60408051602081019091526000815290565b9050600081511161092b5760405180602001604052806000815250610956565b8061093584610ea3565b6040516020016109469291906114c8565b6040516020818303038152906040525b9392505050565b6001600160a01b03918216600090815260056020908152604080832093909416825291909152205460ff1690565b600081815260046020526040902080546001600160a01b0319166001600160a01b03841690811790915581906109c082610624565b6001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45050565b6000818152600260205260408120546001600160a01b0316610a725760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a206f70657261746f7220717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b60648201526084016103f4565b6000610a7d83610624565b9050806001600160a01b0316846001600160a01b03161480610ab85750836001600160a01b0316610aad8461037f565b6001600160a01b0316145b80610ac85750610ac8818561095d565b949350505050565b826001600160a01b0316610ae382610624565b6001600160a01b031614610b4b5760405162461bcd60e51b815260206004820152602960248201527f4552433732313a207472616e73666572206f6620746f6b656e2074686174206960448201526839903737ba1037bbb760b91b60648201526084016103f4565b6001600160a01b038216610bad5760405162461bcd60e51b8152602060048201526024808201527f4552433732313a207472616e7366657220746f20746865207a65726f206164646044820152637265737360e01b60648201526084016103f4565b610bb8838383610fa4565b610bc360008261098b565b6001600160a01b0383166000908152600360205260408120805460019290610bec90849061150d565b90915550506001600160a01b0382166000908152600360205260408120805460019290610c1a908490611520565b909155505060008181526002602052604080822080546001600160a01b0319166001600160a01b0386811691821790925591518493918716917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4505050565b6001600160a01b038216610cd15760405162461bcd60e51b815260206004820181905260248201527f4552433732313a206d696e7420746f20746865207a65726f206164647265737360448201526064016103f4565b6000818152600260205260409020546001600160a01b031615610d365760405162461bcd60e51b815260206004820152601c60248201527f4552433732313a20746f6b656e20616c7265616479206d696e7465640000000060448201526064016103f4565b610d4260008383610fa4565b6001600160a01b0382166000908152600360205260408120805460019290610d6b908490611520565b909155505060008181526002602052604080822080546001600160a01b0319166001600160a01b03861690811790915590518392907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef908290a45050565b6000610dd482610624565b9050610de281600084610fa4565b610ded60008361098b565b6001600160a01b0381166000908152600360205260408120805460019290610e1690849061150d565b909155505060008281526002602052604080822080546001600160a01b0319169055518391906001600160a01b038416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef908390a45050565b610e7b848484610ad0565b610e8784848484611063565b6108275760405162461bcd60e51b81526004016103f490611533565b606081600003610eca5750506040805180820190915260018152600360fc1b602082015290565b8160005b8115610ef45780610ede81611585565b9150610eed9050600a836115b4565b9150610ece565b60008167ffffffffffffffff811115610f0f57610f0f6112ec565b6040519080825280601f01601f191660200182016040528015610f39576020820181803683370190505b5090505b8415610ac857610f4e60018361150d565b9150610f5b600a866115c8565b610f66906030611520565b60f81b818381518110610f7b57610f7b6115dc565b60200101906001600160f81b031916908160001a905350610f9d600a866115b4565b9450610f3d565b336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146105295760405163399e0df360e11b81526001600160a01b0384811660048301528381166024830152604482018390527f0000000000000000000000000000000000000000000000000000000000000000169063733c1be690606401600060405180830381600087803b15801561104657600080fd5b505af115801561105a573d6000803e3d6000fd5b50505050505050565b60006001600160a01b0384163b1561115957604051630a85bd0160e11b81526001600160a01b0385169063150b7a02906110a79033908990889088906004016115f2565b6020604051808303816000875af19250505080156110e2575060408051601f3d908101601f191682019092526110df9181019061162f565b60015b61113f573d808015611110576040519150601f19603f3d011682016040523d82523d6000602084013e611115565b606091505b5080516000036111375760405162461bcd60e51b81526004016103f490611533565b805181602001fd5b6001600160e01b031916630a85bd0160e11b149050610ac8565b506001949350505050565b6001600160e01b03198116811461062157600080fd5b60006020828403121561118c57600080fd5b813561095681611164565b60005b838110156111b257818101518382015260200161119a565b50506000910152565b600081518084526111d3816020860160208601611197565b601f01601f19169290920160200192915050565b60208152600061095660208301846111bb565b60006020828403121561120c57600080fd5b5035919050565b80356001600160a01b038116811461122a57600080fd5b919050565b6000806040838503121561124257600080fd5b61124b83611213565b946020939093013593505050565b60008060006060848603121561126e57600080fd5b61127784611213565b925061128560208501611213565b9150604084013590509250925092565b6000602082840312156112a757600080fd5b61095682611213565b600080604083850312156112c357600080fd5b6112cc83611213565b9150602083013580151581146112e157600080fd5b809150509250929050565b634e487b7160e01b600052604160045260246000fd5b6000806000806080858703121561131857600080fd5b61132185611213565b935061132f60208601611213565b925060408501359150606085013567ffffffffffffffff8082111561135357600080fd5b818701915087601f83011261136757600080fd5b813581811115611379576113796112ec565b604051601f8201601f19908116603f011681019083821181831017156113a1576113a16112ec565b816040528281528a60208487010111156113ba57600080fd5b82602086016020830137600060208483010152809550505050505092959194509250565b600080604083850312156113f157600080fd5b6113fa83611213565b915061140860208401611213565b90509250929050565b600181811c9082168061142557607f821691505b60208210810361144557634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526031908201527f4552433732313a207472616e736665722063616c6c6572206973206e6f74206f6040820152701ddb995c881b9bdc88185c1c1c9bdd9959607a1b606082015260800190565b6020808252601290820152710c6c2d8d8cae440d2e640dcdee840eae6c8f60731b604082015260600190565b600083516114da818460208801611197565b8351908301906114ee818360208801611197565b01949350505050565b634e487b7160e01b600052601160045260246000fd5b818103818111156102e7576102e76114f7565b808201808211156102e7576102e76114f7565b60208082526032908201527f4552433732313a207472616e7366657220746f206e6f6e20455243373231526560408201527131b2b4bb32b91034b6b83632b6b2b73a32b960711b606082015260800190565b600060018201611597576115976114f7565b5060010190565b634e487b7160e01b600052601260045260246000fd5b6000826115c3576115c361159e565b500490565b6000826115d7576115d761159e565b500690565b634e487b7160e01b600052603260045260246000fd5b6001600160a01b0385811682528416602082015260408101839052608060608201819052600090611625908301846111bb565b9695505050505050565b60006020828403121561164157600080fd5b81516109568161116456fea2646970667358221220ea594754ad1673a55322dbc8802984097b691dc3153e9d47b6bb7177bc1a3c7064736f6c63430008150033"
+
+// DeployUSDXPositions deploys a new Ethereum contract, binding an instance of USDXPositions to it.
+func DeployUSDXPositions(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *USDXPositions, error) {
+	parsed, err := abi.JSON(strings.NewReader(USDXPositionsABI))
 	if err != nil {
-		return nil, err
+		return common.Address{}, nil, nil, err
 	}
-	return &USDXFilterer{contract: contract}, nil
-}
 
-// bindUSDX binds a generic wrapper to an already deployed contract.
-func bindUSDX(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
-	parsed, err := abi.JSON(strings.NewReader(USDXABI))
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(USDXPositionsBin), backend)
 	if err != nil {
-		return nil, err
+		return common.Address{}, nil, nil, err
 	}
-	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+	return address, tx, &USDXPositions{USDXPositionsCaller: USDXPositionsCaller{contract: contract}, USDXPositionsTransactor: USDXPositionsTransactor{contract: contract}, USDXPositionsFilterer: USDXPositionsFilterer{contract: contract}}, nil
 }
 
-// Call invokes the (constant) contract method with params as input values and
-// sets the output to result. The result type might be a single field for simple
-// returns, a slice of interfaces for anonymous returns and a struct for named
-// returns.
-func (_USDX *USDXRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _USDX.Contract.USDXCaller.contract.Call(opts, result, method, params...)
+// USDXPositions is an auto generated Go binding around an Ethereum contract.
+type USDXPositions struct {
+	USDXPositionsCaller     // Read-only binding to the contract
+	USDXPositionsTransactor // Write-only binding to the contract
+	USDXPositionsFilterer   // Log filterer for contract events
 }
 
-// Transfer initiates a plain transaction to move funds to the contract, calling
-// its default method if one is available.
-func (_USDX *USDXRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _USDX.Contract.USDXTransactor.contract.Transfer(opts)
+// USDXPositionsCaller is an auto generated read-only Go binding around an Ethereum contract.
+type USDXPositionsCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// Transact invokes the (paid) contract method with params as input values.
-func (_USDX *USDXRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _USDX.Contract.USDXTransactor.contract.Transact(opts, method, params...)
+// USDXPositionsTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type USDXPositionsTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// Call invokes the (constant) contract method with params as input values and
-// sets the output to result. The result type might be a single field for simple
-// returns, a slice of interfaces for anonymous returns and a struct for named
-// returns.
-func (_USDX *USDXCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _USDX.Contract.contract.Call(opts, result, method, params...)
+// USDXPositionsFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type USDXPositionsFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// Transfer initiates a plain transaction to move funds to the contract, calling
-// its default method if one is available.
-func (_USDX *USDXTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _USDX.Contract.contract.Transfer(opts)
+// USDXPositionsSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type USDXPositionsSession struct {
+	Contract     *USDXPositions    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
 }
 
-// Transact invokes the (paid) contract method with params as input values.
-func (_USDX *USDXTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _USDX.Contract.contract.Transact(opts, method, params...)
+// USDXPositionsCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type USDXPositionsCallerSession struct {
+	Contract *USDXPositionsCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
 }
 
-// Accounts is a free data retrieval call binding the contract method 0x5e5c06e2.
-//
-// Solidity: function accounts(address ) view returns(uint256 locked, uint256 mint)
-func (_USDX *USDXCaller) Accounts(opts *bind.CallOpts, arg0 common.Address) (struct {
-	Locked *big.Int
-	Mint   *big.Int
-}, error) {
-	var out []interface{}
-	err := _USDX.contract.Call(opts, &out, "accounts", arg0)
-
-	outstruct := new(struct {
-		Locked *big.Int
-		Mint   *big.Int
-	})
-	if err != nil {
-		return *outstruct, err
-	}
+// USDXPositionsTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type USDXPositionsTransactorSession struct {
+	Contract     *USDXPositionsTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
 
-	outstruct.Locked = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
-	outstruct.Mint = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+// USDXPositionsRaw is an auto generated low-level Go binding around an Ethereum contract.
+type USDXPositionsRaw struct {
+	Contract *USDXPositions // Generic contract binding to access the raw methods on
+}
 
-	return *outstruct, err
+// USDXPositionsCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type USDXPositionsCallerRaw struct {
+	Contract *USDXPositionsCaller // Generic read-only contract binding to access the raw methods on
+}
 
+// USDXPositionsTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type USDXPositionsTransactorRaw struct {
+	Contract *USDXPositionsTransactor // Generic write-only contract binding to access the raw methods on
 }
 
-// Accounts is a free data retrieval call binding the contract method 0x5e5c06e2.
-//
-// Solidity: function accounts(address ) view returns(uint256 locked, uint256 mint)
-func (_USDX *USDXSession) Accounts(arg0 common.Address) (struct {
-	Locked *big.Int
-	Mint   *big.Int
-}, error) {
-	return _USDX.Contract.Accounts(&_USDX.CallOpts, arg0)
+// NewUSDXPositions creates a new instance of USDXPositions, bound to a specific deployed contract.
+func NewUSDXPositions(address common.Address, backend bind.ContractBackend) (*USDXPositions, error) {
+	contract, err := bindUSDXPositions(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXPositions{USDXPositionsCaller: USDXPositionsCaller{contract: contract}, USDXPositionsTransactor: USDXPositionsTransactor{contract: contract}, USDXPositionsFilterer: USDXPositionsFilterer{contract: contract}}, nil
 }
 
-// Accounts is a free data retrieval call binding the contract method 0x5e5c06e2.
-//
-// Solidity: function accounts(address ) view returns(uint256 locked, uint256 mint)
-func (_USDX *USDXCallerSession) Accounts(arg0 common.Address) (struct {
-	Locked *big.Int
-	Mint   *big.Int
-}, error) {
-	return _USDX.Contract.Accounts(&_USDX.CallOpts, arg0)
+// NewUSDXPositionsCaller creates a new read-only instance of USDXPositions, bound to a specific deployed contract.
+func NewUSDXPositionsCaller(address common.Address, caller bind.ContractCaller) (*USDXPositionsCaller, error) {
+	contract, err := bindUSDXPositions(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXPositionsCaller{contract: contract}, nil
 }
 
-// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
-//
-// Solidity: function allowance(address owner, address spender) view returns(uint256)
-func (_USDX *USDXCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
-	var out []interface{}
-	err := _USDX.contract.Call(opts, &out, "allowance", owner, spender)
+// NewUSDXPositionsTransactor creates a new write-only instance of USDXPositions, bound to a specific deployed contract.
+func NewUSDXPositionsTransactor(address common.Address, transactor bind.ContractTransactor) (*USDXPositionsTransactor, error) {
+	contract, err := bindUSDXPositions(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &USDXPositionsTransactor{contract: contract}, nil
+}
 
+// NewUSDXPositionsFilterer creates a new log filterer instance of USDXPositions, bound to a specific deployed contract.
+func NewUSDXPositionsFilterer(address common.Address, filterer bind.ContractFilterer) (*USDXPositionsFilterer, error) {
+	contract, err := bindUSDXPositions(address, nil, nil, filterer)
 	if err != nil {
-		return *new(*big.Int), err
+		return nil, err
 	}
+	return &USDXPositionsFilterer{contract: contract}, nil
+}
 
-	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+// bindUSDXPositions binds a generic wrapper to an already deployed contract.
+func bindUSDXPositions(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(USDXPositionsABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
 
-	return out0, err
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_USDXPositions *USDXPositionsRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _USDXPositions.Contract.USDXPositionsCaller.contract.Call(opts, result, method, params...)
+}
 
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_USDXPositions *USDXPositionsRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _USDXPositions.Contract.USDXPositionsTransactor.contract.Transfer(opts)
 }
 
-// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
-//
-// Solidity: function allowance(address owner, address spender) view returns(uint256)
-func (_USDX *USDXSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
-	return _USDX.Contract.Allowance(&_USDX.CallOpts, owner, spender)
+// Transact invokes the (paid) contract method with params as input values.
+func (_USDXPositions *USDXPositionsRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _USDXPositions.Contract.USDXPositionsTransactor.contract.Transact(opts, method, params...)
 }
 
-// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
-//
-// Solidity: function allowance(address owner, address spender) view returns(uint256)
-func (_USDX *USDXCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
-	return _USDX.Contract.Allowance(&_USDX.CallOpts, owner, spender)
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_USDXPositions *USDXPositionsCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _USDXPositions.Contract.contract.Call(opts, result, method, params...)
 }
 
-// Appreciation is a free data retrieval call binding the contract method 0xd3980103.
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_USDXPositions *USDXPositionsTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _USDXPositions.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_USDXPositions *USDXPositionsTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _USDXPositions.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function appreciation(address _account) view returns(uint256)
-func (_USDX *USDXCaller) Appreciation(opts *bind.CallOpts, _account common.Address) (*big.Int, error) {
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_USDXPositions *USDXPositionsCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
 	var out []interface{}
-	err := _USDX.contract.Call(opts, &out, "appreciation", _account)
+	err := _USDXPositions.contract.Call(opts, &out, "balanceOf", owner)
 
 	if err != nil {
 		return *new(*big.Int), err
@@ -3265,88 +11880,88 @@ func (_USDX *USDXCaller) Appreciation(opts *bind.CallOpts, _account common.Addre
 
 }
 
-// Appreciation is a free data retrieval call binding the contract method 0xd3980103.
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function appreciation(address _account) view returns(uint256)
-func (_USDX *USDXSession) Appreciation(_account common.Address) (*big.Int, error) {
-	return _USDX.Contract.Appreciation(&_USDX.CallOpts, _account)
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_USDXPositions *USDXPositionsSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _USDXPositions.Contract.BalanceOf(&_USDXPositions.CallOpts, owner)
 }
 
-// Appreciation is a free data retrieval call binding the contract method 0xd3980103.
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function appreciation(address _account) view returns(uint256)
-func (_USDX *USDXCallerSession) Appreciation(_account common.Address) (*big.Int, error) {
-	return _USDX.Contract.Appreciation(&_USDX.CallOpts, _account)
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_USDXPositions *USDXPositionsCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _USDXPositions.Contract.BalanceOf(&_USDXPositions.CallOpts, owner)
 }
 
-// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
 //
-// Solidity: function balanceOf(address account) view returns(uint256)
-func (_USDX *USDXCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_USDXPositions *USDXPositionsCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
 	var out []interface{}
-	err := _USDX.contract.Call(opts, &out, "balanceOf", account)
+	err := _USDXPositions.contract.Call(opts, &out, "getApproved", tokenId)
 
 	if err != nil {
-		return *new(*big.Int), err
+		return *new(common.Address), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
 
 	return out0, err
 
 }
 
-// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
 //
-// Solidity: function balanceOf(address account) view returns(uint256)
-func (_USDX *USDXSession) BalanceOf(account common.Address) (*big.Int, error) {
-	return _USDX.Contract.BalanceOf(&_USDX.CallOpts, account)
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_USDXPositions *USDXPositionsSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _USDXPositions.Contract.GetApproved(&_USDXPositions.CallOpts, tokenId)
 }
 
-// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
 //
-// Solidity: function balanceOf(address account) view returns(uint256)
-func (_USDX *USDXCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
-	return _USDX.Contract.BalanceOf(&_USDX.CallOpts, account)
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_USDXPositions *USDXPositionsCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _USDXPositions.Contract.GetApproved(&_USDXPositions.CallOpts, tokenId)
 }
 
-// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
 //
-// Solidity: function decimals() view returns(uint8)
-func (_USDX *USDXCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_USDXPositions *USDXPositionsCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
 	var out []interface{}
-	err := _USDX.contract.Call(opts, &out, "decimals")
+	err := _USDXPositions.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
 
 	if err != nil {
-		return *new(uint8), err
+		return *new(bool), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
 
 	return out0, err
 
 }
 
-// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
 //
-// Solidity: function decimals() view returns(uint8)
-func (_USDX *USDXSession) Decimals() (uint8, error) {
-	return _USDX.Contract.Decimals(&_USDX.CallOpts)
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_USDXPositions *USDXPositionsSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _USDXPositions.Contract.IsApprovedForAll(&_USDXPositions.CallOpts, owner, operator)
 }
 
-// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
 //
-// Solidity: function decimals() view returns(uint8)
-func (_USDX *USDXCallerSession) Decimals() (uint8, error) {
-	return _USDX.Contract.Decimals(&_USDX.CallOpts)
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_USDXPositions *USDXPositionsCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _USDXPositions.Contract.IsApprovedForAll(&_USDXPositions.CallOpts, owner, operator)
 }
 
 // Name is a free data retrieval call binding the contract method 0x06fdde03.
 //
 // Solidity: function name() view returns(string)
-func (_USDX *USDXCaller) Name(opts *bind.CallOpts) (string, error) {
+func (_USDXPositions *USDXPositionsCaller) Name(opts *bind.CallOpts) (string, error) {
 	var out []interface{}
-	err := _USDX.contract.Call(opts, &out, "name")
+	err := _USDXPositions.contract.Call(opts, &out, "name")
 
 	if err != nil {
 		return *new(string), err
@@ -3361,23 +11976,23 @@ func (_USDX *USDXCaller) Name(opts *bind.CallOpts) (string, error) {
 // Name is a free data retrieval call binding the contract method 0x06fdde03.
 //
 // Solidity: function name() view returns(string)
-func (_USDX *USDXSession) Name() (string, error) {
-	return _USDX.Contract.Name(&_USDX.CallOpts)
+func (_USDXPositions *USDXPositionsSession) Name() (string, error) {
+	return _USDXPositions.Contract.Name(&_USDXPositions.CallOpts)
 }
 
 // Name is a free data retrieval call binding the contract method 0x06fdde03.
 //
 // Solidity: function name() view returns(string)
-func (_USDX *USDXCallerSession) Name() (string, error) {
-	return _USDX.Contract.Name(&_USDX.CallOpts)
+func (_USDXPositions *USDXPositionsCallerSession) Name() (string, error) {
+	return _USDXPositions.Contract.Name(&_USDXPositions.CallOpts)
 }
 
-// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
 //
-// Solidity: function owner() view returns(address)
-func (_USDX *USDXCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_USDXPositions *USDXPositionsCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
 	var out []interface{}
-	err := _USDX.contract.Call(opts, &out, "owner")
+	err := _USDXPositions.contract.Call(opts, &out, "ownerOf", tokenId)
 
 	if err != nil {
 		return *new(common.Address), err
@@ -3389,57 +12004,57 @@ func (_USDX *USDXCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
 
 }
 
-// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
 //
-// Solidity: function owner() view returns(address)
-func (_USDX *USDXSession) Owner() (common.Address, error) {
-	return _USDX.Contract.Owner(&_USDX.CallOpts)
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_USDXPositions *USDXPositionsSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _USDXPositions.Contract.OwnerOf(&_USDXPositions.CallOpts, tokenId)
 }
 
-// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
 //
-// Solidity: function owner() view returns(address)
-func (_USDX *USDXCallerSession) Owner() (common.Address, error) {
-	return _USDX.Contract.Owner(&_USDX.CallOpts)
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_USDXPositions *USDXPositionsCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _USDXPositions.Contract.OwnerOf(&_USDXPositions.CallOpts, tokenId)
 }
 
-// PriceStalenessThreshold is a free data retrieval call binding the contract method 0xbd111870.
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
 //
-// Solidity: function priceStalenessThreshold() view returns(uint80)
-func (_USDX *USDXCaller) PriceStalenessThreshold(opts *bind.CallOpts) (*big.Int, error) {
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_USDXPositions *USDXPositionsCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
 	var out []interface{}
-	err := _USDX.contract.Call(opts, &out, "priceStalenessThreshold")
+	err := _USDXPositions.contract.Call(opts, &out, "supportsInterface", interfaceId)
 
 	if err != nil {
-		return *new(*big.Int), err
+		return *new(bool), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
 
 	return out0, err
 
 }
 
-// PriceStalenessThreshold is a free data retrieval call binding the contract method 0xbd111870.
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
 //
-// Solidity: function priceStalenessThreshold() view returns(uint80)
-func (_USDX *USDXSession) PriceStalenessThreshold() (*big.Int, error) {
-	return _USDX.Contract.PriceStalenessThreshold(&_USDX.CallOpts)
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_USDXPositions *USDXPositionsSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _USDXPositions.Contract.SupportsInterface(&_USDXPositions.CallOpts, interfaceId)
 }
 
-// PriceStalenessThreshold is a free data retrieval call binding the contract method 0xbd111870.
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
 //
-// Solidity: function priceStalenessThreshold() view returns(uint80)
-func (_USDX *USDXCallerSession) PriceStalenessThreshold() (*big.Int, error) {
-	return _USDX.Contract.PriceStalenessThreshold(&_USDX.CallOpts)
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_USDXPositions *USDXPositionsCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _USDXPositions.Contract.SupportsInterface(&_USDXPositions.CallOpts, interfaceId)
 }
 
 // Symbol is a free data retrieval call binding the contract method 0x95d89b41.
 //
 // Solidity: function symbol() view returns(string)
-func (_USDX *USDXCaller) Symbol(opts *bind.CallOpts) (string, error) {
+func (_USDXPositions *USDXPositionsCaller) Symbol(opts *bind.CallOpts) (string, error) {
 	var out []interface{}
-	err := _USDX.contract.Call(opts, &out, "symbol")
+	err := _USDXPositions.contract.Call(opts, &out, "symbol")
 
 	if err != nil {
 		return *new(string), err
@@ -3454,355 +12069,250 @@ func (_USDX *USDXCaller) Symbol(opts *bind.CallOpts) (string, error) {
 // Symbol is a free data retrieval call binding the contract method 0x95d89b41.
 //
 // Solidity: function symbol() view returns(string)
-func (_USDX *USDXSession) Symbol() (string, error) {
-	return _USDX.Contract.Symbol(&_USDX.CallOpts)
+func (_USDXPositions *USDXPositionsSession) Symbol() (string, error) {
+	return _USDXPositions.Contract.Symbol(&_USDXPositions.CallOpts)
 }
 
 // Symbol is a free data retrieval call binding the contract method 0x95d89b41.
 //
 // Solidity: function symbol() view returns(string)
-func (_USDX *USDXCallerSession) Symbol() (string, error) {
-	return _USDX.Contract.Symbol(&_USDX.CallOpts)
-}
-
-// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
-//
-// Solidity: function totalSupply() view returns(uint256)
-func (_USDX *USDXCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
-	var out []interface{}
-	err := _USDX.contract.Call(opts, &out, "totalSupply")
-
-	if err != nil {
-		return *new(*big.Int), err
-	}
-
-	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
-
-	return out0, err
-
+func (_USDXPositions *USDXPositionsCallerSession) Symbol() (string, error) {
+	return _USDXPositions.Contract.Symbol(&_USDXPositions.CallOpts)
 }
 
-// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
-//
-// Solidity: function totalSupply() view returns(uint256)
-func (_USDX *USDXSession) TotalSupply() (*big.Int, error) {
-	return _USDX.Contract.TotalSupply(&_USDX.CallOpts)
-}
-
-// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
 //
-// Solidity: function totalSupply() view returns(uint256)
-func (_USDX *USDXCallerSession) TotalSupply() (*big.Int, error) {
-	return _USDX.Contract.TotalSupply(&_USDX.CallOpts)
-}
-
-// UsdPriceFeed is a free data retrieval call binding the contract method 0xde4874b0.
-//
-// Solidity: function usdPriceFeed() view returns(address)
-func (_USDX *USDXCaller) UsdPriceFeed(opts *bind.CallOpts) (common.Address, error) {
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_USDXPositions *USDXPositionsCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
 	var out []interface{}
-	err := _USDX.contract.Call(opts, &out, "usdPriceFeed")
+	err := _USDXPositions.contract.Call(opts, &out, "tokenURI", tokenId)
 
 	if err != nil {
-		return *new(common.Address), err
+		return *new(string), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
-
-	return out0, err
-
-}
-
-// UsdPriceFeed is a free data retrieval call binding the contract method 0xde4874b0.
-//
-// Solidity: function usdPriceFeed() view returns(address)
-func (_USDX *USDXSession) UsdPriceFeed() (common.Address, error) {
-	return _USDX.Contract.UsdPriceFeed(&_USDX.CallOpts)
-}
-
-// UsdPriceFeed is a free data retrieval call binding the contract method 0xde4874b0.
-//
-// Solidity: function usdPriceFeed() view returns(address)
-func (_USDX *USDXCallerSession) UsdPriceFeed() (common.Address, error) {
-	return _USDX.Contract.UsdPriceFeed(&_USDX.CallOpts)
-}
-
-// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
-//
-// Solidity: function approve(address spender, uint256 amount) returns(bool)
-func (_USDX *USDXTransactor) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _USDX.contract.Transact(opts, "approve", spender, amount)
-}
-
-// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
-//
-// Solidity: function approve(address spender, uint256 amount) returns(bool)
-func (_USDX *USDXSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.Approve(&_USDX.TransactOpts, spender, amount)
-}
-
-// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
-//
-// Solidity: function approve(address spender, uint256 amount) returns(bool)
-func (_USDX *USDXTransactorSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.Approve(&_USDX.TransactOpts, spender, amount)
-}
-
-// CollectAppreciation is a paid mutator transaction binding the contract method 0x1a254f12.
-//
-// Solidity: function collectAppreciation(uint256 _limit) returns(uint256)
-func (_USDX *USDXTransactor) CollectAppreciation(opts *bind.TransactOpts, _limit *big.Int) (*types.Transaction, error) {
-	return _USDX.contract.Transact(opts, "collectAppreciation", _limit)
-}
-
-// CollectAppreciation is a paid mutator transaction binding the contract method 0x1a254f12.
-//
-// Solidity: function collectAppreciation(uint256 _limit) returns(uint256)
-func (_USDX *USDXSession) CollectAppreciation(_limit *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.CollectAppreciation(&_USDX.TransactOpts, _limit)
-}
-
-// CollectAppreciation is a paid mutator transaction binding the contract method 0x1a254f12.
-//
-// Solidity: function collectAppreciation(uint256 _limit) returns(uint256)
-func (_USDX *USDXTransactorSession) CollectAppreciation(_limit *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.CollectAppreciation(&_USDX.TransactOpts, _limit)
-}
-
-// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
-//
-// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
-func (_USDX *USDXTransactor) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
-	return _USDX.contract.Transact(opts, "decreaseAllowance", spender, subtractedValue)
-}
-
-// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
-//
-// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
-func (_USDX *USDXSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.DecreaseAllowance(&_USDX.TransactOpts, spender, subtractedValue)
-}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
 
-// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
-//
-// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
-func (_USDX *USDXTransactorSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.DecreaseAllowance(&_USDX.TransactOpts, spender, subtractedValue)
-}
+	return out0, err
 
-// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
-//
-// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
-func (_USDX *USDXTransactor) IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
-	return _USDX.contract.Transact(opts, "increaseAllowance", spender, addedValue)
 }
 
-// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
 //
-// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
-func (_USDX *USDXSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.IncreaseAllowance(&_USDX.TransactOpts, spender, addedValue)
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_USDXPositions *USDXPositionsSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _USDXPositions.Contract.TokenURI(&_USDXPositions.CallOpts, tokenId)
 }
 
-// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
 //
-// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
-func (_USDX *USDXTransactorSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.IncreaseAllowance(&_USDX.TransactOpts, spender, addedValue)
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_USDXPositions *USDXPositionsCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _USDXPositions.Contract.TokenURI(&_USDXPositions.CallOpts, tokenId)
 }
 
-// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+// Usdx is a free data retrieval call binding the contract method 0x311176d7.
 //
-// Solidity: function renounceOwnership() returns()
-func (_USDX *USDXTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _USDX.contract.Transact(opts, "renounceOwnership")
+// Solidity: function usdx() view returns(address)
+func (_USDXPositions *USDXPositionsCaller) Usdx(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _USDXPositions.contract.Call(opts, &out, "usdx")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
 }
 
-// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+// Usdx is a free data retrieval call binding the contract method 0x311176d7.
 //
-// Solidity: function renounceOwnership() returns()
-func (_USDX *USDXSession) RenounceOwnership() (*types.Transaction, error) {
-	return _USDX.Contract.RenounceOwnership(&_USDX.TransactOpts)
+// Solidity: function usdx() view returns(address)
+func (_USDXPositions *USDXPositionsSession) Usdx() (common.Address, error) {
+	return _USDXPositions.Contract.Usdx(&_USDXPositions.CallOpts)
 }
 
-// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+// Usdx is a free data retrieval call binding the contract method 0x311176d7.
 //
-// Solidity: function renounceOwnership() returns()
-func (_USDX *USDXTransactorSession) RenounceOwnership() (*types.Transaction, error) {
-	return _USDX.Contract.RenounceOwnership(&_USDX.TransactOpts)
+// Solidity: function usdx() view returns(address)
+func (_USDXPositions *USDXPositionsCallerSession) Usdx() (common.Address, error) {
+	return _USDXPositions.Contract.Usdx(&_USDXPositions.CallOpts)
 }
 
-// SetFeed is a paid mutator transaction binding the contract method 0x55b775ea.
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
 //
-// Solidity: function setFeed(address _newFeed) returns()
-func (_USDX *USDXTransactor) SetFeed(opts *bind.TransactOpts, _newFeed common.Address) (*types.Transaction, error) {
-	return _USDX.contract.Transact(opts, "setFeed", _newFeed)
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_USDXPositions *USDXPositionsTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.contract.Transact(opts, "approve", to, tokenId)
 }
 
-// SetFeed is a paid mutator transaction binding the contract method 0x55b775ea.
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
 //
-// Solidity: function setFeed(address _newFeed) returns()
-func (_USDX *USDXSession) SetFeed(_newFeed common.Address) (*types.Transaction, error) {
-	return _USDX.Contract.SetFeed(&_USDX.TransactOpts, _newFeed)
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_USDXPositions *USDXPositionsSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.Contract.Approve(&_USDXPositions.TransactOpts, to, tokenId)
 }
 
-// SetFeed is a paid mutator transaction binding the contract method 0x55b775ea.
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
 //
-// Solidity: function setFeed(address _newFeed) returns()
-func (_USDX *USDXTransactorSession) SetFeed(_newFeed common.Address) (*types.Transaction, error) {
-	return _USDX.Contract.SetFeed(&_USDX.TransactOpts, _newFeed)
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_USDXPositions *USDXPositionsTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.Contract.Approve(&_USDXPositions.TransactOpts, to, tokenId)
 }
 
-// SetStalenessThreshold is a paid mutator transaction binding the contract method 0x0f3a72ce.
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
 //
-// Solidity: function setStalenessThreshold(uint80 _newThreshold) returns()
-func (_USDX *USDXTransactor) SetStalenessThreshold(opts *bind.TransactOpts, _newThreshold *big.Int) (*types.Transaction, error) {
-	return _USDX.contract.Transact(opts, "setStalenessThreshold", _newThreshold)
+// Solidity: function burn(uint256 _id) returns()
+func (_USDXPositions *USDXPositionsTransactor) Burn(opts *bind.TransactOpts, _id *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.contract.Transact(opts, "burn", _id)
 }
 
-// SetStalenessThreshold is a paid mutator transaction binding the contract method 0x0f3a72ce.
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
 //
-// Solidity: function setStalenessThreshold(uint80 _newThreshold) returns()
-func (_USDX *USDXSession) SetStalenessThreshold(_newThreshold *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.SetStalenessThreshold(&_USDX.TransactOpts, _newThreshold)
+// Solidity: function burn(uint256 _id) returns()
+func (_USDXPositions *USDXPositionsSession) Burn(_id *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.Contract.Burn(&_USDXPositions.TransactOpts, _id)
 }
 
-// SetStalenessThreshold is a paid mutator transaction binding the contract method 0x0f3a72ce.
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
 //
-// Solidity: function setStalenessThreshold(uint80 _newThreshold) returns()
-func (_USDX *USDXTransactorSession) SetStalenessThreshold(_newThreshold *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.SetStalenessThreshold(&_USDX.TransactOpts, _newThreshold)
+// Solidity: function burn(uint256 _id) returns()
+func (_USDXPositions *USDXPositionsTransactorSession) Burn(_id *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.Contract.Burn(&_USDXPositions.TransactOpts, _id)
 }
 
-// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
 //
-// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
-func (_USDX *USDXTransactor) Transfer(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _USDX.contract.Transact(opts, "transfer", recipient, amount)
+// Solidity: function mint(address _to, uint256 _id) returns()
+func (_USDXPositions *USDXPositionsTransactor) Mint(opts *bind.TransactOpts, _to common.Address, _id *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.contract.Transact(opts, "mint", _to, _id)
 }
 
-// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
 //
-// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
-func (_USDX *USDXSession) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.Transfer(&_USDX.TransactOpts, recipient, amount)
+// Solidity: function mint(address _to, uint256 _id) returns()
+func (_USDXPositions *USDXPositionsSession) Mint(_to common.Address, _id *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.Contract.Mint(&_USDXPositions.TransactOpts, _to, _id)
 }
 
-// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
 //
-// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
-func (_USDX *USDXTransactorSession) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.Transfer(&_USDX.TransactOpts, recipient, amount)
+// Solidity: function mint(address _to, uint256 _id) returns()
+func (_USDXPositions *USDXPositionsTransactorSession) Mint(_to common.Address, _id *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.Contract.Mint(&_USDXPositions.TransactOpts, _to, _id)
 }
 
-// TransferAcct is a paid mutator transaction binding the contract method 0xac660479.
+// Move is a paid mutator transaction binding the contract method 0xbb35783b.
 //
-// Solidity: function transferAcct(address _to) returns()
-func (_USDX *USDXTransactor) TransferAcct(opts *bind.TransactOpts, _to common.Address) (*types.Transaction, error) {
-	return _USDX.contract.Transact(opts, "transferAcct", _to)
+// Solidity: function move(address _from, address _to, uint256 _id) returns()
+func (_USDXPositions *USDXPositionsTransactor) Move(opts *bind.TransactOpts, _from common.Address, _to common.Address, _id *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.contract.Transact(opts, "move", _from, _to, _id)
 }
 
-// TransferAcct is a paid mutator transaction binding the contract method 0xac660479.
+// Move is a paid mutator transaction binding the contract method 0xbb35783b.
 //
-// Solidity: function transferAcct(address _to) returns()
-func (_USDX *USDXSession) TransferAcct(_to common.Address) (*types.Transaction, error) {
-	return _USDX.Contract.TransferAcct(&_USDX.TransactOpts, _to)
+// Solidity: function move(address _from, address _to, uint256 _id) returns()
+func (_USDXPositions *USDXPositionsSession) Move(_from common.Address, _to common.Address, _id *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.Contract.Move(&_USDXPositions.TransactOpts, _from, _to, _id)
 }
 
-// TransferAcct is a paid mutator transaction binding the contract method 0xac660479.
+// Move is a paid mutator transaction binding the contract method 0xbb35783b.
 //
-// Solidity: function transferAcct(address _to) returns()
-func (_USDX *USDXTransactorSession) TransferAcct(_to common.Address) (*types.Transaction, error) {
-	return _USDX.Contract.TransferAcct(&_USDX.TransactOpts, _to)
+// Solidity: function move(address _from, address _to, uint256 _id) returns()
+func (_USDXPositions *USDXPositionsTransactorSession) Move(_from common.Address, _to common.Address, _id *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.Contract.Move(&_USDXPositions.TransactOpts, _from, _to, _id)
 }
 
-// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
 //
-// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
-func (_USDX *USDXTransactor) TransferFrom(opts *bind.TransactOpts, sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _USDX.contract.Transact(opts, "transferFrom", sender, recipient, amount)
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_USDXPositions *USDXPositionsTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
 }
 
-// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
 //
-// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
-func (_USDX *USDXSession) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.TransferFrom(&_USDX.TransactOpts, sender, recipient, amount)
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_USDXPositions *USDXPositionsSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.Contract.SafeTransferFrom(&_USDXPositions.TransactOpts, from, to, tokenId)
 }
 
-// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
 //
-// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
-func (_USDX *USDXTransactorSession) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.TransferFrom(&_USDX.TransactOpts, sender, recipient, amount)
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_USDXPositions *USDXPositionsTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.Contract.SafeTransferFrom(&_USDXPositions.TransactOpts, from, to, tokenId)
 }
 
-// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
 //
-// Solidity: function transferOwnership(address newOwner) returns()
-func (_USDX *USDXTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
-	return _USDX.contract.Transact(opts, "transferOwnership", newOwner)
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_USDXPositions *USDXPositionsTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _USDXPositions.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, _data)
 }
 
-// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
 //
-// Solidity: function transferOwnership(address newOwner) returns()
-func (_USDX *USDXSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
-	return _USDX.Contract.TransferOwnership(&_USDX.TransactOpts, newOwner)
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_USDXPositions *USDXPositionsSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _USDXPositions.Contract.SafeTransferFrom0(&_USDXPositions.TransactOpts, from, to, tokenId, _data)
 }
 
-// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
 //
-// Solidity: function transferOwnership(address newOwner) returns()
-func (_USDX *USDXTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
-	return _USDX.Contract.TransferOwnership(&_USDX.TransactOpts, newOwner)
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_USDXPositions *USDXPositionsTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _USDXPositions.Contract.SafeTransferFrom0(&_USDXPositions.TransactOpts, from, to, tokenId, _data)
 }
 
-// Unlock is a paid mutator transaction binding the contract method 0x6198e339.
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
 //
-// Solidity: function unlock(uint256 _usdx) returns(uint256)
-func (_USDX *USDXTransactor) Unlock(opts *bind.TransactOpts, _usdx *big.Int) (*types.Transaction, error) {
-	return _USDX.contract.Transact(opts, "unlock", _usdx)
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_USDXPositions *USDXPositionsTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _USDXPositions.contract.Transact(opts, "setApprovalForAll", operator, approved)
 }
 
-// Unlock is a paid mutator transaction binding the contract method 0x6198e339.
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
 //
-// Solidity: function unlock(uint256 _usdx) returns(uint256)
-func (_USDX *USDXSession) Unlock(_usdx *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.Unlock(&_USDX.TransactOpts, _usdx)
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_USDXPositions *USDXPositionsSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _USDXPositions.Contract.SetApprovalForAll(&_USDXPositions.TransactOpts, operator, approved)
 }
 
-// Unlock is a paid mutator transaction binding the contract method 0x6198e339.
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
 //
-// Solidity: function unlock(uint256 _usdx) returns(uint256)
-func (_USDX *USDXTransactorSession) Unlock(_usdx *big.Int) (*types.Transaction, error) {
-	return _USDX.Contract.Unlock(&_USDX.TransactOpts, _usdx)
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_USDXPositions *USDXPositionsTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _USDXPositions.Contract.SetApprovalForAll(&_USDXPositions.TransactOpts, operator, approved)
 }
 
-// Receive is a paid mutator transaction binding the contract receive function.
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
 //
-// Solidity: receive() payable returns()
-func (_USDX *USDXTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _USDX.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_USDXPositions *USDXPositionsTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.contract.Transact(opts, "transferFrom", from, to, tokenId)
 }
 
-// Receive is a paid mutator transaction binding the contract receive function.
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
 //
-// Solidity: receive() payable returns()
-func (_USDX *USDXSession) Receive() (*types.Transaction, error) {
-	return _USDX.Contract.Receive(&_USDX.TransactOpts)
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_USDXPositions *USDXPositionsSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.Contract.TransferFrom(&_USDXPositions.TransactOpts, from, to, tokenId)
 }
 
-// Receive is a paid mutator transaction binding the contract receive function.
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
 //
-// Solidity: receive() payable returns()
-func (_USDX *USDXTransactorSession) Receive() (*types.Transaction, error) {
-	return _USDX.Contract.Receive(&_USDX.TransactOpts)
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_USDXPositions *USDXPositionsTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _USDXPositions.Contract.TransferFrom(&_USDXPositions.TransactOpts, from, to, tokenId)
 }
 
-// USDXApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the USDX contract.
-type USDXApprovalIterator struct {
-	Event *USDXApproval // Event containing the contract specifics and raw log
+// USDXPositionsApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the USDXPositions contract.
+type USDXPositionsApprovalIterator struct {
+	Event *USDXPositionsApproval // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -3816,7 +12326,7 @@ type USDXApprovalIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *USDXApprovalIterator) Next() bool {
+func (it *USDXPositionsApprovalIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -3825,7 +12335,7 @@ func (it *USDXApprovalIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(USDXApproval)
+			it.Event = new(USDXPositionsApproval)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -3840,7 +12350,7 @@ func (it *USDXApprovalIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(USDXApproval)
+		it.Event = new(USDXPositionsApproval)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -3856,61 +12366,69 @@ func (it *USDXApprovalIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *USDXApprovalIterator) Error() error {
+func (it *USDXPositionsApprovalIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *USDXApprovalIterator) Close() error {
+func (it *USDXPositionsApprovalIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// USDXApproval represents a Approval event raised by the USDX contract.
-type USDXApproval struct {
-	Owner   common.Address
-	Spender common.Address
-	Value   *big.Int
-	Raw     types.Log // Blockchain specific contextual infos
+// USDXPositionsApproval represents a Approval event raised by the USDXPositions contract.
+type USDXPositionsApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
 }
 
 // FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
 //
-// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
-func (_USDX *USDXFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*USDXApprovalIterator, error) {
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_USDXPositions *USDXPositionsFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*USDXPositionsApprovalIterator, error) {
 
 	var ownerRule []interface{}
 	for _, ownerItem := range owner {
 		ownerRule = append(ownerRule, ownerItem)
 	}
-	var spenderRule []interface{}
-	for _, spenderItem := range spender {
-		spenderRule = append(spenderRule, spenderItem)
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
 	}
 
-	logs, sub, err := _USDX.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	logs, sub, err := _USDXPositions.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
 	if err != nil {
 		return nil, err
 	}
-	return &USDXApprovalIterator{contract: _USDX.contract, event: "Approval", logs: logs, sub: sub}, nil
+	return &USDXPositionsApprovalIterator{contract: _USDXPositions.contract, event: "Approval", logs: logs, sub: sub}, nil
 }
 
 // WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
 //
-// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
-func (_USDX *USDXFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *USDXApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_USDXPositions *USDXPositionsFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *USDXPositionsApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
 
 	var ownerRule []interface{}
 	for _, ownerItem := range owner {
 		ownerRule = append(ownerRule, ownerItem)
 	}
-	var spenderRule []interface{}
-	for _, spenderItem := range spender {
-		spenderRule = append(spenderRule, spenderItem)
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
 	}
 
-	logs, sub, err := _USDX.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	logs, sub, err := _USDXPositions.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
 	if err != nil {
 		return nil, err
 	}
@@ -3920,8 +12438,8 @@ func (_USDX *USDXFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *USDX
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(USDXApproval)
-				if err := _USDX.contract.UnpackLog(event, "Approval", log); err != nil {
+				event := new(USDXPositionsApproval)
+				if err := _USDXPositions.contract.UnpackLog(event, "Approval", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -3944,19 +12462,19 @@ func (_USDX *USDXFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *USDX
 
 // ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
 //
-// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
-func (_USDX *USDXFilterer) ParseApproval(log types.Log) (*USDXApproval, error) {
-	event := new(USDXApproval)
-	if err := _USDX.contract.UnpackLog(event, "Approval", log); err != nil {
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_USDXPositions *USDXPositionsFilterer) ParseApproval(log types.Log) (*USDXPositionsApproval, error) {
+	event := new(USDXPositionsApproval)
+	if err := _USDXPositions.contract.UnpackLog(event, "Approval", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// USDXOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the USDX contract.
-type USDXOwnershipTransferredIterator struct {
-	Event *USDXOwnershipTransferred // Event containing the contract specifics and raw log
+// USDXPositionsApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the USDXPositions contract.
+type USDXPositionsApprovalForAllIterator struct {
+	Event *USDXPositionsApprovalForAll // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -3970,7 +12488,7 @@ type USDXOwnershipTransferredIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *USDXOwnershipTransferredIterator) Next() bool {
+func (it *USDXPositionsApprovalForAllIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -3979,7 +12497,7 @@ func (it *USDXOwnershipTransferredIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(USDXOwnershipTransferred)
+			it.Event = new(USDXPositionsApprovalForAll)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -3994,7 +12512,7 @@ func (it *USDXOwnershipTransferredIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(USDXOwnershipTransferred)
+		it.Event = new(USDXPositionsApprovalForAll)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -4010,60 +12528,61 @@ func (it *USDXOwnershipTransferredIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *USDXOwnershipTransferredIterator) Error() error {
+func (it *USDXPositionsApprovalForAllIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *USDXOwnershipTransferredIterator) Close() error {
+func (it *USDXPositionsApprovalForAllIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// USDXOwnershipTransferred represents a OwnershipTransferred event raised by the USDX contract.
-type USDXOwnershipTransferred struct {
-	PreviousOwner common.Address
-	NewOwner      common.Address
-	Raw           types.Log // Blockchain specific contextual infos
+// USDXPositionsApprovalForAll represents a ApprovalForAll event raised by the USDXPositions contract.
+type USDXPositionsApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
 }
 
-// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_USDX *USDXFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*USDXOwnershipTransferredIterator, error) {
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_USDXPositions *USDXPositionsFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*USDXPositionsApprovalForAllIterator, error) {
 
-	var previousOwnerRule []interface{}
-	for _, previousOwnerItem := range previousOwner {
-		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
 	}
-	var newOwnerRule []interface{}
-	for _, newOwnerItem := range newOwner {
-		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
 	}
 
-	logs, sub, err := _USDX.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	logs, sub, err := _USDXPositions.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
 	if err != nil {
 		return nil, err
 	}
-	return &USDXOwnershipTransferredIterator{contract: _USDX.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+	return &USDXPositionsApprovalForAllIterator{contract: _USDXPositions.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
 }
 
-// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_USDX *USDXFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *USDXOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_USDXPositions *USDXPositionsFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *USDXPositionsApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
 
-	var previousOwnerRule []interface{}
-	for _, previousOwnerItem := range previousOwner {
-		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
 	}
-	var newOwnerRule []interface{}
-	for _, newOwnerItem := range newOwner {
-		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
 	}
 
-	logs, sub, err := _USDX.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	logs, sub, err := _USDXPositions.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
 	if err != nil {
 		return nil, err
 	}
@@ -4073,8 +12592,8 @@ func (_USDX *USDXFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(USDXOwnershipTransferred)
-				if err := _USDX.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+				event := new(USDXPositionsApprovalForAll)
+				if err := _USDXPositions.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -4095,21 +12614,21 @@ func (_USDX *USDXFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink
 	}), nil
 }
 
-// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_USDX *USDXFilterer) ParseOwnershipTransferred(log types.Log) (*USDXOwnershipTransferred, error) {
-	event := new(USDXOwnershipTransferred)
-	if err := _USDX.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_USDXPositions *USDXPositionsFilterer) ParseApprovalForAll(log types.Log) (*USDXPositionsApprovalForAll, error) {
+	event := new(USDXPositionsApprovalForAll)
+	if err := _USDXPositions.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// USDXTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the USDX contract.
-type USDXTransferIterator struct {
-	Event *USDXTransfer // Event containing the contract specifics and raw log
+// USDXPositionsTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the USDXPositions contract.
+type USDXPositionsTransferIterator struct {
+	Event *USDXPositionsTransfer // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -4123,7 +12642,7 @@ type USDXTransferIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *USDXTransferIterator) Next() bool {
+func (it *USDXPositionsTransferIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -4132,7 +12651,7 @@ func (it *USDXTransferIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(USDXTransfer)
+			it.Event = new(USDXPositionsTransfer)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -4147,7 +12666,7 @@ func (it *USDXTransferIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(USDXTransfer)
+		it.Event = new(USDXPositionsTransfer)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -4163,29 +12682,29 @@ func (it *USDXTransferIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *USDXTransferIterator) Error() error {
+func (it *USDXPositionsTransferIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *USDXTransferIterator) Close() error {
+func (it *USDXPositionsTransferIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// USDXTransfer represents a Transfer event raised by the USDX contract.
-type USDXTransfer struct {
-	From  common.Address
-	To    common.Address
-	Value *big.Int
-	Raw   types.Log // Blockchain specific contextual infos
+// USDXPositionsTransfer represents a Transfer event raised by the USDXPositions contract.
+type USDXPositionsTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
 // FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_USDX *USDXFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*USDXTransferIterator, error) {
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_USDXPositions *USDXPositionsFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*USDXPositionsTransferIterator, error) {
 
 	var fromRule []interface{}
 	for _, fromItem := range from {
@@ -4195,18 +12714,22 @@ func (_USDX *USDXFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.A
 	for _, toItem := range to {
 		toRule = append(toRule, toItem)
 	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
 
-	logs, sub, err := _USDX.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	logs, sub, err := _USDXPositions.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
 	if err != nil {
 		return nil, err
 	}
-	return &USDXTransferIterator{contract: _USDX.contract, event: "Transfer", logs: logs, sub: sub}, nil
+	return &USDXPositionsTransferIterator{contract: _USDXPositions.contract, event: "Transfer", logs: logs, sub: sub}, nil
 }
 
 // WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_USDX *USDXFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *USDXTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_USDXPositions *USDXPositionsFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *USDXPositionsTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
 
 	var fromRule []interface{}
 	for _, fromItem := range from {
@@ -4216,8 +12739,12 @@ func (_USDX *USDXFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *USDX
 	for _, toItem := range to {
 		toRule = append(toRule, toItem)
 	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
 
-	logs, sub, err := _USDX.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	logs, sub, err := _USDXPositions.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
 	if err != nil {
 		return nil, err
 	}
@@ -4227,8 +12754,8 @@ func (_USDX *USDXFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *USDX
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(USDXTransfer)
-				if err := _USDX.contract.UnpackLog(event, "Transfer", log); err != nil {
+				event := new(USDXPositionsTransfer)
+				if err := _USDXPositions.contract.UnpackLog(event, "Transfer", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -4251,10 +12778,10 @@ func (_USDX *USDXFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *USDX
 
 // ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_USDX *USDXFilterer) ParseTransfer(log types.Log) (*USDXTransfer, error) {
-	event := new(USDXTransfer)
-	if err := _USDX.contract.UnpackLog(event, "Transfer", log); err != nil {
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_USDXPositions *USDXPositionsFilterer) ParseTransfer(log types.Log) (*USDXPositionsTransfer, error) {
+	event := new(USDXPositionsTransfer)
+	if err := _USDXPositions.contract.UnpackLog(event, "Transfer", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -1,20 +1,29 @@
+// The bindings are compiled with solc 0.8.2, which must be the solc on
+// PATH: other versions compile different bytecode and metadata.
+//
 //go:generate abigen --sol ../../sol/Usdx.sol --pkg usdx --out usdx_abigen.go --exc ../../sol/chainlink/evm-contracts/src/v0.7/interfaces/AggregatorV3Interface.sol:AggregatorV3Interface
-package usdx
+package usdx_test
 
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
 	"math/rand"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
-	"github.com/royalfork/soltest"
+	. "github.com/royalfork/usdx/pkg/usdx"
+	"github.com/royalfork/usdx/pkg/usdxtest"
 )
 
 var (
@@ -29,27 +38,18 @@ func bigint(val int64, decs *big.Int) *big.Int {
 }
 
 func TestReceive(t *testing.T) {
-	chain, accts := soltest.New()
-
-	oracleAddr, _, oracleContract, err := DeployMockOracle(accts[0].Auth, chain)
-	if err != nil {
-		t.Fatal(err)
-	}
-	chain.Commit()
-
-	contractAddr, _, contract, err := DeployUSDX(accts[0].Auth, chain, oracleAddr)
-	if err != nil {
-		t.Fatal(err)
-	}
-	chain.Commit()
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, oracleContract, contractAddr, contract := env.Chain, env.Oracle, env.USDXAddr, env.USDX
 
 	t.Run("latestRoundReverts", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		if !chain.Succeed(oracleContract.SetAccess(accts[0].Auth, false)) {
 			t.Fatal("unable to set oracle access")
 		}
 
 		accts[1].Auth.Value = big.NewInt(100)
-		if chain.Succeed((&USDXRaw{contract}).Transfer(accts[1].Auth)) {
+		if chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[1].Auth)) {
 			t.Error("shouldn't mint when feed reverts")
 		}
 		accts[1].Auth.Value = nil
@@ -61,6 +61,7 @@ func TestReceive(t *testing.T) {
 
 	// Randomly mint usdx, ensure balances always add up.
 	t.Run("mint", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		r := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 		feedDecs := big.NewInt(1e8)
@@ -93,7 +94,7 @@ func TestReceive(t *testing.T) {
 			}
 
 			acct.Auth.Value = payment
-			if !chain.Succeed((&USDXRaw{contract}).Transfer(acct.Auth)) {
+			if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(acct.Auth)) {
 				t.Fatal("unable to transfer")
 			}
 			acct.Auth.Value = nil
@@ -126,29 +127,302 @@ func TestReceive(t *testing.T) {
 	})
 }
 
-func TestUnlock(t *testing.T) {
-	chain, accts := soltest.New()
+func TestMint(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contractAddr, contract := env.Chain, env.Accounts, env.Oracle, env.USDXAddr, env.USDX
 
-	oracleAddr, _, oracleContract, err := DeployMockOracle(accts[0].Auth, chain)
-	if err != nil {
-		t.Fatal(err)
+	setRate := func(t *testing.T, usd int64) {
+		t.Helper()
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(usd, rate), zero, zero, zero)) {
+			t.Fatalf("unable to set oracle round: rate=%v", usd)
+		}
+	}
+	// deadline returns a deadline secs after the latest block.
+	deadline := func(t *testing.T, secs int64) *big.Int {
+		t.Helper()
+		head, err := chain.HeaderByNumber(context.Background(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return big.NewInt(int64(head.Time) + secs)
+	}
+	// quote quotes minting 1 eth, with a 1% tolerance.
+	quote := func(t *testing.T) (*big.Int, *big.Int) {
+		t.Helper()
+		q, err := Quote(&bind.CallOpts{}, chain, contractAddr, big.NewInt(params.Ether))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return q, MinUSDX(q, 100)
+	}
+	assertAcct := func(t *testing.T, addr common.Address, locked, mint *big.Int) {
+		t.Helper()
+		acct, err := contract.Accounts(&bind.CallOpts{}, addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if acct.Locked.Cmp(locked) != 0 || acct.Mint.Cmp(mint) != 0 {
+			t.Errorf("want locked: %v, mint: %v, got: %v, %v", locked, mint, acct.Locked, acct.Mint)
+		}
+		if bal, err := contract.BalanceOf(&bind.CallOpts{}, addr); err != nil {
+			t.Fatal(err)
+		} else if bal.Cmp(mint) != 0 {
+			t.Errorf("want bal: %v, got: %v", mint, bal)
+		}
 	}
-	chain.Commit()
 
-	_, _, contract, err := DeployUSDX(accts[0].Auth, chain, oracleAddr)
-	if err != nil {
-		t.Fatal(err)
+	t.Run("quote", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		setRate(t, 2000)
+		q, min := quote(t)
+		if want := bigint(2000, usdx); q.Cmp(want) != 0 {
+			t.Errorf("want quote: %v, got: %v", want, q)
+		}
+		if want := bigint(1980, usdx); min.Cmp(want) != 0 {
+			t.Errorf("want min: %v, got: %v", want, min)
+		}
+
+		if !chain.Succeed(contract.SetStalenessThreshold(accts[0].Auth, big.NewInt(3))) {
+			t.Fatal("unable to set threshold")
+		}
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, big.NewInt(14), bigint(2000, rate), zero, zero, big.NewInt(10))) {
+			t.Fatal("unable to set oracle round")
+		}
+		if _, err := Quote(&bind.CallOpts{}, chain, contractAddr, big.NewInt(params.Ether)); err != ErrStalePrice {
+			t.Errorf("want err: %v, got: %v", ErrStalePrice, err)
+		}
+	})
+
+	t.Run("priceWithinTolerance", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		setRate(t, 2000)
+		_, min := quote(t)
+		setRate(t, 1990)
+
+		accts[1].Auth.Value = big.NewInt(params.Ether)
+		if !chain.Succeed(contract.Mint(accts[1].Auth, min, deadline(t, 60))) {
+			t.Fatal("unable to mint")
+		}
+		accts[1].Auth.Value = nil
+		assertAcct(t, accts[1].Addr, big.NewInt(params.Ether), bigint(1990, usdx))
+	})
+
+	t.Run("priceRises", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		setRate(t, 2000)
+		_, min := quote(t)
+		setRate(t, 2500)
+
+		accts[1].Auth.Value = big.NewInt(params.Ether)
+		if !chain.Succeed(contract.Mint(accts[1].Auth, min, deadline(t, 60))) {
+			t.Fatal("unable to mint")
+		}
+		accts[1].Auth.Value = nil
+		assertAcct(t, accts[1].Addr, big.NewInt(params.Ether), bigint(2500, usdx))
+	})
+
+	t.Run("slippage", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		setRate(t, 2000)
+		_, min := quote(t)
+		setRate(t, 1900)
+
+		accts[1].Auth.Value = big.NewInt(params.Ether)
+		if chain.Succeed(contract.Mint(accts[1].Auth, min, deadline(t, 60))) {
+			t.Error("shouldn't mint below minimum")
+		}
+		if chain.Succeed(contract.MintTo(accts[1].Auth, accts[2].Addr, min, deadline(t, 60))) {
+			t.Error("shouldn't mint below minimum")
+		}
+		accts[1].Auth.Value = nil
+		assertAcct(t, accts[1].Addr, zero, zero)
+		assertAcct(t, accts[2].Addr, zero, zero)
+
+		if contractBal, _ := chain.BalanceAt(context.Background(), contractAddr, nil); contractBal.Sign() != 0 {
+			t.Errorf("want contract balance: 0, got: %v", contractBal)
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		setRate(t, 2000)
+		_, min := quote(t)
+
+		accts[1].Auth.Value = big.NewInt(params.Ether)
+		if chain.Succeed(contract.Mint(accts[1].Auth, min, deadline(t, -1))) {
+			t.Error("shouldn't mint after deadline")
+		}
+		accts[1].Auth.Value = nil
+		assertAcct(t, accts[1].Addr, zero, zero)
+	})
+
+	t.Run("mintTo", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		setRate(t, 2000)
+		_, min := quote(t)
+
+		accts[1].Auth.Value = big.NewInt(params.Ether)
+		if chain.Succeed(contract.MintTo(accts[1].Auth, accts[2].Addr, min, deadline(t, 60))) {
+			t.Fatal("shouldn't mint to a recipient which doesn't allow sender")
+		}
+		accts[1].Auth.Value = nil
+		if !chain.Succeed(contract.SetLotSender(accts[2].Auth, accts[1].Addr, true)) {
+			t.Fatal("unable to allow lot sender")
+		}
+		accts[1].Auth.Value = big.NewInt(params.Ether)
+		if !chain.Succeed(contract.MintTo(accts[1].Auth, accts[2].Addr, min, deadline(t, 60))) {
+			t.Fatal("unable to mint")
+		}
+		accts[1].Auth.Value = nil
+		assertAcct(t, accts[1].Addr, zero, zero)
+		assertAcct(t, accts[2].Addr, big.NewInt(params.Ether), bigint(2000, usdx))
+
+		// the recipient, not the sender, is able to unlock
+		if chain.Succeed(contract.Unlock(accts[1].Auth, zero)) {
+			t.Error("sender shouldn't unlock recipient's eth")
+		}
+		if !chain.Succeed(contract.Unlock(accts[2].Auth, zero)) {
+			t.Fatal("recipient unable to unlock")
+		}
+		if wd, err := contract.Withdrawable(&bind.CallOpts{}, accts[2].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := big.NewInt(params.Ether); wd.Cmp(want) != 0 {
+			t.Errorf("want withdrawable: %v, got: %v", want, wd)
+		}
+	})
+}
+
+func TestMintFor(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contractAddr, contract := env.Chain, env.Accounts, env.Oracle, env.USDXAddr, env.USDX
+
+	// Set rate to 1000usd/eth
+	if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(1000e8), zero, zero, zero)) {
+		t.Fatal("unable to set oracle round")
+	}
+	// accts[2] accepts lots from accts[1].
+	if !chain.Succeed(contract.SetLotSender(accts[2].Auth, accts[1].Addr, true)) {
+		t.Fatal("unable to allow lot sender")
+	}
+
+	// mintFor mints for beneficiary with 1 eth from acct.
+	mintFor := func(t *testing.T, acct usdxtest.Account, beneficiary common.Address) {
+		t.Helper()
+		acct.Auth.Value = big.NewInt(params.Ether)
+		if !chain.Succeed(contract.MintFor(acct.Auth, beneficiary)) {
+			t.Fatal("unable to mint")
+		}
+		acct.Auth.Value = nil
+	}
+	assertAcct := func(t *testing.T, addr common.Address, locked, mint, bal *big.Int) {
+		t.Helper()
+		acct, err := contract.Accounts(&bind.CallOpts{}, addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if acct.Locked.Cmp(locked) != 0 || acct.Mint.Cmp(mint) != 0 {
+			t.Errorf("want locked: %v, mint: %v, got: %v, %v", locked, mint, acct.Locked, acct.Mint)
+		}
+		if got, err := contract.BalanceOf(&bind.CallOpts{}, addr); err != nil {
+			t.Fatal(err)
+		} else if got.Cmp(bal) != 0 {
+			t.Errorf("want bal: %v, got: %v", bal, got)
+		}
 	}
-	chain.Commit()
+
+	t.Run("beneficiaryUnlocks", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		mintFor(t, accts[1], accts[2].Addr)
+		assertAcct(t, accts[1].Addr, zero, zero, zero)
+		assertAcct(t, accts[2].Addr, big.NewInt(params.Ether), bigint(1000, usdx), bigint(1000, usdx))
+
+		if !chain.Succeed(contract.Unlock(accts[2].Auth, bigint(400, usdx))) {
+			t.Fatal("beneficiary unable to unlock")
+		}
+		if wd, err := contract.Withdrawable(&bind.CallOpts{}, accts[2].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := big.NewInt(4e17); wd.Cmp(want) != 0 {
+			t.Errorf("want withdrawable: %v, got: %v", want, wd)
+		}
+	})
+
+	t.Run("senderCantUnlock", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		mintFor(t, accts[1], accts[2].Addr)
+
+		// Even holding the usdx, the sender has no account.
+		if !chain.Succeed(contract.Transfer(accts[2].Auth, accts[1].Addr, bigint(1000, usdx))) {
+			t.Fatal("unable to transfer usdx")
+		}
+		if chain.Succeed(contract.Unlock(accts[1].Auth, zero)) {
+			t.Error("sender shouldn't unlock beneficiary's eth")
+		}
+		// Nor can the beneficiary unlock without usdx.
+		if chain.Succeed(contract.Unlock(accts[2].Auth, zero)) {
+			t.Error("beneficiary shouldn't unlock without usdx")
+		}
+		assertAcct(t, accts[2].Addr, big.NewInt(params.Ether), bigint(1000, usdx), zero)
+
+		if contractBal, _ := chain.BalanceAt(context.Background(), contractAddr, nil); contractBal.Cmp(big.NewInt(params.Ether)) != 0 {
+			t.Errorf("want contract balance: %v, got: %v", params.Ether, contractBal)
+		}
+	})
+
+	t.Run("addsToAccount", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// beneficiary's own mint, then a mint for it at a new rate
+		mintFor(t, accts[2], accts[2].Addr)
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(3000e8), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+		mintFor(t, accts[1], accts[2].Addr)
+		assertAcct(t, accts[2].Addr, big.NewInt(2*params.Ether), bigint(4000, usdx), bigint(4000, usdx))
+
+		if !chain.Succeed(contract.Unlock(accts[2].Auth, zero)) {
+			t.Fatal("beneficiary unable to unlock")
+		}
+		if wd, err := contract.Withdrawable(&bind.CallOpts{}, accts[2].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := big.NewInt(2 * params.Ether); wd.Cmp(want) != 0 {
+			t.Errorf("want withdrawable: %v, got: %v", want, wd)
+		}
+	})
+
+	t.Run("zeroBeneficiary", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		accts[1].Auth.Value = big.NewInt(params.Ether)
+		if chain.Succeed(contract.MintFor(accts[1].Auth, common.Address{})) {
+			t.Error("shouldn't mint for the zero address")
+		}
+		accts[1].Auth.Value = nil
+	})
+
+	t.Run("notAllowed", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		accts[3].Auth.Value = big.NewInt(params.Ether)
+		if chain.Succeed(contract.MintFor(accts[3].Auth, accts[2].Addr)) {
+			t.Error("shouldn't mint for a beneficiary which doesn't allow sender")
+		}
+		accts[3].Auth.Value = nil
+		assertAcct(t, accts[2].Addr, zero, zero, zero)
+	})
+}
+
+func TestUnlock(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contract := env.Chain, env.Accounts, env.Oracle, env.USDX
 
 	// Set rate to 1000usd/eth
 	if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(1000e8), zero, zero, zero)) {
 		t.Fatal("unable to set oracle round")
 	}
 
-	setup := func(t *testing.T, acct soltest.TestAccount) (curBal *big.Int, cleanup func()) {
+	setup := func(t *testing.T, acct usdxtest.Account) (curBal *big.Int, cleanup func()) {
 		acct.Auth.Value = big.NewInt(params.Ether)
-		if !chain.Succeed((&USDXRaw{contract}).Transfer(acct.Auth)) {
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(acct.Auth)) {
 			t.Fatal("unable to transfer")
 		}
 		acct.Auth.Value = nil
@@ -170,17 +444,38 @@ func TestUnlock(t *testing.T) {
 			if !chain.Succeed(contract.Unlock(acct.Auth, zero)) {
 				t.Fatal("unable to redeem full balance")
 			}
+			if !chain.Succeed(contract.Withdraw(acct.Auth)) {
+				t.Fatal("unable to withdraw unlocked eth")
+			}
 		}
 	}
 
 	// limit == 0
 	t.Run("all", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		oldBal, _ := setup(t, accts[1])
 
 		if !chain.Succeed(contract.Unlock(accts[1].Auth, zero)) {
 			t.Fatal("unable to redeem")
 		}
 
+		// Unlocked eth is credited, not sent
+		if wd, err := contract.Withdrawable(&bind.CallOpts{}, accts[1].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := big.NewInt(params.Ether); want.Cmp(wd) != 0 {
+			t.Fatalf("want withdrawable: %v, got: %v", want, wd)
+		}
+
+		if bal, err := chain.BalanceAt(context.Background(), accts[1].Addr, nil); err != nil {
+			t.Fatal(err)
+		} else if oldBal.Cmp(bal) != 0 {
+			t.Fatalf("want bal before withdraw: %v, got: %v", oldBal, bal)
+		}
+
+		if !chain.Succeed(contract.Withdraw(accts[1].Auth)) {
+			t.Fatal("unable to withdraw")
+		}
+
 		if newBal, err := chain.BalanceAt(context.Background(), accts[1].Addr, nil); err != nil {
 			t.Fatal(err)
 		} else if want := oldBal.Add(oldBal, big.NewInt(params.Ether)); want.Cmp(newBal) != 0 {
@@ -197,10 +492,15 @@ func TestUnlock(t *testing.T) {
 		if chain.Succeed(contract.Unlock(accts[1].Auth, zero)) {
 			t.Fatal("redeem with 0 balance should revert")
 		}
+
+		if chain.Succeed(contract.Withdraw(accts[1].Auth)) {
+			t.Fatal("withdraw with 0 withdrawable should revert")
+		}
 	})
 
 	// limit < acct.mint
 	t.Run("limit", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		oldBal, done := setup(t, accts[1])
 		defer done()
 
@@ -209,6 +509,10 @@ func TestUnlock(t *testing.T) {
 			t.Fatal("unable to redeem")
 		}
 
+		if !chain.Succeed(contract.Withdraw(accts[1].Auth)) {
+			t.Fatal("unable to withdraw")
+		}
+
 		if newBal, err := chain.BalanceAt(context.Background(), accts[1].Addr, nil); err != nil {
 			t.Fatal(err)
 		} else if want := oldBal.Add(oldBal, big.NewInt(5e17)); want.Cmp(newBal) != 0 {
@@ -225,6 +529,7 @@ func TestUnlock(t *testing.T) {
 
 	// limit > acct.mint
 	t.Run("limitExceedsMint", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		oldBal, _ := setup(t, accts[1])
 
 		// redeem 5000usdx
@@ -232,6 +537,10 @@ func TestUnlock(t *testing.T) {
 			t.Fatal("unable to redeem")
 		}
 
+		if !chain.Succeed(contract.Withdraw(accts[1].Auth)) {
+			t.Fatal("unable to withdraw")
+		}
+
 		if newBal, err := chain.BalanceAt(context.Background(), accts[1].Addr, nil); err != nil {
 			t.Fatal(err)
 		} else if want := oldBal.Add(oldBal, big.NewInt(params.Ether)); want.Cmp(newBal) != 0 {
@@ -248,6 +557,7 @@ func TestUnlock(t *testing.T) {
 
 	// usdx bal < acct.mint
 	t.Run("lowUSDXBal", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		oldBal, cleanup := setup(t, accts[1])
 
 		// transfer 200usdx to another account
@@ -260,6 +570,10 @@ func TestUnlock(t *testing.T) {
 			t.Fatal("unable to redeem")
 		}
 
+		if !chain.Succeed(contract.Withdraw(accts[1].Auth)) {
+			t.Fatal("unable to withdraw")
+		}
+
 		// only get .8eth back
 		if newBal, err := chain.BalanceAt(context.Background(), accts[1].Addr, nil); err != nil {
 			t.Fatal(err)
@@ -281,6 +595,7 @@ func TestUnlock(t *testing.T) {
 
 	// usdx bal > acct.mint
 	t.Run("balExceedsMint", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		oldBal, _ := setup(t, accts[1])
 		_, cleanup := setup(t, accts[2])
 		defer cleanup()
@@ -295,6 +610,10 @@ func TestUnlock(t *testing.T) {
 			t.Fatal("unable to redeem")
 		}
 
+		if !chain.Succeed(contract.Withdraw(accts[1].Auth)) {
+			t.Fatal("unable to withdraw")
+		}
+
 		// get full eth back
 		if newBal, err := chain.BalanceAt(context.Background(), accts[1].Addr, nil); err != nil {
 			t.Fatal(err)
@@ -320,6 +639,7 @@ func TestUnlock(t *testing.T) {
 
 	// mint, redeem, mint, redeem suceeds
 	t.Run("doubleUnlock", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		_, redeem := setup(t, accts[1])
 		redeem()
 		_, redeem = setup(t, accts[1])
@@ -327,272 +647,2006 @@ func TestUnlock(t *testing.T) {
 	})
 }
 
-func TestAppreciation(t *testing.T) {
-	chain, accts := soltest.New()
+// walletBin is the init code of a minimal smart contract wallet.
+// Calldata of the form (address target, uint256 value, bytes data)
+// is forwarded as a call to target with value and data.  Receiving
+// eth (empty calldata) adds msg.value to storage slot 0, which costs
+// more than the 2300 gas stipend forwarded by transfer.
+const walletBin = "0x603880600b6000396000f3" +
+	"3615602e573660409003806040600037600060008260006020356000355af1" +
+	"602c573d600060003e3d6000fd5b005b346000540160005500"
+
+// walletCall returns calldata which makes the wallet call target.
+func walletCall(target common.Address, value *big.Int, data []byte) []byte {
+	out := common.LeftPadBytes(target.Bytes(), 32)
+	out = append(out, common.LeftPadBytes(value.Bytes(), 32)...)
+	return append(out, data...)
+}
 
-	oracleAddr, _, oracleContract, err := DeployMockOracle(accts[0].Auth, chain)
-	if err != nil {
-		t.Fatal(err)
+func TestWithdraw(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contractAddr, contract := env.Chain, env.Accounts, env.Oracle, env.USDXAddr, env.USDX
+
+	// Set rate to 1000usd/eth
+	if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(1000e8), zero, zero, zero)) {
+		t.Fatal("unable to set oracle round")
 	}
-	chain.Commit()
 
-	_, _, contract, err := DeployUSDX(accts[0].Auth, chain, oracleAddr)
+	usdxABI, err := abi.JSON(strings.NewReader(USDXABI))
 	if err != nil {
 		t.Fatal(err)
 	}
-	chain.Commit()
 
-	t.Run("doubleCollect", func(t *testing.T) {
-		// rate starts at 100usd/eth
-		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(100e8), zero, zero, zero)) {
-			t.Fatal("unable to set oracle round")
+	t.Run("contractWallet", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		walletAddr, _, wallet, err := bind.DeployContract(accts[1].Auth, abi.ABI{}, common.FromHex(walletBin), chain)
+		if err != nil {
+			t.Fatal(err)
 		}
+		chain.Commit()
 
-		// mint 100 usdx
-		accts[0].Auth.Value = big.NewInt(params.Ether)
-		if !chain.Succeed((&USDXRaw{contract}).Transfer(accts[0].Auth)) {
-			t.Fatal("unable to transfer")
+		// wallet mints 1000usdx
+		accts[1].Auth.Value = big.NewInt(params.Ether)
+		if !chain.Succeed(wallet.RawTransact(accts[1].Auth, walletCall(contractAddr, big.NewInt(params.Ether), nil))) {
+			t.Fatal("wallet unable to mint")
 		}
-		accts[0].Auth.Value = nil
+		accts[1].Auth.Value = nil
 
-		// rate increased to 150 usd/eth
-		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(150e8), zero, zero, zero)) {
-			t.Fatal("unable to set oracle round")
+		if bal, err := contract.BalanceOf(&bind.CallOpts{}, walletAddr); err != nil {
+			t.Fatal(err)
+		} else if want := bigint(1000, usdx); bal.Cmp(want) != 0 {
+			t.Fatalf("want wallet usdx bal: %v, got: %v", want, bal)
 		}
 
-		// collect 50 usdx
-		oldBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr)
+		data, err := usdxABI.Pack("unlock", zero)
 		if err != nil {
 			t.Fatal(err)
 		}
-
-		if !chain.Succeed(contract.CollectAppreciation(accts[0].Auth, zero)) {
-			t.Fatal("unable to collect appreciation")
+		if !chain.Succeed(wallet.RawTransact(accts[1].Auth, walletCall(contractAddr, zero, data))) {
+			t.Fatal("wallet unable to unlock")
 		}
 
-		if newBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr); err != nil {
+		data, err = usdxABI.Pack("withdraw")
+		if err != nil {
 			t.Fatal(err)
-		} else if want := oldBal.Add(oldBal, bigint(50, usdx)); newBal.Cmp(want) != 0 {
-			t.Fatalf("want usdx balance: %v, got: %v", want, newBal)
 		}
-
-		// rate drops to 120, can't collect anything
-		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(100e8), zero, zero, zero)) {
-			t.Fatal("unable to set oracle round")
+		if !chain.Succeed(wallet.RawTransact(accts[1].Auth, walletCall(contractAddr, zero, data))) {
+			t.Fatal("wallet unable to withdraw")
 		}
 
-		if appr, err := contract.Appreciation(&bind.CallOpts{}, accts[0].Addr); err != nil {
+		if bal, err := chain.BalanceAt(context.Background(), walletAddr, nil); err != nil {
 			t.Fatal(err)
-		} else if appr.Cmp(zero) != 0 {
-			t.Fatalf("want appreciation: %v, got: %v", zero, appr)
-		}
-
-		// collect again @ 200
-		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(200e8), zero, zero, zero)) {
-			t.Fatal("unable to set oracle round")
-		}
-
-		if !chain.Succeed(contract.CollectAppreciation(accts[0].Auth, zero)) {
-			t.Fatal("unable to collect appreciation")
+		} else if want := big.NewInt(params.Ether); bal.Cmp(want) != 0 {
+			t.Fatalf("want wallet eth bal: %v, got: %v", want, bal)
 		}
 
-		if newBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr); err != nil {
+		// wallet's receive logic ran with more than 2300 gas
+		if recv, err := chain.StorageAt(context.Background(), walletAddr, common.Hash{}, nil); err != nil {
 			t.Fatal(err)
-		} else if want := oldBal.Add(oldBal, bigint(50, usdx)); newBal.Cmp(want) != 0 {
-			t.Fatalf("want usdx balance: %v, got: %v", want, newBal)
+		} else if want := big.NewInt(params.Ether); new(big.Int).SetBytes(recv).Cmp(want) != 0 {
+			t.Fatalf("want wallet received: %v, got: %x", want, recv)
 		}
 
-		// rate increases to 250, can collect 50
-		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(250e8), zero, zero, zero)) {
-			t.Fatal("unable to set oracle round")
-		}
-		if appr, err := contract.Appreciation(&bind.CallOpts{}, accts[0].Addr); err != nil {
+		if wd, err := contract.Withdrawable(&bind.CallOpts{}, walletAddr); err != nil {
 			t.Fatal(err)
-		} else if want := bigint(50, usdx); appr.Cmp(want) != 0 {
-			t.Fatalf("want appreciation: %v, got: %v", want, appr)
+		} else if wd.Cmp(zero) != 0 {
+			t.Fatalf("want withdrawable: %v, got: %v", zero, wd)
 		}
+	})
 
-		// rate increases to 300, can collect 100
-		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(300e8), zero, zero, zero)) {
-			t.Fatal("unable to set oracle round")
-		}
-		if appr, err := contract.Appreciation(&bind.CallOpts{}, accts[0].Addr); err != nil {
-			t.Fatal(err)
-		} else if want := bigint(100, usdx); appr.Cmp(want) != 0 {
-			t.Fatalf("want appreciation: %v, got: %v", want, appr)
+	t.Run("accumulates", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		accts[2].Auth.Value = big.NewInt(params.Ether)
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[2].Auth)) {
+			t.Fatal("unable to transfer")
 		}
+		accts[2].Auth.Value = nil
 
-		// redeem everything to reset
-		if !chain.Succeed(contract.Unlock(accts[0].Auth, zero)) {
+		// unlock in 2 parts, withdraw once
+		if !chain.Succeed(contract.Unlock(accts[2].Auth, bigint(400, usdx))) {
 			t.Fatal("unable to redeem")
 		}
-	})
-
-	t.Run("limitOverAppr", func(t *testing.T) {
-		// rate starts at 100usd/eth
-		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(100e8), zero, zero, zero)) {
-			t.Fatal("unable to set oracle round")
-		}
-
-		// mint 100 usdx
-		accts[0].Auth.Value = big.NewInt(params.Ether)
-		if !chain.Succeed((&USDXRaw{contract}).Transfer(accts[0].Auth)) {
-			t.Fatal("unable to transfer")
+		if !chain.Succeed(contract.Unlock(accts[2].Auth, zero)) {
+			t.Fatal("unable to redeem")
 		}
-		accts[0].Auth.Value = nil
 
-		// rate increased to 150 usd/eth
-		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(150e8), zero, zero, zero)) {
-			t.Fatal("unable to set oracle round")
+		if wd, err := contract.Withdrawable(&bind.CallOpts{}, accts[2].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := big.NewInt(params.Ether); wd.Cmp(want) != 0 {
+			t.Fatalf("want withdrawable: %v, got: %v", want, wd)
 		}
 
-		oldBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr)
+		oldBal, err := chain.BalanceAt(context.Background(), accts[2].Addr, nil)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		if !chain.Succeed(contract.CollectAppreciation(accts[0].Auth, bigint(100, usdx))) {
-			t.Fatal("unable to collect appreciation")
+		if !chain.Succeed(contract.Withdraw(accts[2].Auth)) {
+			t.Fatal("unable to withdraw")
 		}
 
-		if newBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr); err != nil {
+		if newBal, err := chain.BalanceAt(context.Background(), accts[2].Addr, nil); err != nil {
 			t.Fatal(err)
-		} else if want := oldBal.Add(oldBal, bigint(50, usdx)); newBal.Cmp(want) != 0 {
-			t.Fatalf("want usdx balance: %v, got: %v", want, newBal)
+		} else if want := oldBal.Add(oldBal, big.NewInt(params.Ether)); want.Cmp(newBal) != 0 {
+			t.Fatalf("want new bal: %v, got: %v", want, newBal)
+		}
+	})
+}
+
+func TestLots(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contractAddr, contract := env.Chain, env.Accounts, env.Oracle, env.USDXAddr, env.USDX
+
+	setRate := func(t *testing.T, usd int64) {
+		t.Helper()
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(usd, rate), zero, zero, zero)) {
+			t.Fatalf("unable to set oracle round: rate=%v", usd)
+		}
+	}
+	mint := func(t *testing.T, acct usdxtest.Account) {
+		t.Helper()
+		acct.Auth.Value = big.NewInt(params.Ether)
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(acct.Auth)) {
+			t.Fatal("unable to transfer")
+		}
+		acct.Auth.Value = nil
+	}
+	lots := func(t *testing.T, addr common.Address) []Lot {
+		t.Helper()
+		lots, err := AccountLots(&bind.CallOpts{}, chain, contractAddr, addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return lots
+	}
+	// assertLots checks addr's lots' locked eth (in 1/100 eth) and
+	// mint.
+	assertLots := func(t *testing.T, addr common.Address, want ...[2]int64) {
+		t.Helper()
+		got := lots(t, addr)
+		if len(got) != len(want) {
+			t.Fatalf("want %d lots, got: %d", len(want), len(got))
+		}
+		for i, l := range got {
+			if locked, mint := big.NewInt(want[i][0]*(params.Ether/100)), bigint(want[i][1], usdx); l.Locked.Cmp(locked) != 0 || l.Mint.Cmp(mint) != 0 {
+				t.Errorf("lot %d: want locked: %v, mint: %v, got: %v, %v", i, locked, mint, l.Locked, l.Mint)
+			}
+		}
+	}
+	assertWithdrawable := func(t *testing.T, addr common.Address, centiEth int64) {
+		t.Helper()
+		if wd, err := contract.Withdrawable(&bind.CallOpts{}, addr); err != nil {
+			t.Fatal(err)
+		} else if want := big.NewInt(centiEth * (params.Ether / 100)); wd.Cmp(want) != 0 {
+			t.Errorf("want withdrawable: %v, got: %v", want, wd)
+		}
+	}
+
+	// accts[1] mints 1 eth at 1000usd/eth, and 1 eth at 4000usd/eth.
+	setRate(t, 1000)
+	mint(t, accts[1])
+	setRate(t, 4000)
+	mint(t, accts[1])
+	assertLots(t, accts[1].Addr, [2]int64{100, 1000}, [2]int64{100, 4000})
+
+	t.Run("unlockFIFO", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// all of lot 1, and a quarter of lot 2
+		if !chain.Succeed(contract.Unlock(accts[1].Auth, bigint(2000, usdx))) {
+			t.Fatal("unable to unlock")
+		}
+		assertWithdrawable(t, accts[1].Addr, 125)
+		assertLots(t, accts[1].Addr, [2]int64{75, 3000})
+
+		acct, err := contract.Accounts(&bind.CallOpts{}, accts[1].Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := big.NewInt(75 * (params.Ether / 100)); acct.Locked.Cmp(want) != 0 || acct.Mint.Cmp(bigint(3000, usdx)) != 0 {
+			t.Errorf("want locked: %v, mint: %v, got: %v, %v", want, bigint(3000, usdx), acct.Locked, acct.Mint)
+		}
+	})
+
+	t.Run("unlockLIFO", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// half of lot 2
+		if !chain.Succeed(contract.UnlockLots(accts[1].Auth, bigint(2000, usdx), LIFO)) {
+			t.Fatal("unable to unlock")
+		}
+		assertWithdrawable(t, accts[1].Addr, 50)
+		assertLots(t, accts[1].Addr, [2]int64{100, 1000}, [2]int64{50, 2000})
+
+		// rest of lot 2, and half of lot 1
+		if !chain.Succeed(contract.UnlockLots(accts[1].Auth, bigint(2500, usdx), LIFO)) {
+			t.Fatal("unable to unlock")
+		}
+		assertWithdrawable(t, accts[1].Addr, 150)
+		assertLots(t, accts[1].Addr, [2]int64{50, 500})
+	})
+
+	t.Run("unlockAll", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if !chain.Succeed(contract.UnlockLots(accts[1].Auth, zero, LIFO)) {
+			t.Fatal("unable to unlock")
+		}
+		assertWithdrawable(t, accts[1].Addr, 200)
+		assertLots(t, accts[1].Addr)
+
+		acct, err := contract.Accounts(&bind.CallOpts{}, accts[1].Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if acct.Locked.Sign() != 0 || acct.Mint.Sign() != 0 || acct.FirstLot.Sign() != 0 || acct.LastLot.Sign() != 0 {
+			t.Errorf("want empty account, got: %+v", acct)
+		}
+	})
+
+	t.Run("unlockLot", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		ls := lots(t, accts[1].Addr)
+		if chain.Succeed(contract.UnlockLot(accts[2].Auth, ls[1].ID, zero)) {
+			t.Error("non-owner shouldn't unlock lot")
+		}
+		if chain.Succeed(contract.UnlockLot(accts[1].Auth, big.NewInt(99), zero)) {
+			t.Error("shouldn't unlock nonexistent lot")
+		}
+
+		if !chain.Succeed(contract.UnlockLot(accts[1].Auth, ls[1].ID, bigint(1000, usdx))) {
+			t.Fatal("unable to unlock lot")
+		}
+		if want := ls[1].Unlocks(bigint(1000, usdx)); want.Cmp(big.NewInt(25*(params.Ether/100))) != 0 {
+			t.Errorf("want lot to unlock: %v, got: %v", 25*(params.Ether/100), want)
+		}
+		assertWithdrawable(t, accts[1].Addr, 25)
+		assertLots(t, accts[1].Addr, [2]int64{100, 1000}, [2]int64{75, 3000})
+
+		// the rest of the lot, which is removed
+		if !chain.Succeed(contract.UnlockLot(accts[1].Auth, ls[1].ID, zero)) {
+			t.Fatal("unable to unlock lot")
+		}
+		assertWithdrawable(t, accts[1].Addr, 100)
+		assertLots(t, accts[1].Addr, [2]int64{100, 1000})
+		if chain.Succeed(contract.UnlockLot(accts[1].Auth, ls[1].ID, zero)) {
+			t.Error("shouldn't unlock removed lot")
+		}
+
+		// a new lot is appended after the remaining lot
+		mint(t, accts[1])
+		assertLots(t, accts[1].Addr, [2]int64{100, 1000}, [2]int64{100, 4000})
+		if ls2 := lots(t, accts[1].Addr); ls2[0].ID.Cmp(ls[0].ID) != 0 || ls2[1].ID.Cmp(ls[1].ID) <= 0 {
+			t.Errorf("unexpected lot ids: %v, %v", ls2[0].ID, ls2[1].ID)
+		}
+	})
+
+	t.Run("lowUSDXBal", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// only 1000 usdx is left to unlock with
+		if !chain.Succeed(contract.Transfer(accts[1].Auth, accts[2].Addr, bigint(4000, usdx))) {
+			t.Fatal("unable to transfer usdx")
+		}
+		if !chain.Succeed(contract.UnlockLots(accts[1].Auth, zero, LIFO)) {
+			t.Fatal("unable to unlock")
+		}
+		assertWithdrawable(t, accts[1].Addr, 25)
+		assertLots(t, accts[1].Addr, [2]int64{100, 1000}, [2]int64{75, 3000})
+	})
+
+	t.Run("appreciation", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// Lot 1 appreciates by 1500usdx; lot 2 depreciates by
+		// 1500usdx, which doesn't offset lot 1.
+		setRate(t, 2500)
+		ls := lots(t, accts[1].Addr)
+		if ls[0].Appreciation.Cmp(bigint(1500, usdx)) != 0 || ls[1].Appreciation.Sign() != 0 {
+			t.Errorf("want lot appreciation: 1500, 0, got: %v, %v", ls[0].Appreciation, ls[1].Appreciation)
+		}
+		if appr, err := contract.Appreciation(&bind.CallOpts{}, accts[1].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := bigint(1500, usdx); appr.Cmp(want) != 0 {
+			t.Errorf("want appreciation: %v, got: %v", want, appr)
+		}
+
+		if !chain.Succeed(contract.CollectLotAppreciation(accts[1].Auth, ls[1].ID, zero)) {
+			t.Fatal("unable to collect lot appreciation")
+		}
+		if chain.Succeed(contract.CollectLotAppreciation(accts[2].Auth, ls[0].ID, zero)) {
+			t.Error("non-owner shouldn't collect lot appreciation")
+		}
+		if !chain.Succeed(contract.CollectLotAppreciation(accts[1].Auth, ls[0].ID, bigint(500, usdx))) {
+			t.Fatal("unable to collect lot appreciation")
+		}
+		assertLots(t, accts[1].Addr, [2]int64{100, 1500}, [2]int64{100, 4000})
+
+		if !chain.Succeed(contract.CollectAppreciation(accts[1].Auth, zero)) {
+			t.Fatal("unable to collect appreciation")
+		}
+		assertLots(t, accts[1].Addr, [2]int64{100, 2500}, [2]int64{100, 4000})
+	})
+
+	t.Run("collectLimit", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// lot 1 appreciates by 4000usdx, lot 2 by 1000usdx
+		setRate(t, 5000)
+		if !chain.Succeed(contract.CollectAppreciation(accts[1].Auth, bigint(4500, usdx))) {
+			t.Fatal("unable to collect appreciation")
+		}
+		assertLots(t, accts[1].Addr, [2]int64{100, 5000}, [2]int64{100, 4500})
+
+		if bal, err := contract.BalanceOf(&bind.CallOpts{}, accts[1].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := bigint(9500, usdx); bal.Cmp(want) != 0 {
+			t.Errorf("want bal: %v, got: %v", want, bal)
+		}
+	})
+
+	t.Run("transferAcct", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		ls := lots(t, accts[1].Addr)
+		if !chain.Succeed(contract.SetLotSender(accts[3].Auth, accts[1].Addr, true)) {
+			t.Fatal("unable to allow lot sender")
+		}
+		if !chain.Succeed(contract.TransferAcct(accts[1].Auth, accts[3].Addr)) {
+			t.Fatal("unable to transfer account")
+		}
+		assertLots(t, accts[1].Addr)
+		assertLots(t, accts[3].Addr, [2]int64{100, 1000}, [2]int64{100, 4000})
+
+		if !chain.Succeed(contract.Transfer(accts[1].Auth, accts[3].Addr, bigint(5000, usdx))) {
+			t.Fatal("unable to transfer usdx")
+		}
+		if chain.Succeed(contract.UnlockLot(accts[1].Auth, ls[0].ID, zero)) {
+			t.Error("previous owner shouldn't unlock lot")
+		}
+		if !chain.Succeed(contract.UnlockLot(accts[3].Auth, ls[0].ID, zero)) {
+			t.Fatal("new owner unable to unlock lot")
+		}
+		assertWithdrawable(t, accts[3].Addr, 100)
+		assertLots(t, accts[3].Addr, [2]int64{100, 4000})
+	})
+}
+
+func TestPositions(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contractAddr, contract := env.Chain, env.Accounts, env.Oracle, env.USDXAddr, env.USDX
+
+	// Set rate to 1000usd/eth
+	if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(1000e8), zero, zero, zero)) {
+		t.Fatal("unable to set oracle round")
+	}
+
+	positionsAddr, err := contract.Positions(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	positions, err := NewUSDXPositions(positionsAddr, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := positions.Usdx(&bind.CallOpts{}); err != nil {
+		t.Fatal(err)
+	} else if got != contractAddr {
+		t.Fatalf("want positions usdx: %s, got: %s", contractAddr.Hex(), got.Hex())
+	}
+	// accts[1] and accts[2] accept each other's lots, and accts[3]
+	// accepts accts[1]'s.
+	for _, allow := range [][2]usdxtest.Account{{accts[1], accts[2]}, {accts[2], accts[1]}, {accts[3], accts[1]}} {
+		if !chain.Succeed(contract.SetLotSender(allow[0].Auth, allow[1].Addr, true)) {
+			t.Fatal("unable to allow lot sender")
+		}
+	}
+
+	// mint mints with 1 eth, and returns the lot's id.
+	mint := func(t *testing.T, acct usdxtest.Account) *big.Int {
+		t.Helper()
+		acct.Auth.Value = big.NewInt(params.Ether)
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(acct.Auth)) {
+			t.Fatal("unable to transfer")
+		}
+		acct.Auth.Value = nil
+		id, err := contract.LastLotId(&bind.CallOpts{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	assertOwner := func(t *testing.T, id *big.Int, want common.Address) {
+		t.Helper()
+		if got, err := positions.OwnerOf(&bind.CallOpts{}, id); err != nil {
+			t.Fatal(err)
+		} else if got != want {
+			t.Errorf("want token %v owner: %s, got: %s", id, want.Hex(), got.Hex())
+		}
+		if l, err := LotByID(&bind.CallOpts{}, chain, contractAddr, id); err != nil {
+			t.Fatal(err)
+		} else if l.Owner != want {
+			t.Errorf("want lot %v owner: %s, got: %s", id, want.Hex(), l.Owner.Hex())
+		}
+	}
+	assertAcct := func(t *testing.T, addr common.Address, locked, mint *big.Int) {
+		t.Helper()
+		acct, err := contract.Accounts(&bind.CallOpts{}, addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if acct.Locked.Cmp(locked) != 0 || acct.Mint.Cmp(mint) != 0 {
+			t.Errorf("%s: want locked: %v, mint: %v, got: %v, %v", addr.Hex(), locked, mint, acct.Locked, acct.Mint)
+		}
+	}
+	// assertMoved asserts lots ids, of 1 eth and 1000 usdx each, are
+	// the lots logged moving from from to to.
+	assertMoved := func(t *testing.T, from, to common.Address, ids ...*big.Int) {
+		t.Helper()
+		it, err := contract.FilterLotTransferred(&bind.FilterOpts{}, []common.Address{from}, []common.Address{to}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []*big.Int
+		for it.Next() {
+			if it.Event.Locked.Cmp(big.NewInt(params.Ether)) != 0 || it.Event.Mint.Cmp(bigint(1000, usdx)) != 0 {
+				t.Errorf("lot %v: want 1 eth, 1000 usdx moved, got: %v, %v", it.Event.Id, it.Event.Locked, it.Event.Mint)
+			}
+			got = append(got, it.Event.Id)
+		}
+		if !reflect.DeepEqual(got, ids) {
+			t.Errorf("want lots %v moved from %s to %s, got: %v", ids, from.Hex(), to.Hex(), got)
+		}
+	}
+
+	t.Run("mint", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		id := mint(t, accts[1])
+		assertOwner(t, id, accts[1].Addr)
+		if bal, err := positions.BalanceOf(&bind.CallOpts{}, accts[1].Addr); err != nil {
+			t.Fatal(err)
+		} else if bal.Cmp(big.NewInt(1)) != 0 {
+			t.Errorf("want 1 token, got: %v", bal)
+		}
+	})
+
+	t.Run("transferThenUnlock", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		id := mint(t, accts[1])
+		if !chain.Succeed(positions.TransferFrom(accts[1].Auth, accts[1].Addr, accts[2].Addr, id)) {
+			t.Fatal("unable to transfer position")
+		}
+		assertOwner(t, id, accts[2].Addr)
+		assertAcct(t, accts[1].Addr, zero, zero)
+		assertAcct(t, accts[2].Addr, big.NewInt(params.Ether), bigint(1000, usdx))
+		assertMoved(t, accts[1].Addr, accts[2].Addr, id)
+
+		// The usdx stays with the previous holder, who can't unlock.
+		if chain.Succeed(contract.UnlockLot(accts[1].Auth, id, zero)) {
+			t.Error("previous holder shouldn't unlock")
+		}
+		if chain.Succeed(contract.Unlock(accts[2].Auth, zero)) {
+			t.Error("holder shouldn't unlock without usdx")
+		}
+		if !chain.Succeed(contract.Transfer(accts[1].Auth, accts[2].Addr, bigint(1000, usdx))) {
+			t.Fatal("unable to transfer usdx")
+		}
+		if !chain.Succeed(contract.UnlockLot(accts[2].Auth, id, zero)) {
+			t.Fatal("holder unable to unlock")
+		}
+		if wd, err := contract.Withdrawable(&bind.CallOpts{}, accts[2].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := big.NewInt(params.Ether); wd.Cmp(want) != 0 {
+			t.Errorf("want withdrawable: %v, got: %v", want, wd)
+		}
+
+		// The fully unlocked lot's token is burned.
+		if _, err := positions.OwnerOf(&bind.CallOpts{}, id); err == nil {
+			t.Error("want unlocked lot's token burned")
+		}
+		if _, err := LotByID(&bind.CallOpts{}, chain, contractAddr, id); err != ErrNoLot {
+			t.Errorf("want err: %v, got: %v", ErrNoLot, err)
+		}
+	})
+
+	t.Run("transferToAccount", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		own := mint(t, accts[2])
+		id := mint(t, accts[1])
+		// accts[3] is approved to transfer accts[1]'s token
+		if !chain.Succeed(positions.Approve(accts[1].Auth, accts[3].Addr, id)) {
+			t.Fatal("unable to approve")
+		}
+		if !chain.Succeed(positions.SafeTransferFrom(accts[3].Auth, accts[1].Addr, accts[2].Addr, id)) {
+			t.Fatal("unable to transfer position")
+		}
+		assertAcct(t, accts[2].Addr, big.NewInt(2*params.Ether), bigint(2000, usdx))
+		lots, err := AccountLots(&bind.CallOpts{}, chain, contractAddr, accts[2].Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(lots) != 2 || lots[0].ID.Cmp(own) != 0 || lots[1].ID.Cmp(id) != 0 {
+			t.Errorf("want lots %v, %v, got: %+v", own, id, lots)
+		}
+
+		// and back
+		if !chain.Succeed(positions.TransferFrom(accts[2].Auth, accts[2].Addr, accts[1].Addr, id)) {
+			t.Fatal("unable to transfer position")
+		}
+		assertAcct(t, accts[1].Addr, big.NewInt(params.Ether), bigint(1000, usdx))
+		assertAcct(t, accts[2].Addr, big.NewInt(params.Ether), bigint(1000, usdx))
+	})
+
+	t.Run("mintAtSamePrice", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		id := mint(t, accts[1])
+		if again := mint(t, accts[1]); again.Cmp(id) != 0 {
+			t.Fatalf("want mint added to lot %v, got lot %v", id, again)
+		}
+		assertOwner(t, id, accts[1].Addr)
+		assertAcct(t, accts[1].Addr, big.NewInt(2*params.Ether), bigint(2000, usdx))
+		if l, err := LotByID(&bind.CallOpts{}, chain, contractAddr, id); err != nil {
+			t.Fatal(err)
+		} else if l.Locked.Cmp(big.NewInt(2*params.Ether)) != 0 || l.Mint.Cmp(bigint(2000, usdx)) != 0 {
+			t.Errorf("want lot of 2 eth, 2000 usdx, got: %v, %v", l.Locked, l.Mint)
+		}
+		if bal, err := positions.BalanceOf(&bind.CallOpts{}, accts[1].Addr); err != nil {
+			t.Fatal(err)
+		} else if bal.Cmp(big.NewInt(1)) != 0 {
+			t.Errorf("want 1 token, got: %v", bal)
+		}
+	})
+
+	t.Run("transferAcct", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// accts[1] holds 2 lots: its own, and accts[2]'s.
+		id1, id2 := mint(t, accts[1]), mint(t, accts[2])
+		if !chain.Succeed(positions.TransferFrom(accts[2].Auth, accts[2].Addr, accts[1].Addr, id2)) {
+			t.Fatal("unable to transfer position")
+		}
+		if !chain.Succeed(contract.TransferAcct(accts[1].Auth, accts[3].Addr)) {
+			t.Fatal("unable to transfer account")
+		}
+		assertOwner(t, id1, accts[3].Addr)
+		assertOwner(t, id2, accts[3].Addr)
+		assertMoved(t, accts[1].Addr, accts[3].Addr, id1, id2)
+	})
+
+	t.Run("onlyUSDX", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		id := mint(t, accts[1])
+		if chain.Succeed(positions.Mint(accts[1].Auth, accts[1].Addr, big.NewInt(99))) {
+			t.Error("shouldn't mint positions")
+		}
+		if chain.Succeed(positions.Burn(accts[1].Auth, id)) {
+			t.Error("shouldn't burn positions")
+		}
+		if chain.Succeed(positions.Move(accts[2].Auth, accts[1].Addr, accts[2].Addr, id)) {
+			t.Error("shouldn't move positions")
+		}
+		if chain.Succeed(contract.TransferLot(accts[2].Auth, accts[1].Addr, accts[2].Addr, id)) {
+			t.Error("shouldn't transfer lots")
+		}
+		if chain.Succeed(positions.TransferFrom(accts[2].Auth, accts[1].Addr, accts[2].Addr, id)) {
+			t.Error("unapproved account shouldn't transfer position")
+		}
+		assertOwner(t, id, accts[1].Addr)
+	})
+
+	t.Run("notAllowed", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		id := mint(t, accts[1])
+		if chain.Succeed(positions.TransferFrom(accts[1].Auth, accts[1].Addr, accts[4].Addr, id)) {
+			t.Error("shouldn't transfer position to a recipient which doesn't allow holder")
+		}
+		// An account is only transferred to a recipient with lots
+		// if it allows the holder.
+		mint(t, accts[4])
+		if chain.Succeed(contract.TransferAcct(accts[1].Auth, accts[4].Addr)) {
+			t.Error("shouldn't transfer account to a recipient which doesn't allow holder")
+		}
+		assertOwner(t, id, accts[1].Addr)
+	})
+}
+
+func TestAppreciation(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, oracleContract, contract := env.Chain, env.Oracle, env.USDX
+
+	t.Run("doubleCollect", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// rate starts at 100usd/eth
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(100e8), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+
+		// mint 100 usdx
+		accts[0].Auth.Value = big.NewInt(params.Ether)
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[0].Auth)) {
+			t.Fatal("unable to transfer")
+		}
+		accts[0].Auth.Value = nil
+
+		// rate increased to 150 usd/eth
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(150e8), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+
+		// collect 50 usdx
+		oldBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !chain.Succeed(contract.CollectAppreciation(accts[0].Auth, zero)) {
+			t.Fatal("unable to collect appreciation")
+		}
+
+		if newBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := oldBal.Add(oldBal, bigint(50, usdx)); newBal.Cmp(want) != 0 {
+			t.Fatalf("want usdx balance: %v, got: %v", want, newBal)
+		}
+
+		// rate drops to 120, can't collect anything
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(100e8), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+
+		if appr, err := contract.Appreciation(&bind.CallOpts{}, accts[0].Addr); err != nil {
+			t.Fatal(err)
+		} else if appr.Cmp(zero) != 0 {
+			t.Fatalf("want appreciation: %v, got: %v", zero, appr)
+		}
+
+		// collect again @ 200
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(200e8), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+
+		if !chain.Succeed(contract.CollectAppreciation(accts[0].Auth, zero)) {
+			t.Fatal("unable to collect appreciation")
+		}
+
+		if newBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := oldBal.Add(oldBal, bigint(50, usdx)); newBal.Cmp(want) != 0 {
+			t.Fatalf("want usdx balance: %v, got: %v", want, newBal)
+		}
+
+		// rate increases to 250, can collect 50
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(250e8), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+		if appr, err := contract.Appreciation(&bind.CallOpts{}, accts[0].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := bigint(50, usdx); appr.Cmp(want) != 0 {
+			t.Fatalf("want appreciation: %v, got: %v", want, appr)
+		}
+
+		// rate increases to 300, can collect 100
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(300e8), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+		if appr, err := contract.Appreciation(&bind.CallOpts{}, accts[0].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := bigint(100, usdx); appr.Cmp(want) != 0 {
+			t.Fatalf("want appreciation: %v, got: %v", want, appr)
+		}
+		// redeem everything to reset
+		if !chain.Succeed(contract.Unlock(accts[0].Auth, zero)) {
+			t.Fatal("unable to redeem")
+		}
+	})
+
+	t.Run("limitOverAppr", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// rate starts at 100usd/eth
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(100e8), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+
+		// mint 100 usdx
+		accts[0].Auth.Value = big.NewInt(params.Ether)
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[0].Auth)) {
+			t.Fatal("unable to transfer")
+		}
+		accts[0].Auth.Value = nil
+
+		// rate increased to 150 usd/eth
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(150e8), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+
+		oldBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !chain.Succeed(contract.CollectAppreciation(accts[0].Auth, bigint(100, usdx))) {
+			t.Fatal("unable to collect appreciation")
+		}
+
+		if newBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := oldBal.Add(oldBal, bigint(50, usdx)); newBal.Cmp(want) != 0 {
+			t.Fatalf("want usdx balance: %v, got: %v", want, newBal)
+		}
+		// redeem everything to reset
+		if !chain.Succeed(contract.Unlock(accts[0].Auth, zero)) {
+			t.Fatal("unable to redeem")
+		}
+	})
+
+	t.Run("limitUnderAppr", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// rate starts at 100usd/eth
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(100e8), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+
+		// mint 100 usdx
+		accts[0].Auth.Value = big.NewInt(params.Ether)
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[0].Auth)) {
+			t.Fatal("unable to transfer")
+		}
+		accts[0].Auth.Value = nil
+
+		// rate increased to 150 usd/eth
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(150e8), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+
+		oldBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !chain.Succeed(contract.CollectAppreciation(accts[0].Auth, bigint(25, usdx))) {
+			t.Fatal("unable to collect appreciation")
+		}
+
+		if newBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := oldBal.Add(oldBal, bigint(25, usdx)); newBal.Cmp(want) != 0 {
+			t.Fatalf("want usdx balance: %v, got: %v", want, newBal)
+		}
+		// redeem everything to reset
+		if !chain.Succeed(contract.Unlock(accts[0].Auth, zero)) {
+			t.Fatal("unable to redeem")
+		}
+	})
+
+	tests := []struct {
+		prices  []int64
+		expAppr int64
+	}{
+		{[]int64{100}, 0},
+		{[]int64{100, 90}, 0},
+		{[]int64{100, 110}, 10},
+		{[]int64{100, 90, 150}, 110},
+		{[]int64{100, 110, 150}, 90},
+		{[]int64{100, 90, 50}, 0},
+		{[]int64{100, 110, 90, 150}, 150},
+		// Each deposit is a separate lot, so the 100 deposit is able
+		// to collect 20usdx of appreciation at 120, even though the
+		// 150 deposit is 30usdx underwater.  The basis isn't
+		// averaged, as it would be if the deposits were combined.
+		{[]int64{100, 150, 120}, 20},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			accts := env.Isolate(t).Accounts
+			// Mint 1 eth at each price
+			for _, price := range test.prices {
+				// set oracle to the price
+				if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(price, rate), zero, zero, zero)) {
+					t.Fatal("unable to set oracle round")
+				}
+
+				accts[0].Auth.Value = big.NewInt(params.Ether)
+				// mint 1 eth
+				if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[0].Auth)) {
+					t.Fatal("unable to transfer")
+				}
+				accts[0].Auth.Value = nil
+			}
+
+			// call appreciation, ensure it's expected
+			appr, err := contract.Appreciation(&bind.CallOpts{}, accts[0].Addr)
+			if err != nil {
+				t.Fatal(err)
+			} else if want := bigint(test.expAppr, usdx); appr.Cmp(want) != 0 {
+				t.Fatalf("want appreciation: %v, got: %v", want, appr)
+			}
+
+			oldBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !chain.Succeed(contract.CollectAppreciation(accts[0].Auth, zero)) {
+				t.Fatal("unable to collect appreciation")
+			}
+
+			if newBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr); err != nil {
+				t.Fatal(err)
+			} else if want := oldBal.Add(oldBal, bigint(test.expAppr, usdx)); newBal.Cmp(want) != 0 {
+				t.Fatalf("want usdx balance: %v, got: %v", want, newBal)
+			}
+			// redeem everything to reset
+			if !chain.Succeed(contract.Unlock(accts[0].Auth, zero)) {
+				t.Fatal("unable to redeem")
+			}
+		})
+	}
+}
+
+func TestOrders(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contract := env.Chain, env.Accounts, env.Oracle, env.USDX
+
+	setRate := func(t *testing.T, usd int64) {
+		t.Helper()
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(usd, rate), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+	}
+	setRate(t, 1000)
+	// accts[1] mints 1 eth for 1000usdx.
+	accts[1].Auth.Value = big.NewInt(params.Ether)
+	if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[1].Auth)) {
+		t.Fatal("unable to mint")
+	}
+	accts[1].Auth.Value = nil
+
+	place := func(t *testing.T, kind uint8, usd, amount int64) *big.Int {
+		t.Helper()
+		if !chain.Succeed(contract.PlaceOrder(accts[1].Auth, kind, bigint(usd, rate), bigint(amount, usdx))) {
+			t.Fatal("unable to place order")
+		}
+		id, err := contract.LastOrderId(&bind.CallOpts{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	assertAcct := func(t *testing.T, locked, mint, bal, withdrawable *big.Int) {
+		t.Helper()
+		acct, err := contract.Accounts(&bind.CallOpts{}, accts[1].Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if acct.Locked.Cmp(locked) != 0 || acct.Mint.Cmp(mint) != 0 {
+			t.Errorf("want locked: %v, mint: %v, got: %v, %v", locked, mint, acct.Locked, acct.Mint)
+		}
+		if got, err := contract.BalanceOf(&bind.CallOpts{}, accts[1].Addr); err != nil {
+			t.Fatal(err)
+		} else if got.Cmp(bal) != 0 {
+			t.Errorf("want bal: %v, got: %v", bal, got)
+		}
+		if got, err := contract.Withdrawable(&bind.CallOpts{}, accts[1].Addr); err != nil {
+			t.Fatal(err)
+		} else if got.Cmp(withdrawable) != 0 {
+			t.Errorf("want withdrawable: %v, got: %v", withdrawable, got)
+		}
+	}
+	assertOpen := func(t *testing.T, id *big.Int, open bool) {
+		t.Helper()
+		o, err := contract.Orders(&bind.CallOpts{}, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := o.Owner != (common.Address{}); got != open {
+			t.Errorf("order %v: want open: %v, got: %v", id, open, got)
+		}
+	}
+
+	t.Run("stopLoss", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		id := place(t, StopLoss, 800, 400)
+		assertOpen(t, id, true)
+
+		setRate(t, 801)
+		if chain.Succeed(contract.ExecuteOrder(accts[2].Auth, id)) {
+			t.Fatal("order executed above its price")
+		}
+
+		// Anyone may execute; proceeds go to the owner.
+		setRate(t, 800)
+		if !chain.Succeed(contract.ExecuteOrder(accts[2].Auth, id)) {
+			t.Fatal("unable to execute order")
+		}
+		assertAcct(t, big.NewInt(6e17), bigint(600, usdx), bigint(600, usdx), big.NewInt(4e17))
+		assertOpen(t, id, false)
+		if w, _ := contract.Withdrawable(&bind.CallOpts{}, accts[2].Addr); w.Sign() != 0 {
+			t.Errorf("executor credited %v", w)
+		}
+
+		// Orders are executed once.
+		if chain.Succeed(contract.ExecuteOrder(accts[2].Auth, id)) {
+			t.Error("order executed twice")
+		}
+	})
+
+	t.Run("stopLossAll", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		id := place(t, StopLoss, 800, 0)
+		setRate(t, 500)
+		if !chain.Succeed(contract.ExecuteOrder(accts[2].Auth, id)) {
+			t.Fatal("unable to execute order")
+		}
+		assertAcct(t, zero, zero, zero, big.NewInt(params.Ether))
+	})
+
+	t.Run("stopLossNoBalance", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		id := place(t, StopLoss, 800, 0)
+		if !chain.Succeed(contract.Transfer(accts[1].Auth, accts[3].Addr, bigint(1000, usdx))) {
+			t.Fatal("unable to transfer usdx")
+		}
+		setRate(t, 500)
+		if chain.Succeed(contract.ExecuteOrder(accts[2].Auth, id)) {
+			t.Error("order executed without usdx to unlock")
+		}
+		assertOpen(t, id, true)
+	})
+
+	t.Run("takeProfit", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		id := place(t, TakeProfit, 1500, 300)
+		setRate(t, 1499)
+		if chain.Succeed(contract.ExecuteOrder(accts[2].Auth, id)) {
+			t.Fatal("order executed below its price")
+		}
+
+		setRate(t, 1500)
+		if !chain.Succeed(contract.ExecuteOrder(accts[2].Auth, id)) {
+			t.Fatal("unable to execute order")
+		}
+		assertAcct(t, big.NewInt(params.Ether), bigint(1300, usdx), bigint(1300, usdx), zero)
+		assertOpen(t, id, false)
+		if bal, _ := contract.BalanceOf(&bind.CallOpts{}, accts[2].Addr); bal.Sign() != 0 {
+			t.Errorf("executor minted %v", bal)
+		}
+	})
+
+	t.Run("takeProfitNoAppreciation", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// The owner collects before the order is executed, so
+		// there's nothing left for it to collect.
+		id := place(t, TakeProfit, 1500, 0)
+		setRate(t, 1500)
+		if !chain.Succeed(contract.CollectAppreciation(accts[1].Auth, zero)) {
+			t.Fatal("unable to collect appreciation")
+		}
+		if chain.Succeed(contract.ExecuteOrder(accts[2].Auth, id)) {
+			t.Error("order executed without appreciation")
+		}
+		assertOpen(t, id, true)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		id := place(t, StopLoss, 800, 0)
+		if chain.Succeed(contract.CancelOrder(accts[2].Auth, id)) {
+			t.Error("non-owner cancelled order")
+		}
+		if !chain.Succeed(contract.CancelOrder(accts[1].Auth, id)) {
+			t.Fatal("unable to cancel order")
+		}
+		assertOpen(t, id, false)
+		setRate(t, 500)
+		if chain.Succeed(contract.ExecuteOrder(accts[2].Auth, id)) {
+			t.Error("cancelled order executed")
+		}
+		assertAcct(t, big.NewInt(params.Ether), bigint(1000, usdx), bigint(1000, usdx), zero)
+	})
+
+	t.Run("invalidPrice", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if chain.Succeed(contract.PlaceOrder(accts[1].Auth, StopLoss, zero, zero)) {
+			t.Error("order placed with zero price")
+		}
+	})
+}
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contract := env.Chain, env.Accounts, env.Oracle, env.USDX
+
+	setRate := func(t *testing.T, usd int64) {
+		t.Helper()
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(usd, rate), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+	}
+	setRate(t, 1000)
+	// accts[1] mints 1 eth for 1000usdx.
+	accts[1].Auth.Value = big.NewInt(params.Ether)
+	if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[1].Auth)) {
+		t.Fatal("unable to mint")
+	}
+	accts[1].Auth.Value = nil
+
+	assertMint := func(t *testing.T, mint int64) {
+		t.Helper()
+		acct, err := contract.Accounts(&bind.CallOpts{}, accts[1].Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := bigint(mint, usdx); acct.Mint.Cmp(want) != 0 {
+			t.Errorf("want mint: %v, got: %v", want, acct.Mint)
+		}
+		if bal, err := contract.BalanceOf(&bind.CallOpts{}, accts[1].Addr); err != nil {
+			t.Fatal(err)
+		} else if want := bigint(mint, usdx); bal.Cmp(want) != 0 {
+			t.Errorf("want bal: %v, got: %v", want, bal)
+		}
+	}
+
+	t.Run("collects", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if !chain.Succeed(contract.SetCollector(accts[1].Auth, accts[2].Addr, bigint(100, usdx))) {
+			t.Fatal("unable to set collector")
+		}
+		if d, err := contract.Collectors(&bind.CallOpts{}, accts[1].Addr); err != nil {
+			t.Fatal(err)
+		} else if d.Collector != accts[2].Addr || d.Threshold.Cmp(bigint(100, usdx)) != 0 {
+			t.Errorf("want collector: %s, threshold: 100usdx, got: %s, %v", accts[2].Addr.Hex(), d.Collector.Hex(), d.Threshold)
+		}
+
+		setRate(t, 1050)
+		if chain.Succeed(contract.CollectAppreciationFor(accts[2].Auth, accts[1].Addr, zero)) {
+			t.Error("collected below threshold")
+		}
+
+		setRate(t, 1300)
+		if chain.Succeed(contract.CollectAppreciationFor(accts[3].Auth, accts[1].Addr, zero)) {
+			t.Error("non-collector collected")
+		}
+		if !chain.Succeed(contract.CollectAppreciationFor(accts[2].Auth, accts[1].Addr, bigint(200, usdx))) {
+			t.Fatal("collector unable to collect")
+		}
+		assertMint(t, 1200)
+		if bal, _ := contract.BalanceOf(&bind.CallOpts{}, accts[2].Addr); bal.Sign() != 0 {
+			t.Errorf("collector minted %v", bal)
+		}
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if !chain.Succeed(contract.SetCollector(accts[1].Auth, accts[2].Addr, zero)) {
+			t.Fatal("unable to set collector")
+		}
+		if !chain.Succeed(contract.SetCollector(accts[1].Auth, common.Address{}, bigint(100, usdx))) {
+			t.Fatal("unable to revoke collector")
+		}
+		setRate(t, 1300)
+		if chain.Succeed(contract.CollectAppreciationFor(accts[2].Auth, accts[1].Addr, zero)) {
+			t.Error("revoked collector collected")
+		}
+		// Nor may anyone collect for an account without a collector.
+		if chain.Succeed(contract.CollectAppreciationFor(accts[2].Auth, accts[3].Addr, zero)) {
+			t.Error("collected for account without collector")
+		}
+		assertMint(t, 1000)
+	})
+}
+
+func TestRedeem(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contractAddr, contract := env.Chain, env.Accounts, env.Oracle, env.USDXAddr, env.USDX
+	opts := &bind.CallOpts{}
+
+	setRate := func(t *testing.T, usd int64) {
+		t.Helper()
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(usd, rate), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+	}
+	mint := func(t *testing.T, acct usdxtest.Account, wei int64) {
+		t.Helper()
+		acct.Auth.Value = big.NewInt(wei)
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(acct.Auth)) {
+			t.Fatal("unable to mint")
+		}
+		acct.Auth.Value = nil
+	}
+	ids := func(ids ...int64) []*big.Int {
+		out := make([]*big.Int, len(ids))
+		for i, id := range ids {
+			out[i] = big.NewInt(id)
+		}
+		return out
+	}
+	assertWithdrawable := func(t *testing.T, addr common.Address, want *big.Int) {
+		t.Helper()
+		if got, err := contract.Withdrawable(opts, addr); err != nil {
+			t.Fatal(err)
+		} else if got.Cmp(want) != 0 {
+			t.Errorf("%s: want withdrawable: %v, got: %v", addr.Hex(), want, got)
+		}
+	}
+	assertAcct := func(t *testing.T, addr common.Address, locked, mint *big.Int) {
+		t.Helper()
+		acct, err := contract.Accounts(opts, addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if acct.Locked.Cmp(locked) != 0 || acct.Mint.Cmp(mint) != 0 {
+			t.Errorf("%s: want locked: %v, mint: %v, got: %v, %v", addr.Hex(), locked, mint, acct.Locked, acct.Mint)
+		}
+	}
+
+	// Lot 1 locks 1eth per 1000usdx, lot 2 half that, and lot 3
+	// twice that.
+	setRate(t, 1000)
+	mint(t, accts[1], params.Ether)
+	setRate(t, 2000)
+	mint(t, accts[2], params.Ether)
+	setRate(t, 500)
+	mint(t, accts[3], 2*params.Ether)
+	setRate(t, 1000)
+	// accts[4] has no account, only usdx.
+	if !chain.Succeed(contract.Transfer(accts[2].Auth, accts[4].Addr, bigint(1500, usdx))) {
+		t.Fatal("unable to transfer usdx")
+	}
+
+	t.Run("lotPage", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		assertPage := func(start, count *big.Int, wantIDs []*big.Int, wantNext int64) {
+			t.Helper()
+			page, err := contract.LotPage(opts, start, count)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(page.Ids, wantIDs) || len(page.Owners) != len(wantIDs) || page.Next.Cmp(big.NewInt(wantNext)) != 0 {
+				t.Errorf("page(%v, %v): want ids %v, next %v, got: %+v", start, count, wantIDs, wantNext, page)
+			}
+		}
+		assertPage(big.NewInt(1), big.NewInt(2), ids(1, 2), 3)
+		assertPage(big.NewInt(3), big.NewInt(2), ids(3), 0)
+		assertPage(zero, abi.MaxUint256, ids(1, 2, 3), 0)
+		assertPage(big.NewInt(4), big.NewInt(1), ids(), 0)
+
+		// Removed lots are skipped.
+		if !chain.Succeed(contract.UnlockLot(accts[1].Auth, big.NewInt(1), zero)) {
+			t.Fatal("unable to unlock")
+		}
+		assertPage(big.NewInt(1), big.NewInt(2), ids(2), 3)
+	})
+
+	t.Run("quote", func(t *testing.T) {
+		env.Isolate(t)
+		lots, err := RedemptionLots(opts, chain, contractAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(lots) != 2 || lots[0].ID.Int64() != 3 || lots[1].ID.Int64() != 1 {
+			t.Errorf("want redemption lots [3 1], got: %v", lots)
+		}
+
+		q, err := QuoteRedeem(opts, chain, contractAddr, bigint(1500, usdx))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.USDX.Cmp(bigint(1500, usdx)) != 0 || q.ETH.Cmp(big.NewInt(15e17)) != 0 || len(q.IDs) != 2 {
+			t.Errorf("want 1500usdx for 1.5eth from 2 lots, got: %v for %v from %v", q.USDX, q.ETH, q.IDs)
+		}
+
+		// Lots 1 and 3 mint 2000usdx in all.
+		if q, err := QuoteRedeem(opts, chain, contractAddr, bigint(3000, usdx)); err != nil {
+			t.Fatal(err)
+		} else if q.USDX.Cmp(bigint(2000, usdx)) != 0 {
+			t.Errorf("want 2000usdx redeemable, got: %v", q.USDX)
+		}
+	})
+
+	t.Run("redeems", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if !chain.Succeed(contract.Redeem(accts[4].Auth, bigint(1500, usdx), ids(3, 1), big.NewInt(15e17))) {
+			t.Fatal("unable to redeem")
+		}
+		// 1.5eth at 1000usd/eth, for the redeemer.
+		assertWithdrawable(t, accts[4].Addr, big.NewInt(15e17))
+		if bal, _ := contract.BalanceOf(opts, accts[4].Addr); bal.Sign() != 0 {
+			t.Errorf("want redeemer's usdx burned, got: %v", bal)
+		}
+
+		// Lot 3 is fully redeemed for 1eth; its other 1eth, its
+		// appreciation, is its owner's.
+		assertAcct(t, accts[3].Addr, zero, zero)
+		assertWithdrawable(t, accts[3].Addr, big.NewInt(params.Ether))
+		if bal, _ := contract.BalanceOf(opts, accts[3].Addr); bal.Cmp(bigint(1000, usdx)) != 0 {
+			t.Errorf("want owner's usdx kept, got: %v", bal)
+		}
+		positionsAddr, _ := contract.Positions(opts)
+		positions, _ := NewUSDXPositionsCaller(positionsAddr, chain)
+		if _, err := positions.OwnerOf(opts, big.NewInt(3)); err == nil {
+			t.Error("want lot 3's token burned")
+		}
+
+		// Lot 1 is half redeemed, and still unlocks at its price.
+		assertAcct(t, accts[1].Addr, big.NewInt(5e17), bigint(500, usdx))
+		if !chain.Succeed(contract.Unlock(accts[1].Auth, zero)) {
+			t.Fatal("unable to unlock")
+		}
+		assertWithdrawable(t, accts[1].Addr, big.NewInt(5e17))
+
+		// Solvent: the contract holds every account's locked and
+		// withdrawable eth.
+		assertAcct(t, accts[2].Addr, big.NewInt(params.Ether), bigint(2000, usdx))
+		if bal, _ := chain.BalanceAt(context.Background(), contractAddr, nil); bal.Cmp(big.NewInt(4*params.Ether)) != 0 {
+			t.Errorf("want contract balance: 4eth, got: %v", bal)
+		}
+		if locked, err := contract.TotalLocked(opts); err != nil || locked.Cmp(big.NewInt(params.Ether)) != 0 {
+			t.Errorf("want total locked: 1eth, got: %v, err: %v", locked, err)
+		}
+	})
+
+	t.Run("order", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if chain.Succeed(contract.Redeem(accts[4].Auth, bigint(1500, usdx), ids(1, 3), zero)) {
+			t.Error("redeemed out of order")
+		}
+		// Lot 1 isn't next while lot 3 has usdx to redeem.
+		if chain.Succeed(contract.Redeem(accts[4].Auth, bigint(100, usdx), ids(1), zero)) {
+			t.Error("redeemed from lot 1 before lot 3")
+		}
+	})
+
+	t.Run("lowerCollateral", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// Lot 2 is worth its mint, but locks less eth per usdx than
+		// lots 1 and 3.
+		setRate(t, 2000)
+		if chain.Succeed(contract.Redeem(accts[4].Auth, bigint(100, usdx), ids(2), zero)) {
+			t.Error("redeemed from lot with less eth per usdx")
+		}
+		if chain.Succeed(contract.Redeem(accts[4].Auth, bigint(1500, usdx), ids(3, 2), zero)) {
+			t.Error("redeemed from lot 2 before lot 1")
+		}
+	})
+
+	t.Run("sameBucket", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// Lot 4 locks 1eth per 1005usdx, within lot 1's bucket, so
+		// either may be redeemed from first.
+		setRate(t, 1005)
+		mint(t, accts[5], params.Ether)
+		lots, err := RedemptionLots(opts, chain, contractAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(lots) != 3 || lots[0].ID.Int64() != 3 || lots[1].ID.Int64() != 1 || lots[2].ID.Int64() != 4 {
+			t.Errorf("want redemption lots [3 1 4], got: %v", lots)
+		}
+		if !chain.Succeed(contract.Redeem(accts[4].Auth, bigint(1500, usdx), ids(3, 4), zero)) {
+			t.Error("unable to redeem from lot in same bucket")
+		}
+	})
+
+	t.Run("undercollateralized", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// Lot 3 is worth 800usdx of its 1000usdx mint.
+		setRate(t, 400)
+		if chain.Succeed(contract.Redeem(accts[4].Auth, bigint(100, usdx), ids(3), zero)) {
+			t.Error("redeemed from undercollateralized lot")
+		}
+		if _, err := QuoteRedeem(opts, chain, contractAddr, bigint(100, usdx)); err != ErrNoRedemption {
+			t.Errorf("want ErrNoRedemption, got: %v", err)
+		}
+	})
+
+	t.Run("minEth", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if chain.Succeed(contract.Redeem(accts[4].Auth, bigint(1500, usdx), ids(3, 1), big.NewInt(15e17+1))) {
+			t.Error("redeemed less than min eth")
+		}
+	})
+
+	t.Run("balance", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if chain.Succeed(contract.Redeem(accts[4].Auth, bigint(1501, usdx), ids(3, 1), zero)) {
+			t.Error("redeemed more than balance")
+		}
+		if chain.Succeed(contract.Redeem(accts[0].Auth, bigint(1, usdx), ids(3), zero)) {
+			t.Error("redeemed without usdx")
+		}
+	})
+}
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contractAddr, contract := env.Chain, env.Accounts, env.Oracle, env.USDXAddr, env.USDX
+	opts := &bind.CallOpts{}
+	ctx := context.Background()
+
+	// setRate sets the rate, updated at the latest block's time less ago.
+	setRate := func(t *testing.T, usd int64, ago time.Duration) {
+		t.Helper()
+		head, err := chain.HeaderByNumber(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		updatedAt := new(big.Int).SetUint64(head.Time - uint64(ago/time.Second))
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(usd, rate), zero, updatedAt, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+	}
+	mint := func(t *testing.T, acct usdxtest.Account, wei int64) {
+		t.Helper()
+		acct.Auth.Value = big.NewInt(wei)
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(acct.Auth)) {
+			t.Fatal("unable to mint")
+		}
+		acct.Auth.Value = nil
+	}
+	shutdown := func(t *testing.T, usd int64) *Settlement {
+		t.Helper()
+		if !chain.Succeed(contract.Shutdown(accts[0].Auth, bigint(usd, rate))) {
+			t.Fatal("unable to shut down")
+		}
+		s, err := SettlementAt(opts, chain, contractAddr, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	settle := func(t *testing.T, s *Settlement, n int) {
+		t.Helper()
+		for _, ids := range s.Batches(n) {
+			if !chain.Succeed(contract.SettleLots(accts[4].Auth, ids)) {
+				t.Fatalf("unable to settle lots %v", ids)
+			}
+		}
+	}
+	withdrawable := func(t *testing.T, addr common.Address) *big.Int {
+		t.Helper()
+		w, err := contract.Withdrawable(opts, addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return w
+	}
+	// assertSolvent checks the contract holds the settlement pool, and
+	// every account's locked and withdrawable eth.
+	assertSolvent := func(t *testing.T) {
+		t.Helper()
+		owed, err := contract.SettlementPool(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		locked, err := contract.TotalLocked(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		owed.Add(owed, locked)
+		for _, acct := range accts[:5] {
+			owed.Add(owed, withdrawable(t, acct.Addr))
+		}
+		if bal, _ := chain.BalanceAt(ctx, contractAddr, nil); bal.Cmp(owed) < 0 {
+			t.Errorf("want contract balance at least %v, got: %v", owed, bal)
+		}
+	}
+	// claim claims acct's usdx, and checks it's credited the eth s
+	// quoted, and the surplus of its lots.
+	claim := func(t *testing.T, s *Settlement, acct usdxtest.Account) *big.Int {
+		t.Helper()
+		bal, err := contract.BalanceOf(opts, acct.Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		before := withdrawable(t, acct.Addr)
+		if want := s.Surplus(acct.Addr); before.Cmp(want) != 0 {
+			t.Errorf("%s: want surplus: %v, got: %v", acct.Addr.Hex(), want, before)
+		}
+		// Quoted before any claim, so off by rounding for later ones.
+		want := s.Claim(bal)
+		if !chain.Succeed(contract.Claim(acct.Auth, zero)) {
+			t.Fatalf("%s: unable to claim", acct.Addr.Hex())
+		}
+		got := new(big.Int).Sub(withdrawable(t, acct.Addr), before)
+		if diff := new(big.Int).Sub(want, got); diff.CmpAbs(big.NewInt(1)) > 0 {
+			t.Errorf("%s: want claimed: %v, got: %v", acct.Addr.Hex(), want, got)
+		}
+		if bal, _ := contract.BalanceOf(opts, acct.Addr); bal.Sign() != 0 {
+			t.Errorf("%s: want usdx burned, got: %v", acct.Addr.Hex(), bal)
+		}
+		return got
+	}
+
+	// Lot 1 locks 1eth for 1000usdx, lot 2 1eth for 2000usdx, and
+	// accts[3] holds usdx but no lot.
+	setRate(t, 1000, 0)
+	mint(t, accts[1], params.Ether)
+	setRate(t, 2000, 0)
+	mint(t, accts[2], params.Ether)
+	if !chain.Succeed(contract.Transfer(accts[2].Auth, accts[3].Addr, bigint(500, usdx))) {
+		t.Fatal("unable to transfer usdx")
+	}
+
+	t.Run("owner", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if chain.Succeed(contract.Shutdown(accts[1].Auth, bigint(2000, rate))) {
+			t.Error("non-owner shut down")
+		}
+		if chain.Succeed(contract.Shutdown(accts[0].Auth, zero)) {
+			t.Error("shut down at 0")
+		}
+		if chain.Succeed(contract.SettleLots(accts[4].Auth, []*big.Int{big.NewInt(1)})) {
+			t.Error("settled lot while live")
+		}
+		if chain.Succeed(contract.Claim(accts[3].Auth, zero)) {
+			t.Error("claimed while live")
+		}
+		shutdown(t, 2000)
+		if p, _ := contract.SettlementPrice(opts); p.Cmp(bigint(2000, rate)) != 0 {
+			t.Errorf("want settlement price: 2000, got: %v", p)
+		}
+		if chain.Succeed(contract.Shutdown(accts[0].Auth, bigint(1000, rate))) {
+			t.Error("shut down twice")
+		}
+	})
+
+	t.Run("frozen", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		setRate(t, 3000, 0)
+		shutdown(t, 2000)
+		accts[1].Auth.Value = big.NewInt(params.Ether)
+		if chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[1].Auth)) {
+			t.Error("minted while shut down")
+		}
+		accts[1].Auth.Value = nil
+		if chain.Succeed(contract.Unlock(accts[1].Auth, zero)) {
+			t.Error("unlocked while shut down")
+		}
+		if chain.Succeed(contract.UnlockLot(accts[1].Auth, big.NewInt(1), zero)) {
+			t.Error("unlocked lot while shut down")
+		}
+		if chain.Succeed(contract.CollectAppreciation(accts[1].Auth, zero)) {
+			t.Error("collected appreciation while shut down")
+		}
+		if chain.Succeed(contract.Redeem(accts[3].Auth, bigint(100, usdx), []*big.Int{big.NewInt(1)}, zero)) {
+			t.Error("redeemed while shut down")
+		}
+		// usdx is still transferable.
+		if !chain.Succeed(contract.Transfer(accts[3].Auth, accts[4].Addr, bigint(100, usdx))) {
+			t.Error("unable to transfer usdx while shut down")
+		}
+	})
+
+	t.Run("stale", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// Far enough past genesis that updatedAt can be days ago.
+		if err := chain.AdjustTime(7 * 24 * time.Hour); err != nil {
+			t.Fatal(err)
+		}
+		chain.Commit()
+		// A round without an update time isn't stale.
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(1500, rate), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+		if chain.Succeed(contract.ShutdownStale(accts[4].Auth)) {
+			t.Error("shut down with an incomplete round")
+		}
+		setRate(t, 1500, 3*24*time.Hour-time.Minute)
+		if chain.Succeed(contract.ShutdownStale(accts[4].Auth)) {
+			t.Error("shut down with a fresh price feed")
+		}
+		setRate(t, 1500, 3*24*time.Hour+time.Minute)
+		if !chain.Succeed(contract.ShutdownStale(accts[4].Auth)) {
+			t.Fatal("unable to shut down with a stale price feed")
+		}
+		if p, _ := contract.SettlementPrice(opts); p.Cmp(bigint(1500, rate)) != 0 {
+			t.Errorf("want settlement price: 1500, got: %v", p)
+		}
+		if chain.Succeed(contract.ShutdownStale(accts[4].Auth)) {
+			t.Error("shut down twice")
+		}
+	})
+
+	t.Run("collateralized", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// Lot 1's debt is 1/3eth and lot 2's 2/3eth, so 2/3eth is
+		// returned to lot 1's owner, and 1/3eth to lot 2's.
+		s := shutdown(t, 3000)
+		if len(s.Lots) != 2 {
+			t.Fatalf("want 2 lots to settle, got: %d", len(s.Lots))
+		}
+		if chain.Succeed(contract.Claim(accts[3].Auth, zero)) {
+			t.Error("claimed with lots unsettled")
+		}
+		settle(t, s, 1)
+		if chain.Succeed(contract.SettleLots(accts[4].Auth, []*big.Int{big.NewInt(1)})) {
+			t.Error("settled lot twice")
+		}
+		if pool, _ := contract.SettlementPool(opts); pool.Cmp(s.Pool) != 0 {
+			t.Errorf("want settlement pool: %v, got: %v", s.Pool, pool)
+		}
+		for _, acct := range accts[1:3] {
+			if a, _ := contract.Accounts(opts, acct.Addr); a.Locked.Sign() != 0 || a.Mint.Sign() != 0 {
+				t.Errorf("%s: want account settled, got: %v, %v", acct.Addr.Hex(), a.Locked, a.Mint)
+			}
+		}
+		assertSolvent(t)
+
+		// Claims are at par, at least.
+		for _, acct := range accts[1:4] {
+			bal, _ := contract.BalanceOf(opts, acct.Addr)
+			if got := claim(t, s, acct); got.Cmp(s.Par(bal)) < 0 {
+				t.Errorf("%s: want at least par: %v, got: %v", acct.Addr.Hex(), s.Par(bal), got)
+			}
+			assertSolvent(t)
+		}
+		if supply, _ := contract.TotalSupply(opts); supply.Sign() != 0 {
+			t.Errorf("want every usdx claimed, got supply: %v", supply)
+		}
+		if chain.Succeed(contract.Claim(accts[3].Auth, zero)) {
+			t.Error("claimed without usdx")
+		}
+		if !chain.Succeed(contract.Withdraw(accts[1].Auth)) {
+			t.Error("unable to withdraw")
+		}
+		assertSolvent(t)
+	})
+
+	t.Run("undercollateralized", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// Lot 2's 2000usdx is worth 2eth at 1000usd/eth, but locks 1eth,
+		// so holders are paid 2eth for 3000usdx.
+		s := shutdown(t, 1000)
+		if s.Lots[1].Debt.Cmp(big.NewInt(params.Ether)) != 0 || s.Lots[1].Surplus.Sign() != 0 {
+			t.Errorf("want lot 2's debt capped at 1eth, got: %v, %v", s.Lots[1].Debt, s.Lots[1].Surplus)
+		}
+		if s.Pool.Cmp(big.NewInt(2*params.Ether)) != 0 {
+			t.Errorf("want pool: 2eth, got: %v", s.Pool)
+		}
+		settle(t, s, 50)
+		assertSolvent(t)
+
+		// Claims are pro rata, at a haircut.
+		for _, acct := range accts[1:4] {
+			bal, _ := contract.BalanceOf(opts, acct.Addr)
+			if got := claim(t, s, acct); got.Cmp(s.Par(bal)) >= 0 {
+				t.Errorf("%s: want a haircut on par: %v, got: %v", acct.Addr.Hex(), s.Par(bal), got)
+			}
+			assertSolvent(t)
+		}
+	})
+}
+
+func TestPause(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contractAddr, contract := env.Chain, env.Accounts, env.Oracle, env.USDXAddr, env.USDX
+	opts := &bind.CallOpts{}
+	owner, guardian := accts[0], accts[4]
+	forever := big.NewInt(math.MaxInt64)
+
+	setRate := func(t *testing.T, usd int64) {
+		t.Helper()
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(usd, rate), zero, zero, zero)) {
+			t.Fatal("unable to set oracle round")
+		}
+	}
+	mint := func(t *testing.T, acct usdxtest.Account) bool {
+		t.Helper()
+		acct.Auth.Value = big.NewInt(params.Ether)
+		defer func() { acct.Auth.Value = nil }()
+		return chain.Succeed((&USDXRaw{Contract: contract}).Transfer(acct.Auth))
+	}
+	assertPaused := func(t *testing.T, want bool) {
+		t.Helper()
+		if paused, err := contract.Paused(opts); err != nil {
+			t.Fatal(err)
+		} else if paused != want {
+			t.Errorf("want paused: %v, got: %v", want, paused)
+		}
+	}
+
+	// accts[1] and accts[2] each mint 1eth at 1000usd/eth, which
+	// appreciates at 2000usd/eth.  accts[1] has a take-profit order
+	// (1) and a triggered stop-loss order (2), and accts[2] has
+	// enrolled accts[3] as its collector.
+	setRate(t, 1000)
+	if !mint(t, accts[1]) || !mint(t, accts[2]) {
+		t.Fatal("unable to mint")
+	}
+	setRate(t, 2000)
+	if !chain.Succeed(contract.PlaceOrder(accts[1].Auth, TakeProfit, bigint(1500, rate), zero)) ||
+		!chain.Succeed(contract.PlaceOrder(accts[1].Auth, StopLoss, bigint(2500, rate), zero)) {
+		t.Fatal("unable to place orders")
+	}
+	if !chain.Succeed(contract.SetCollector(accts[2].Auth, accts[3].Addr, zero)) {
+		t.Fatal("unable to set collector")
+	}
+
+	t.Run("guardian", func(t *testing.T) {
+		env.Isolate(t)
+		if chain.Succeed(contract.SetGuardian(guardian.Auth, guardian.Addr)) {
+			t.Error("non-owner set guardian")
+		}
+		if chain.Succeed(contract.Pause(owner.Auth)) {
+			t.Error("paused without a guardian")
+		}
+		if !chain.Succeed(contract.SetGuardian(owner.Auth, guardian.Addr)) {
+			t.Fatal("unable to set guardian")
+		}
+		if got, _ := contract.Guardian(opts); got != guardian.Addr {
+			t.Errorf("want guardian: %s, got: %s", guardian.Addr.Hex(), got.Hex())
+		}
+
+		// Only the guardian, not the owner, pauses and unpauses.
+		if chain.Succeed(contract.Pause(owner.Auth)) {
+			t.Error("owner paused")
+		}
+		if !chain.Succeed(contract.Pause(guardian.Auth)) {
+			t.Fatal("unable to pause")
+		}
+		assertPaused(t, true)
+		if chain.Succeed(contract.Pause(guardian.Auth)) {
+			t.Error("paused twice")
+		}
+		if chain.Succeed(contract.Unpause(owner.Auth)) {
+			t.Error("owner unpaused")
+		}
+		if !chain.Succeed(contract.Unpause(guardian.Auth)) {
+			t.Fatal("unable to unpause")
+		}
+		assertPaused(t, false)
+		if chain.Succeed(contract.Unpause(guardian.Auth)) {
+			t.Error("unpaused twice")
+		}
+
+		paused, err := contract.FilterPaused(&bind.FilterOpts{}, []common.Address{guardian.Addr})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !paused.Next() || paused.Next() {
+			t.Error("want 1 Paused event")
+		}
+		unpaused, err := contract.FilterUnpaused(&bind.FilterOpts{}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !unpaused.Next() || unpaused.Event.Guardian != guardian.Addr {
+			t.Error("want guardian's Unpaused event")
 		}
 
-		// redeem everything to reset
-		if !chain.Succeed(contract.Unlock(accts[0].Auth, zero)) {
-			t.Fatal("unable to redeem")
+		// A revoked guardian can't pause.
+		if !chain.Succeed(contract.SetGuardian(owner.Auth, common.Address{})) {
+			t.Fatal("unable to revoke guardian")
+		}
+		if chain.Succeed(contract.Pause(guardian.Auth)) {
+			t.Error("revoked guardian paused")
 		}
 	})
 
-	t.Run("limitUnderAppr", func(t *testing.T) {
-		// rate starts at 100usd/eth
-		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(100e8), zero, zero, zero)) {
-			t.Fatal("unable to set oracle round")
+	if !chain.Succeed(contract.SetGuardian(owner.Auth, guardian.Addr)) || !chain.Succeed(contract.Pause(guardian.Auth)) {
+		t.Fatal("unable to pause")
+	}
+
+	t.Run("mint", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if mint(t, accts[3]) {
+			t.Error("received while paused")
+		}
+		if !chain.Succeed(contract.SetLotSender(accts[1].Auth, accts[3].Addr, true)) {
+			t.Fatal("unable to allow lot sender")
+		}
+		accts[3].Auth.Value = big.NewInt(params.Ether)
+		if chain.Succeed(contract.Mint(accts[3].Auth, zero, forever)) {
+			t.Error("minted while paused")
+		}
+		if chain.Succeed(contract.MintTo(accts[3].Auth, accts[1].Addr, zero, forever)) {
+			t.Error("minted to while paused")
+		}
+		if chain.Succeed(contract.MintFor(accts[3].Auth, accts[1].Addr)) {
+			t.Error("minted for while paused")
+		}
+		accts[3].Auth.Value = nil
+		if _, err := Quote(opts, chain, contractAddr, big.NewInt(params.Ether)); err != ErrPaused {
+			t.Errorf("want err: %v, got: %v", ErrPaused, err)
 		}
+	})
 
-		// mint 100 usdx
-		accts[0].Auth.Value = big.NewInt(params.Ether)
-		if !chain.Succeed((&USDXRaw{contract}).Transfer(accts[0].Auth)) {
-			t.Fatal("unable to transfer")
+	t.Run("collect", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if chain.Succeed(contract.CollectAppreciation(accts[1].Auth, zero)) {
+			t.Error("collected appreciation while paused")
 		}
-		accts[0].Auth.Value = nil
+		if chain.Succeed(contract.CollectLotAppreciation(accts[1].Auth, big.NewInt(1), zero)) {
+			t.Error("collected lot appreciation while paused")
+		}
+		if chain.Succeed(contract.CollectAppreciationFor(accts[3].Auth, accts[2].Addr, zero)) {
+			t.Error("collector collected appreciation while paused")
+		}
+		if chain.Succeed(contract.ExecuteOrder(accts[3].Auth, big.NewInt(1))) {
+			t.Error("executed take-profit order while paused")
+		}
+		if appr, _ := contract.Appreciation(opts, accts[1].Addr); appr.Cmp(bigint(1000, usdx)) != 0 {
+			t.Errorf("want appreciation kept: 1000usdx, got: %v", appr)
+		}
+	})
 
-		// rate increased to 150 usd/eth
-		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, big.NewInt(150e8), zero, zero, zero)) {
+	t.Run("unlock", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if !chain.Succeed(contract.Unlock(accts[1].Auth, zero)) {
+			t.Fatal("unable to unlock while paused")
+		}
+		if !chain.Succeed(contract.UnlockLot(accts[2].Auth, big.NewInt(2), bigint(500, usdx))) {
+			t.Fatal("unable to unlock lot while paused")
+		}
+		if !chain.Succeed(contract.Withdraw(accts[1].Auth)) {
+			t.Fatal("unable to withdraw while paused")
+		}
+		if w, _ := contract.Withdrawable(opts, accts[2].Addr); w.Cmp(big.NewInt(5e17)) != 0 {
+			t.Errorf("want withdrawable: .5eth, got: %v", w)
+		}
+	})
+
+	t.Run("stopLoss", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if !chain.Succeed(contract.ExecuteOrder(accts[3].Auth, big.NewInt(2))) {
+			t.Fatal("unable to execute stop-loss order while paused")
+		}
+		if w, _ := contract.Withdrawable(opts, accts[1].Addr); w.Cmp(big.NewInt(params.Ether)) != 0 {
+			t.Errorf("want withdrawable: 1eth, got: %v", w)
+		}
+	})
+
+	t.Run("transferAcct", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if !chain.Succeed(contract.SetLotSender(accts[3].Auth, accts[2].Addr, true)) {
+			t.Fatal("unable to allow lot sender while paused")
+		}
+		if !chain.Succeed(contract.TransferAcct(accts[2].Auth, accts[3].Addr)) {
+			t.Fatal("unable to transfer account while paused")
+		}
+		if acct, _ := contract.Accounts(opts, accts[3].Addr); acct.Locked.Cmp(big.NewInt(params.Ether)) != 0 {
+			t.Errorf("want transferred locked: 1eth, got: %v", acct.Locked)
+		}
+	})
+
+	t.Run("unpause", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if !chain.Succeed(contract.Unpause(guardian.Auth)) {
+			t.Fatal("unable to unpause")
+		}
+		if !mint(t, accts[3]) {
+			t.Error("unable to mint once unpaused")
+		}
+		if !chain.Succeed(contract.CollectAppreciation(accts[1].Auth, zero)) {
+			t.Error("unable to collect appreciation once unpaused")
+		}
+	})
+}
+
+func TestMintLimits(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contractAddr, contract := env.Chain, env.Accounts, env.Oracle, env.USDXAddr, env.USDX
+	opts := &bind.CallOpts{}
+	owner := accts[0]
+	unlimited := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	setRate := func(t *testing.T, usd int64) {
+		t.Helper()
+		if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(usd, rate), zero, zero, zero)) {
 			t.Fatal("unable to set oracle round")
 		}
+	}
+	setLimits := func(t *testing.T, cap, limit int64, window time.Duration) {
+		t.Helper()
+		if !chain.Succeed(contract.SetMintLimits(owner.Auth, bigint(cap, usdx), bigint(limit, usdx), big.NewInt(int64(window/time.Second)))) {
+			t.Fatal("unable to set mint limits")
+		}
+	}
+	mint := func(acct usdxtest.Account, wei int64) bool {
+		acct.Auth.Value = big.NewInt(wei)
+		defer func() { acct.Auth.Value = nil }()
+		return chain.Succeed((&USDXRaw{Contract: contract}).Transfer(acct.Auth))
+	}
+	assertHeadroom := func(t *testing.T, supply, window *big.Int) {
+		t.Helper()
+		if got, err := contract.SupplyHeadroom(opts); err != nil {
+			t.Fatal(err)
+		} else if got.Cmp(supply) != 0 {
+			t.Errorf("want supply headroom: %v, got: %v", supply, got)
+		}
+		if got, err := contract.WindowHeadroom(opts); err != nil {
+			t.Fatal(err)
+		} else if got.Cmp(window) != 0 {
+			t.Errorf("want window headroom: %v, got: %v", window, got)
+		}
+		want := supply
+		if window.Cmp(want) < 0 {
+			want = window
+		}
+		if got, err := Headroom(opts, chain, contractAddr); err != nil {
+			t.Fatal(err)
+		} else if got.Cmp(want) != 0 {
+			t.Errorf("want headroom: %v, got: %v", want, got)
+		}
+	}
+	// advance advances the chain's time by d.
+	advance := func(t *testing.T, d time.Duration) {
+		t.Helper()
+		if err := chain.AdjustTime(d); err != nil {
+			t.Fatal(err)
+		}
+		chain.Commit()
+	}
 
-		oldBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr)
+	setRate(t, 2000)
+
+	t.Run("owner", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		assertHeadroom(t, unlimited, unlimited)
+		if chain.Succeed(contract.SetMintLimits(accts[1].Auth, bigint(1, usdx), zero, zero)) {
+			t.Error("non-owner set mint limits")
+		}
+		setLimits(t, 5000, 3000, time.Hour)
+		if got, _ := contract.SupplyCap(opts); got.Cmp(bigint(5000, usdx)) != 0 {
+			t.Errorf("want supply cap: 5000usdx, got: %v", got)
+		}
+		if got, _ := contract.MintWindow(opts); got.Int64() != 3600 {
+			t.Errorf("want mint window: 3600, got: %v", got)
+		}
+		set, err := contract.FilterMintLimitsSet(&bind.FilterOpts{})
 		if err != nil {
 			t.Fatal(err)
 		}
+		if !set.Next() || set.Event.MintLimit.Cmp(bigint(3000, usdx)) != 0 {
+			t.Error("want MintLimitsSet event")
+		}
+	})
 
-		if !chain.Succeed(contract.CollectAppreciation(accts[0].Auth, bigint(25, usdx))) {
-			t.Fatal("unable to collect appreciation")
+	t.Run("supplyCap", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		setLimits(t, 3000, 0, 0)
+		if !mint(accts[1], params.Ether) {
+			t.Fatal("unable to mint under cap")
 		}
+		assertHeadroom(t, bigint(1000, usdx), unlimited)
 
-		if newBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr); err != nil {
-			t.Fatal(err)
-		} else if want := oldBal.Add(oldBal, bigint(25, usdx)); newBal.Cmp(want) != 0 {
-			t.Fatalf("want usdx balance: %v, got: %v", want, newBal)
+		// 2000usdx is over the cap, with a clear error.
+		if _, err := Quote(opts, chain, contractAddr, big.NewInt(params.Ether)); !errors.Is(err, ErrMintLimit) {
+			t.Errorf("want err: %v, got: %v", ErrMintLimit, err)
+		}
+		if mint(accts[2], params.Ether) {
+			t.Error("minted over cap")
 		}
+		if !mint(accts[2], 5e17) {
+			t.Fatal("unable to mint up to cap")
+		}
+		assertHeadroom(t, zero, unlimited)
 
-		// redeem everything to reset
-		if !chain.Succeed(contract.Unlock(accts[0].Auth, zero)) {
-			t.Fatal("unable to redeem")
+		// Appreciation is capped too.
+		setRate(t, 3000)
+		if chain.Succeed(contract.CollectAppreciation(accts[1].Auth, zero)) {
+			t.Error("collected appreciation over cap")
+		}
+
+		// Burning usdx makes room.
+		if !chain.Succeed(contract.Unlock(accts[2].Auth, zero)) {
+			t.Fatal("unable to unlock")
+		}
+		assertHeadroom(t, bigint(1000, usdx), unlimited)
+		if !chain.Succeed(contract.CollectAppreciation(accts[1].Auth, zero)) {
+			t.Error("unable to collect appreciation under cap")
 		}
+
+		// A cap under the supply stops minting.
+		setLimits(t, 1000, 0, 0)
+		assertHeadroom(t, zero, unlimited)
 	})
 
-	tests := []struct {
-		prices  []int64
-		expAppr int64
-	}{
-		{[]int64{100}, 0},
-		{[]int64{100, 90}, 0},
-		{[]int64{100, 110}, 10},
-		{[]int64{100, 90, 150}, 110},
-		{[]int64{100, 110, 150}, 90},
-		{[]int64{100, 90, 50}, 0},
-		{[]int64{100, 110, 90, 150}, 150},
-		// The following case is interesting.  If the 100 and 150
-		// deposits were done via 2 separate accounts, the 100 account
-		// deposit would be able to collect 20usdx of appreciation if
-		// price rises to 120, and the 150 deposit would be 30usdx
-		// underwater. Combining the 2 transactions into a single
-		// transaction averages the basis, such that 2 eth have been
-		// deposited at an average cost of 125usd/eth.  This might not
-		// be a tradeoff the depositor is willing to make, but the
-		// mitigation against this would add too much complexity.
-		{[]int64{100, 150, 120}, 0},
-	}
+	t.Run("window", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		// Start at a window's start, so it doesn't roll over early.
+		head, err := chain.HeaderByNumber(context.Background(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		advance(t, time.Duration(3600-head.Time%3600)*time.Second)
 
-	for i, test := range tests {
-		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
-			// Mint 1 eth at each price
-			for _, price := range test.prices {
-				// set oracle to the price
-				if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(price, rate), zero, zero, zero)) {
-					t.Fatal("unable to set oracle round")
-				}
+		setLimits(t, 0, 3000, time.Hour)
+		if !mint(accts[1], params.Ether) {
+			t.Fatal("unable to mint under limit")
+		}
+		assertHeadroom(t, unlimited, bigint(1000, usdx))
+		if _, err := Quote(opts, chain, contractAddr, big.NewInt(params.Ether)); !errors.Is(err, ErrMintLimit) {
+			t.Errorf("want err: %v, got: %v", ErrMintLimit, err)
+		}
+		if mint(accts[2], params.Ether) {
+			t.Error("minted over limit")
+		}
+		// Not only does estimating gas fail: a mint sent with a gas
+		// limit is mined, and reverts.
+		auth := *accts[2].Auth
+		auth.Value = big.NewInt(params.Ether)
+		auth.GasLimit = 1e6
+		tx, err := (&USDXRaw{Contract: contract}).Transfer(&auth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chain.Commit()
+		if rcpt, err := chain.TransactionReceipt(context.Background(), tx.Hash()); err != nil {
+			t.Fatal(err)
+		} else if rcpt.Status != types.ReceiptStatusFailed {
+			t.Error("mint over limit didn't revert")
+		}
+		if bal, _ := contract.BalanceOf(opts, accts[2].Addr); bal.Sign() != 0 {
+			t.Errorf("want no usdx minted over limit, got: %v", bal)
+		}
+		advance(t, 30*time.Minute)
+		if mint(accts[2], params.Ether) {
+			t.Error("minted over limit, in the same window")
+		}
 
-				accts[0].Auth.Value = big.NewInt(params.Ether)
-				// mint 1 eth
-				if !chain.Succeed((&USDXRaw{contract}).Transfer(accts[0].Auth)) {
-					t.Fatal("unable to transfer")
-				}
-				accts[0].Auth.Value = nil
-			}
+		// The next window has the full limit.
+		advance(t, 30*time.Minute)
+		assertHeadroom(t, unlimited, bigint(3000, usdx))
+		if !mint(accts[2], params.Ether) {
+			t.Fatal("unable to mint in the next window")
+		}
+		assertHeadroom(t, unlimited, bigint(1000, usdx))
 
-			// call appreciation, ensure it's expected
-			appr, err := contract.Appreciation(&bind.CallOpts{}, accts[0].Addr)
+		// Mints before a new limit count toward it, unless the window
+		// changes.
+		setLimits(t, 0, 2500, time.Hour)
+		assertHeadroom(t, unlimited, bigint(500, usdx))
+		setLimits(t, 0, 2500, 2*time.Hour)
+		assertHeadroom(t, unlimited, bigint(2500, usdx))
+	})
+
+	t.Run("block", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		setLimits(t, 0, 3000, 0)
+		// Two mints in one block are over the limit, so the second
+		// fails; gas limits are set, since estimating the second
+		// against the first would fail.
+		var txs []common.Hash
+		for _, acct := range accts[1:3] {
+			auth := *acct.Auth
+			auth.Value = big.NewInt(params.Ether)
+			auth.GasLimit = 1e6
+			tx, err := (&USDXRaw{Contract: contract}).Transfer(&auth)
 			if err != nil {
 				t.Fatal(err)
-			} else if want := bigint(test.expAppr, usdx); appr.Cmp(want) != 0 {
-				t.Fatalf("want appreciation: %v, got: %v", want, appr)
 			}
-
-			oldBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr)
+			txs = append(txs, tx.Hash())
+		}
+		chain.Commit()
+		for i, hash := range txs {
+			rcpt, err := chain.TransactionReceipt(context.Background(), hash)
 			if err != nil {
 				t.Fatal(err)
 			}
-
-			if !chain.Succeed(contract.CollectAppreciation(accts[0].Auth, zero)) {
-				t.Fatal("unable to collect appreciation")
-			}
-
-			if newBal, err := contract.BalanceOf(&bind.CallOpts{}, accts[0].Addr); err != nil {
-				t.Fatal(err)
-			} else if want := oldBal.Add(oldBal, bigint(test.expAppr, usdx)); newBal.Cmp(want) != 0 {
-				t.Fatalf("want usdx balance: %v, got: %v", want, newBal)
+			if ok := rcpt.Status == types.ReceiptStatusSuccessful; ok != (i == 0) {
+				t.Errorf("mint %d: want success: %v, got: %v", i, i == 0, ok)
 			}
+		}
 
-			// redeem everything to reset
-			if !chain.Succeed(contract.Unlock(accts[0].Auth, zero)) {
-				t.Fatal("unable to redeem")
-			}
-		})
-	}
+		// The next block has the full limit.
+		if !mint(accts[2], params.Ether) {
+			t.Error("unable to mint in the next block")
+		}
+	})
 }
 
 func TestSetFeed(t *testing.T) {
-	chain, accts := soltest.New()
-
-	oracleAddr, _, _, err := DeployMockOracle(accts[0].Auth, chain)
-	if err != nil {
-		t.Fatal(err)
-	}
-	chain.Commit()
-
-	_, _, contract, err := DeployUSDX(accts[0].Auth, chain, oracleAddr)
-	if err != nil {
-		t.Fatal(err)
-	}
-	chain.Commit()
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, oracleAddr, contract := env.Chain, env.OracleAddr, env.USDX
 
 	t.Run("constructorSetsPriceFeed", func(t *testing.T) {
+		env.Isolate(t)
 		priceFeed, err := contract.UsdPriceFeed(&bind.CallOpts{})
 		if err != nil {
 			t.Errorf("uexpected err reading ethUsdPriceFeed: %v", err)
@@ -603,12 +2657,14 @@ func TestSetFeed(t *testing.T) {
 	})
 
 	t.Run("feedDecimalsIncorrect", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		if chain.Succeed(contract.SetFeed(accts[0].Auth, common.Address{0xff})) {
 			t.Error("owner shouldn't set invalid feed")
 		}
 	})
 
 	t.Run("ownerReplacesFeed", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		newOracle, _, _, err := DeployMockOracle(accts[0].Auth, chain)
 		if err != nil {
 			t.Fatal(err)
@@ -638,22 +2694,13 @@ func TestSetFeed(t *testing.T) {
 }
 
 func TestThreshold(t *testing.T) {
-	chain, accts := soltest.New()
-
-	oracleAddr, _, oracleContract, err := DeployMockOracle(accts[0].Auth, chain)
-	if err != nil {
-		t.Fatal(err)
-	}
-	chain.Commit()
-
-	_, _, contract, err := DeployUSDX(accts[0].Auth, chain, oracleAddr)
-	if err != nil {
-		t.Fatal(err)
-	}
-	chain.Commit()
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, oracleContract, contract := env.Chain, env.Oracle, env.USDX
 
 	tt := big.NewInt(3)
 	t.Run("ownerSets", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		if chain.Succeed(contract.SetStalenessThreshold(accts[1].Auth, tt)) {
 			t.Fatal("non-owner shouldn't set threshold")
 		}
@@ -664,6 +2711,7 @@ func TestThreshold(t *testing.T) {
 	})
 
 	t.Run("receive", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		if !chain.Succeed(contract.SetStalenessThreshold(accts[0].Auth, tt)) {
 			t.Fatal("non-owner shouldn't set threshold")
 		}
@@ -675,7 +2723,7 @@ func TestThreshold(t *testing.T) {
 
 		// sending eth should fail when oracle is stale
 		accts[1].Auth.Value = big.NewInt(params.Ether)
-		if chain.Succeed((&USDXRaw{contract}).Transfer(accts[1].Auth)) {
+		if chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[1].Auth)) {
 			t.Fatal("transfer should fail when oracle is stale")
 		}
 
@@ -685,7 +2733,7 @@ func TestThreshold(t *testing.T) {
 		}
 
 		// sending eth should succeed when oracle is fresh
-		if !chain.Succeed((&USDXRaw{contract}).Transfer(accts[1].Auth)) {
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[1].Auth)) {
 			t.Fatal("transfer should succeed when oracle is fresh")
 		}
 
@@ -693,6 +2741,7 @@ func TestThreshold(t *testing.T) {
 	})
 
 	t.Run("appreciation", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		if !chain.Succeed(contract.SetStalenessThreshold(accts[0].Auth, tt)) {
 			t.Fatal("non-owner shouldn't set threshold")
 		}
@@ -704,7 +2753,7 @@ func TestThreshold(t *testing.T) {
 
 		// sending eth should fail when oracle is stale
 		accts[2].Auth.Value = big.NewInt(params.Ether)
-		if !chain.Succeed((&USDXRaw{contract}).Transfer(accts[2].Auth)) {
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[2].Auth)) {
 			t.Fatal("unable to mint usdx")
 		}
 		accts[2].Auth.Value = nil
@@ -732,19 +2781,9 @@ func TestThreshold(t *testing.T) {
 }
 
 func TestTransferAcct(t *testing.T) {
-	chain, accts := soltest.New()
-
-	oracleAddr, _, oracleContract, err := DeployMockOracle(accts[0].Auth, chain)
-	if err != nil {
-		t.Fatal(err)
-	}
-	chain.Commit()
-
-	_, _, contract, err := DeployUSDX(accts[0].Auth, chain, oracleAddr)
-	if err != nil {
-		t.Fatal(err)
-	}
-	chain.Commit()
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contract := env.Chain, env.Accounts, env.Oracle, env.USDX
 
 	// set 1000usd/eth rate in oracle
 	mint := bigint(1000, rate)
@@ -753,45 +2792,59 @@ func TestTransferAcct(t *testing.T) {
 	}
 
 	t.Run("transferToExisting", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		// acct1 mints 1 eth
 		accts[1].Auth.Value = bigint(1, eth)
-		if !chain.Succeed((&USDXRaw{contract}).Transfer(accts[1].Auth)) {
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[1].Auth)) {
 			t.Fatal("unable to transfer")
 		}
 		accts[1].Auth.Value = nil
 
 		// acct2 mints 1 eth
 		accts[2].Auth.Value = bigint(1, eth)
-		if !chain.Succeed((&USDXRaw{contract}).Transfer(accts[2].Auth)) {
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[2].Auth)) {
 			t.Fatal("unable to transfer")
 		}
 		accts[2].Auth.Value = nil
 
-		// acct1 can't send to acct2
+		// acct1 can't send to acct2 until acct2 allows it
 		if chain.Succeed(contract.TransferAcct(accts[1].Auth, accts[2].Addr)) {
-			t.Fatal("shouldn't transfer to account with existing balance")
+			t.Fatal("shouldn't transfer to account which doesn't allow sender")
 		}
-
-		// acct1 redeems
-		if !chain.Succeed(contract.Unlock(accts[1].Auth, zero)) {
-			t.Fatal("redeem failed")
+		if !chain.Succeed(contract.SetLotSender(accts[2].Auth, accts[1].Addr, true)) {
+			t.Fatal("unable to allow lot sender")
+		}
+		// then acct1's lots are added to acct2's
+		if !chain.Succeed(contract.TransferAcct(accts[1].Auth, accts[2].Addr)) {
+			t.Fatal("unable to transfer to account with existing balance")
+		}
+		if acct, err := contract.Accounts(&bind.CallOpts{}, accts[1].Addr); err != nil {
+			t.Fatal("unable to view account")
+		} else if acct.Locked.Sign() != 0 || acct.Lots.Sign() != 0 {
+			t.Fatalf("want empty account, got: %+v", acct)
+		}
+		if acct, err := contract.Accounts(&bind.CallOpts{}, accts[2].Addr); err != nil {
+			t.Fatal("unable to view account")
+		} else if acct.Locked.Cmp(bigint(2, eth)) != 0 || acct.Mint.Cmp(bigint(2000, usdx)) != 0 || acct.Lots.Cmp(big.NewInt(2)) != 0 {
+			t.Fatalf("want 2 lots of 2 eth, 2000 usdx, got: %+v", acct)
 		}
 
-		// acct2 redeems
-		if !chain.Succeed(contract.Unlock(accts[2].Auth, zero)) {
-			t.Fatal("redeem failed")
+		// nor to itself
+		if chain.Succeed(contract.TransferAcct(accts[2].Auth, accts[2].Addr)) {
+			t.Fatal("shouldn't transfer to itself")
 		}
 	})
 
 	t.Run("success", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		// acct1 mints 1 eth
 		accts[1].Auth.Value = bigint(1, eth)
-		if !chain.Succeed((&USDXRaw{contract}).Transfer(accts[1].Auth)) {
+		if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[1].Auth)) {
 			t.Fatal("unable to transfer")
 		}
 		accts[1].Auth.Value = nil
 
-		// transfer account
+		// transfer account; acct2 has no lots, so needn't allow acct1
 		if !chain.Succeed(contract.TransferAcct(accts[1].Auth, accts[2].Addr)) {
 			t.Fatal("account transfer failed")
 		}
@@ -817,22 +2870,151 @@ func TestTransferAcct(t *testing.T) {
 	})
 }
 
-func TestOwner(t *testing.T) {
-	chain, accts := soltest.New()
+// TestLotSenders checks that lots are only added to an account by
+// senders it allows, and only up to MAX_LOTS, so dust lots can't grief
+// an account.  Mints into a full account are added to its newest lot.
+func TestLotSenders(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contract := env.Chain, env.Accounts, env.Oracle, env.USDX
+	opts := &bind.CallOpts{}
 
-	oracleAddr, _, _, err := DeployMockOracle(accts[0].Auth, chain)
-	if err != nil {
-		t.Fatal(err)
+	if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(1000, rate), zero, zero, zero)) {
+		t.Fatal("unable to set oracle round")
 	}
-	chain.Commit()
-
-	_, _, contract, err := DeployUSDX(accts[0].Auth, chain, oracleAddr)
+	// mintFor mints a lot of wei for to, from acct.
+	mintFor := func(acct usdxtest.Account, to common.Address, wei int64) bool {
+		acct.Auth.Value = big.NewInt(wei)
+		defer func() { acct.Auth.Value = nil }()
+		return chain.Succeed(contract.MintFor(acct.Auth, to))
+	}
+	maxLots, err := contract.MAXLOTS(opts)
 	if err != nil {
 		t.Fatal(err)
 	}
-	chain.Commit()
+
+	t.Run("allow", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if mintFor(accts[1], accts[2].Addr, 1) {
+			t.Error("shouldn't add a lot without being allowed")
+		}
+		if !chain.Succeed(contract.SetLotSender(accts[2].Auth, accts[1].Addr, true)) {
+			t.Fatal("unable to allow lot sender")
+		}
+		if ok, _ := contract.AcceptsLots(opts, accts[2].Addr, accts[1].Addr); !ok {
+			t.Error("want accts[1] accepted")
+		}
+		if ok, _ := contract.AcceptsLots(opts, accts[2].Addr, accts[3].Addr); ok {
+			t.Error("want accts[3] not accepted")
+		}
+		if !mintFor(accts[1], accts[2].Addr, 1) {
+			t.Fatal("unable to add a lot once allowed")
+		}
+
+		if !chain.Succeed(contract.SetLotSender(accts[2].Auth, accts[1].Addr, false)) {
+			t.Fatal("unable to revoke lot sender")
+		}
+		if mintFor(accts[1], accts[2].Addr, 1) {
+			t.Error("shouldn't add a lot once revoked")
+		}
+		set, err := contract.FilterLotSenderSet(&bind.FilterOpts{}, []common.Address{accts[2].Addr}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var allowed []bool
+		for set.Next() {
+			allowed = append(allowed, set.Event.Allowed)
+		}
+		if !reflect.DeepEqual(allowed, []bool{true, false}) {
+			t.Errorf("want LotSenderSet allowed, then revoked, got: %v", allowed)
+		}
+	})
+
+	t.Run("allowAll", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if !chain.Succeed(contract.SetLotSender(accts[2].Auth, common.Address{}, true)) {
+			t.Fatal("unable to allow every lot sender")
+		}
+		if !mintFor(accts[3], accts[2].Addr, 1) {
+			t.Fatal("unable to add a lot once every sender is allowed")
+		}
+	})
+
+	t.Run("maxLots", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
+		if !chain.Succeed(contract.SetLotSender(accts[2].Auth, common.Address{}, true)) {
+			t.Fatal("unable to allow every lot sender")
+		}
+		// Alternate prices, so each mint is a new lot.
+		for i := int64(0); i < maxLots.Int64(); i++ {
+			if !chain.Succeed(oracleContract.SetLastRound(accts[0].Auth, zero, bigint(1000*(1+i%2), rate), zero, zero, zero)) {
+				t.Fatal("unable to set oracle round")
+			}
+			if !mintFor(accts[1], accts[2].Addr, 1) {
+				t.Fatalf("unable to add lot %d", i)
+			}
+		}
+		last, err := contract.LastLotId(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !mintFor(accts[1], accts[2].Addr, 1) || !mintFor(accts[2], accts[2].Addr, params.Ether) {
+			t.Fatal("unable to mint into a full account")
+		}
+		if acct, _ := contract.Accounts(opts, accts[2].Addr); acct.Lots.Cmp(maxLots) != 0 || acct.LastLot.Cmp(last) != 0 {
+			t.Fatalf("want %v lots, the newest %v, got: %v, %v", maxLots, last, acct.Lots, acct.LastLot)
+		}
+		if l, _ := contract.Lots(opts, last); l.Locked.Cmp(big.NewInt(params.Ether+2)) != 0 {
+			t.Errorf("want newest lot of %v wei, got: %v", params.Ether+2, l.Locked)
+		}
+		// An account can't be transferred into a full one.
+		if !mintFor(accts[3], accts[3].Addr, params.Ether) {
+			t.Fatal("unable to mint")
+		}
+		if chain.Succeed(contract.TransferAcct(accts[3].Auth, accts[2].Addr)) {
+			t.Error("shouldn't transfer account into a full account")
+		}
+
+		// Every loop over the full account fits in a block, and
+		// neither transferAcct nor unlock is paused.
+		if _, err := contract.Appreciation(opts, accts[2].Addr); err != nil {
+			t.Fatal(err)
+		}
+		if !chain.Succeed(contract.SetGuardian(accts[0].Auth, accts[5].Addr)) || !chain.Succeed(contract.Pause(accts[5].Auth)) {
+			t.Fatal("unable to pause")
+		}
+		if !chain.Succeed(contract.SetLotSender(accts[4].Auth, accts[2].Addr, true)) {
+			t.Fatal("unable to allow lot sender")
+		}
+		if !chain.Succeed(contract.TransferAcct(accts[2].Auth, accts[4].Addr)) {
+			t.Fatal("unable to transfer full account while paused")
+		}
+		if acct, _ := contract.Accounts(opts, accts[4].Addr); acct.Lots.Cmp(maxLots) != 0 {
+			t.Errorf("want %v lots transferred, got: %v", maxLots, acct.Lots)
+		}
+		bal, err := contract.BalanceOf(opts, accts[2].Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !chain.Succeed(contract.Transfer(accts[2].Auth, accts[4].Addr, bal)) {
+			t.Fatal("unable to transfer usdx")
+		}
+		if !chain.Succeed(contract.Unlock(accts[4].Auth, zero)) {
+			t.Fatal("unable to unlock full account while paused")
+		}
+		if acct, _ := contract.Accounts(opts, accts[4].Addr); acct.Lots.Sign() != 0 {
+			t.Errorf("want every lot unlocked, got: %v lots", acct.Lots)
+		}
+	})
+}
+
+func TestOwner(t *testing.T) {
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, contract := env.Chain, env.USDX
 
 	t.Run("constructorSetsOwner", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		owner, err := contract.Owner(&bind.CallOpts{})
 		if err != nil {
 			t.Errorf("uexpected err reading owner: %v", err)
@@ -843,6 +3025,7 @@ func TestOwner(t *testing.T) {
 	})
 
 	t.Run("ownerChangesOwner", func(t *testing.T) {
+		accts := env.Isolate(t).Accounts
 		// acct1 can't change owner
 		if chain.Succeed(contract.TransferOwnership(accts[1].Auth, accts[2].Addr)) {
 			t.Error("non-owner can TransferOwnership")
@@ -878,19 +3061,9 @@ func TestOwner(t *testing.T) {
 }
 
 func TestERC20(t *testing.T) {
-	chain, accts := soltest.New()
-
-	oracleAddr, _, oracleContract, err := DeployMockOracle(accts[0].Auth, chain)
-	if err != nil {
-		t.Fatal(err)
-	}
-	chain.Commit()
-
-	_, _, contract, err := DeployUSDX(accts[0].Auth, chain, oracleAddr)
-	if err != nil {
-		t.Fatal(err)
-	}
-	chain.Commit()
+	t.Parallel()
+	env := usdxtest.NewEnv(t, cover)
+	chain, accts, oracleContract, contract := env.Chain, env.Accounts, env.Oracle, env.USDX
 
 	txEvts := make(chan *USDXTransfer, 1)
 	txSub, err := contract.USDXFilterer.WatchTransfer(nil, txEvts, nil, nil)
@@ -921,7 +3094,7 @@ func TestERC20(t *testing.T) {
 
 	// accts1 sends 1 eth to get 1000 usdx
 	accts[1].Auth.Value = bigint(1, eth)
-	if !chain.Succeed((&USDXRaw{contract}).Transfer(accts[1].Auth)) {
+	if !chain.Succeed((&USDXRaw{Contract: contract}).Transfer(accts[1].Auth)) {
 		t.Fatal("unable to transfer")
 	}
 	accts[1].Auth.Value = nil
@@ -0,0 +1,230 @@
+// Package usdxtest provides a simulated chain with MockOracle and USDX
+// deployed, for testing code which integrates with USDX.
+//
+//	func TestIntegration(t *testing.T) {
+//		env := usdxtest.NewEnv(t)
+//		env.SetPrice(big.NewInt(2000e8))
+//
+//		t.Run("mint", func(t *testing.T) {
+//			e := env.Isolate(t)
+//			e.Mint(e.Accounts[1], big.NewInt(1e18))
+//			e.AssertSupply(usdxtest.USDX(2000))
+//		})
+//		t.Run("noSupply", func(t *testing.T) {
+//			e := env.Isolate(t) // mint was reverted
+//			e.AssertSupply(new(big.Int))
+//		})
+//	}
+//
+// Isolated envs share their parent's chain, so subtests using them
+// must not run in parallel.
+package usdxtest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/royalfork/usdx/pkg/snapshot"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+// NumAccounts is the number of funded accounts in an Env.
+const NumAccounts = 10
+
+// GasLimit is the gas limit of every block.
+const GasLimit = 10000000
+
+// Balance is the wei each account is funded with.
+var Balance = new(big.Int).Mul(big.NewInt(1e6), big.NewInt(1e18))
+
+// USDX returns n whole usdx, in usdx's smallest unit.
+func USDX(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e18))
+}
+
+// Chain is a simulated chain.  Transactions are pending until Commit.
+type Chain struct {
+	*backends.SimulatedBackend
+}
+
+// Succeed commits tx, and returns whether it was sent and executed
+// successfully.
+func (c *Chain) Succeed(tx *types.Transaction, err error) bool {
+	if err != nil {
+		return false
+	}
+	c.Commit()
+	rcpt, err := c.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		return false
+	}
+	return rcpt.Status == types.ReceiptStatusSuccessful
+}
+
+// Account is a funded account.  Auth has a zero gas price, so an
+// account's eth balance only changes by the value it sends and
+// receives.
+type Account struct {
+	Addr common.Address
+	Key  *ecdsa.PrivateKey
+	Auth *bind.TransactOpts
+}
+
+// Env is a chain with MockOracle and USDX deployed by Accounts[0].
+// Its helpers fail the test it's bound to.
+type Env struct {
+	t        testing.TB
+	Chain    *Chain
+	Accounts []Account
+
+	OracleAddr common.Address
+	Oracle     *usdx.MockOracle
+	USDXAddr   common.Address
+	USDX       *usdx.USDX
+
+	round int64 // last oracle round
+}
+
+// NewEnv deploys MockOracle and USDX to a new chain.  The oracle has
+// no price until SetPrice is called.  Each of attach is called with the
+// chain before anything is deployed, e.g. to trace its transactions.
+func NewEnv(t testing.TB, attach ...func(*Chain)) *Env {
+	t.Helper()
+	alloc := make(core.GenesisAlloc)
+	accts := make([]Account, NumAccounts)
+	for i := range accts {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+		if err != nil {
+			t.Fatal(err)
+		}
+		auth.GasPrice = new(big.Int)
+		accts[i] = Account{auth.From, key, auth}
+		alloc[auth.From] = core.GenesisAccount{Balance: Balance}
+	}
+	chain := &Chain{backends.NewSimulatedBackend(alloc, GasLimit)}
+	t.Cleanup(func() { chain.Close() })
+	for _, f := range attach {
+		f(chain)
+	}
+
+	e := &Env{t: t, Chain: chain, Accounts: accts}
+	var err error
+	if e.OracleAddr, _, e.Oracle, err = usdx.DeployMockOracle(accts[0].Auth, chain); err != nil {
+		t.Fatalf("unable to deploy MockOracle: %v", err)
+	}
+	chain.Commit()
+	if e.USDXAddr, _, e.USDX, err = usdx.DeployUSDX(accts[0].Auth, chain, e.OracleAddr); err != nil {
+		t.Fatalf("unable to deploy USDX: %v", err)
+	}
+	chain.Commit()
+	return e
+}
+
+// Isolate returns a copy of e bound to t.  The chain is reverted to
+// its current state when t completes, so changes made within t don't
+// leak into later tests.
+func (e *Env) Isolate(t testing.TB) *Env {
+	t.Helper()
+	snap := e.Snapshot()
+	t.Cleanup(func() {
+		if err := snap.Revert(); err != nil {
+			t.Errorf("unable to revert chain: %v", err)
+		}
+	})
+
+	c := *e
+	c.t = t
+	c.Accounts = make([]Account, len(e.Accounts))
+	for i, a := range e.Accounts {
+		auth := *a.Auth
+		a.Auth = &auth
+		c.Accounts[i] = a
+	}
+	return &c
+}
+
+// Snapshot snapshots e's chain.
+func (e *Env) Snapshot() *snapshot.Snapshot {
+	return snapshot.Take(e.Chain)
+}
+
+// Revert reverts e's chain to snap.
+func (e *Env) Revert(snap *snapshot.Snapshot) {
+	e.t.Helper()
+	if err := snap.Revert(); err != nil {
+		e.t.Fatalf("unable to revert chain: %v", err)
+	}
+}
+
+// SetPrice starts a new oracle round answering the eth/usd price,
+// which has 8 decimals.
+func (e *Env) SetPrice(price *big.Int) {
+	e.t.Helper()
+	e.round++
+	round := big.NewInt(e.round)
+	now := new(big.Int).SetUint64(e.Chain.Blockchain().CurrentHeader().Time)
+	if !e.Chain.Succeed(e.Oracle.SetLastRound(e.Accounts[0].Auth, round, price, now, now, round)) {
+		e.t.Fatalf("unable to set price: %v", price)
+	}
+}
+
+// Mint sends wei from acct to USDX, and returns the usdx minted.
+func (e *Env) Mint(acct Account, wei *big.Int) *big.Int {
+	e.t.Helper()
+	before := e.BalanceOf(acct.Addr)
+	acct.Auth.Value = wei
+	defer func() { acct.Auth.Value = nil }()
+	if !e.Chain.Succeed((&usdx.USDXRaw{Contract: e.USDX}).Transfer(acct.Auth)) {
+		e.t.Fatalf("unable to mint with %v wei", wei)
+	}
+	return new(big.Int).Sub(e.BalanceOf(acct.Addr), before)
+}
+
+// BalanceOf returns addr's usdx balance.
+func (e *Env) BalanceOf(addr common.Address) *big.Int {
+	e.t.Helper()
+	bal, err := e.USDX.BalanceOf(&bind.CallOpts{}, addr)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	return bal
+}
+
+// AssertAccount checks addr's locked eth and minted usdx.
+func (e *Env) AssertAccount(addr common.Address, locked, mint *big.Int) {
+	e.t.Helper()
+	acct, err := e.USDX.Accounts(&bind.CallOpts{}, addr)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	if acct.Locked.Cmp(locked) != 0 {
+		e.t.Errorf("%s: want locked: %v, got: %v", addr.Hex(), locked, acct.Locked)
+	}
+	if acct.Mint.Cmp(mint) != 0 {
+		e.t.Errorf("%s: want mint: %v, got: %v", addr.Hex(), mint, acct.Mint)
+	}
+}
+
+// AssertSupply checks USDX's total supply.
+func (e *Env) AssertSupply(supply *big.Int) {
+	e.t.Helper()
+	got, err := e.USDX.TotalSupply(&bind.CallOpts{})
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	if got.Cmp(supply) != 0 {
+		e.t.Errorf("want supply: %v, got: %v", supply, got)
+	}
+}
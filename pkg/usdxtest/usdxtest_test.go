@@ -0,0 +1,61 @@
+package usdxtest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+func TestEnv(t *testing.T) {
+	env := NewEnv(t)
+	env.SetPrice(big.NewInt(2000e8))
+	eth := big.NewInt(1e18)
+
+	if feed, err := env.USDX.UsdPriceFeed(&bind.CallOpts{}); err != nil || feed != env.OracleAddr {
+		t.Fatalf("want price feed: %s, got: %s, err: %v", env.OracleAddr.Hex(), feed.Hex(), err)
+	}
+
+	t.Run("mint", func(t *testing.T) {
+		e := env.Isolate(t)
+		acct := e.Accounts[1]
+		if minted := e.Mint(acct, eth); minted.Cmp(USDX(2000)) != 0 {
+			t.Errorf("want minted: %v, got: %v", USDX(2000), minted)
+		}
+		e.AssertAccount(acct.Addr, eth, USDX(2000))
+		e.AssertSupply(USDX(2000))
+
+		e.SetPrice(big.NewInt(1000e8))
+		if minted := e.Mint(acct, eth); minted.Cmp(USDX(1000)) != 0 {
+			t.Errorf("want minted: %v, got: %v", USDX(1000), minted)
+		}
+		e.AssertAccount(acct.Addr, new(big.Int).Mul(eth, big.NewInt(2)), USDX(3000))
+		e.AssertSupply(USDX(3000))
+	})
+
+	t.Run("isolated", func(t *testing.T) {
+		e := env.Isolate(t)
+		e.AssertAccount(e.Accounts[1].Addr, new(big.Int), new(big.Int))
+		e.AssertSupply(new(big.Int))
+		if minted := e.Mint(e.Accounts[1], eth); minted.Cmp(USDX(2000)) != 0 {
+			t.Errorf("want price restored, minted: %v", minted)
+		}
+
+		t.Run("nested", func(t *testing.T) {
+			e := e.Isolate(t)
+			e.Mint(e.Accounts[2], eth)
+			e.AssertSupply(USDX(4000))
+		})
+		e.AssertSupply(USDX(2000))
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		e := env.Isolate(t)
+		snap := e.Snapshot()
+		e.Mint(e.Accounts[3], eth)
+		e.Revert(snap)
+		e.AssertSupply(new(big.Int))
+	})
+
+	env.AssertSupply(new(big.Int))
+}
@@ -0,0 +1,174 @@
+// Package verify checks that deployed code is what USDX's sources
+// compile to.
+//
+// The expected runtime code is found by executing USDX's init code
+// with the deployment's constructor arguments, at the deployment's
+// address, so values the constructor sets (such as immutables, like
+// the address of the USDXPositions it creates) are expected too.  solc
+// appends CBOR encoded metadata, which includes a hash of the sources
+// and compiler settings, to runtime code.  Code which differs only in
+// its metadata was compiled from different (but equivalent) sources or
+// settings, such as changed comments or a different source path.
+package verify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/royalfork/usdx/pkg/deploy"
+)
+
+// Status is the outcome of comparing deployed code with expected code.
+type Status int
+
+const (
+	// Match is deployed code identical to the expected code.
+	Match Status = iota
+	// MetadataOnly is deployed code which differs from the expected
+	// code only in its metadata.
+	MetadataOnly
+	// Diverged is deployed code which executes differently from the
+	// expected code.
+	Diverged
+)
+
+func (s Status) String() string {
+	switch s {
+	case Match:
+		return "exact match"
+	case MetadataOnly:
+		return "metadata differs"
+	case Diverged:
+		return "diverged"
+	}
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+// ErrNoCode is returned when there's no code to verify.
+var ErrNoCode = errors.New("no code")
+
+// Result compares deployed code with expected code.
+type Result struct {
+	Status Status
+
+	// Code and metadata of the deployed and expected code.
+	Deployed, Expected                 []byte
+	DeployedMetadata, ExpectedMetadata []byte
+
+	// Offset is the first byte at which the deployed code, excluding
+	// metadata, differs from the expected code.  It's only set when
+	// Status is Diverged.
+	Offset int
+}
+
+func (r *Result) String() string {
+	switch r.Status {
+	case Match:
+		return fmt.Sprintf("%s (%d bytes)", r.Status, len(r.Deployed))
+	case MetadataOnly:
+		return fmt.Sprintf("%s: deployed 0x%x, expected 0x%x", r.Status, r.DeployedMetadata, r.ExpectedMetadata)
+	}
+	return fmt.Sprintf("%s at byte %d: deployed %d bytes, expected %d bytes", r.Status, r.Offset, len(r.Deployed), len(r.Expected))
+}
+
+// SplitMetadata splits code into its executable code and solc's
+// metadata, which is CBOR encoded and followed by its 2 byte big
+// endian length.  The returned metadata includes the length.  If code
+// has no metadata, it's returned whole.
+func SplitMetadata(code []byte) (exec, metadata []byte) {
+	if len(code) < 2 {
+		return code, nil
+	}
+	n := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	start := len(code) - 2 - n
+	// Metadata is a CBOR map (major type 5).
+	if n == 0 || start < 0 || code[start]>>5 != 5 {
+		return code, nil
+	}
+	return code[:start], code[start:]
+}
+
+// Compare compares deployed runtime code with expected runtime code.
+func Compare(deployed, expected []byte) (*Result, error) {
+	if len(deployed) == 0 || len(expected) == 0 {
+		return nil, ErrNoCode
+	}
+	r := &Result{Deployed: deployed, Expected: expected}
+	if bytes.Equal(deployed, expected) {
+		r.Status = Match
+		return r, nil
+	}
+
+	var dexec, eexec []byte
+	dexec, r.DeployedMetadata = SplitMetadata(deployed)
+	eexec, r.ExpectedMetadata = SplitMetadata(expected)
+	if bytes.Equal(dexec, eexec) {
+		r.Status = MetadataOnly
+		return r, nil
+	}
+
+	r.Status = Diverged
+	for r.Offset < len(dexec) && r.Offset < len(eexec) && dexec[r.Offset] == eexec[r.Offset] {
+		r.Offset++
+	}
+	return r, nil
+}
+
+// feedStub is runtime code which returns 8 to any call, so it answers
+// decimals() like a chainlink eth/usd feed.
+//
+//	PUSH1 8 PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 RETURN
+var feedStub = common.FromHex("0x600860005260206000f3")
+
+// USDXRuntime returns the runtime code deployed to addr by USDX's init
+// code with constructor argument priceFeed.
+func USDXRuntime(addr, priceFeed common.Address) ([]byte, error) {
+	initCode, err := deploy.USDXInitCode(priceFeed)
+	if err != nil {
+		return nil, err
+	}
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		return nil, err
+	}
+	// The constructor checks the feed's decimals.
+	statedb.SetCode(priceFeed, feedStub)
+	// Calling the init code at addr executes it as deploying to addr
+	// would: contracts it creates get the deployment's addresses,
+	// since a new contract's nonce starts at 1.
+	statedb.SetCode(addr, initCode)
+	statedb.SetNonce(addr, 1)
+	code, _, err := runtime.Call(addr, nil, &runtime.Config{
+		State:    statedb,
+		GasLimit: 30000000,
+		Value:    new(big.Int),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing init code: %w", err)
+	}
+	return code, nil
+}
+
+// USDX compares the code at addr with USDX deployed with priceFeed.
+func USDX(ctx context.Context, backend bind.ContractCaller, addr, priceFeed common.Address) (*Result, error) {
+	deployed, err := backend.CodeAt(ctx, addr, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(deployed) == 0 {
+		return nil, fmt.Errorf("%s: %w", addr.Hex(), ErrNoCode)
+	}
+	expected, err := USDXRuntime(addr, priceFeed)
+	if err != nil {
+		return nil, err
+	}
+	return Compare(deployed, expected)
+}
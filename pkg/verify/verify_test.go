@@ -0,0 +1,93 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/royalfork/soltest"
+	"github.com/royalfork/usdx/pkg/usdx"
+)
+
+func TestSplitMetadata(t *testing.T) {
+	for _, tt := range []struct {
+		code, exec, metadata string
+	}{
+		{"6000" + "a1626964" + "0004", "6000", "a1626964" + "0004"},
+		{"6000", "6000", ""},
+		{"6000" + "0004", "60000004", ""},          // metadata isn't a map
+		{"6000" + "a1" + "00ff", "6000a100ff", ""}, // length exceeds code
+		{"00", "00", ""},
+	} {
+		exec, metadata := SplitMetadata(common.FromHex(tt.code))
+		if !bytes.Equal(exec, common.FromHex(tt.exec)) || !bytes.Equal(metadata, common.FromHex(tt.metadata)) {
+			t.Errorf("%s: want %s %s, got: %x %x", tt.code, tt.exec, tt.metadata, exec, metadata)
+		}
+	}
+}
+
+func TestUSDX(t *testing.T) {
+	chain, accts := soltest.New()
+	oracleAddr, _, _, err := usdx.DeployMockOracle(accts[0].Auth, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+	addr, _, _, err := usdx.DeployUSDX(accts[0].Auth, chain, oracleAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+
+	ctx := context.Background()
+	res, err := USDX(ctx, chain, addr, oracleAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != Match {
+		t.Fatalf("want %v, got: %v", Match, res)
+	}
+	if res, err := USDX(ctx, chain, oracleAddr, accts[1].Addr); err != nil || res.Status != Diverged {
+		t.Fatalf("want MockOracle to diverge from USDX, got: %v, err: %v", res, err)
+	}
+	// The USDXPositions USDX creates depends on USDX's address.
+	if elsewhere, err := USDXRuntime(accts[1].Addr, oracleAddr); err != nil {
+		t.Fatal(err)
+	} else if res, err := Compare(res.Deployed, elsewhere); err != nil || res.Status != Diverged {
+		t.Fatalf("want USDX deployed elsewhere to diverge, got: %v, err: %v", res, err)
+	}
+	if _, err := USDX(ctx, chain, accts[1].Addr, oracleAddr); err == nil {
+		t.Fatal("want error verifying an account without code")
+	}
+
+	deployed := res.Deployed
+	_, metadata := SplitMetadata(deployed)
+	if len(metadata) == 0 {
+		t.Fatal("deployed code has no metadata")
+	}
+
+	t.Run("metadataOnly", func(t *testing.T) {
+		code := common.CopyBytes(deployed)
+		code[len(code)-10] ^= 0xff // within the metadata hash
+		res, err := Compare(code, deployed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Status != MetadataOnly {
+			t.Fatalf("want %v, got: %v", MetadataOnly, res)
+		}
+	})
+
+	t.Run("diverged", func(t *testing.T) {
+		code := common.CopyBytes(deployed)
+		code[100] ^= 0xff
+		res, err := Compare(code, deployed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Status != Diverged || res.Offset != 100 {
+			t.Fatalf("want %v at byte 100, got: %v", Diverged, res)
+		}
+	})
+}
@@ -3,6 +3,7 @@ pragma solidity ^0.8.2;
 
 import "./chainlink/evm-contracts/src/v0.7/interfaces/AggregatorV3Interface.sol";
 import "./openzeppelin-contracts/contracts/token/ERC20/ERC20.sol";
+import "./openzeppelin-contracts/contracts/token/ERC721/ERC721.sol";
 import "./openzeppelin-contracts/contracts/access/Ownable.sol";
 import "./openzeppelin-contracts/contracts/utils/math/SafeMath.sol";
 import "./openzeppelin-contracts/contracts/utils/math/SafeCast.sol";
@@ -20,25 +21,202 @@ import "./openzeppelin-contracts/contracts/utils/math/SafeCast.sol";
  *   - Eth sent to the USDX contract is locked, and USDX is minted
  *     into the sender's account at the current eth/usd exchange rate.
  *   - Locked eth can be redeemed by the original sender by burning USDX
- *     at the originally minted price.
+ *     at the originally minted price.  Each mint is a lot, redeemed at
+ *     its own price; lots are redeemed first-in-first-out unless
+ *     another order or lot is chosen.  Redeemed eth is credited to the
+ *     sender's withdrawable balance, and sent with withdraw().
+ *   - Each lot is an ERC-721 token of the USDXPositions contract.
+ *     Transferring the token transfers the lot, and the right to
+ *     redeem it.  Lots are only added to an account by its owner, or
+ *     by senders the owner allows, and an account has at most
+ *     MAX_LOTS lots.  A mint at the price of an account's newest lot,
+ *     or into a full account, is added to the newest lot.
+ *   - Account owners may place stop-loss orders, to unlock if eth/usd
+ *     falls to a price, and take-profit orders, to collect
+ *     appreciation if it rises to one.  Anyone may execute an order
+ *     once the oracle's price triggers it.
+ *   - Account owners may delegate collecting their appreciation to a
+ *     collector, such as a keeper; collected usdx is still minted to
+ *     the owner.
+ *   - Any usdx holder may redeem usdx for eth at the current eth/usd
+ *     exchange rate, taken from the lots with the most eth locked
+ *     per usdx minted.
+ *   - If the oracle fails, USDX can be shut down at a fixed settlement
+ *     price: each lot's usdx is settled for its eth at that price, any
+ *     surplus eth returned to its owner, and usdx holders claim the
+ *     settled eth pro rata.
+ *   - A guardian may pause minting and collecting appreciation, such
+ *     as during an oracle incident.  Unlocking and transferring
+ *     accounts are never paused.
+ *   - Owner may cap usdx's total supply, and the usdx minted per block
+ *     or per window of time, to bound what an oracle failure can mint.
  *
- *   Note: Owner is able to set the eth/usd oracle.
+ *   Note: Owner is able to set the eth/usd oracle, the guardian and
+ *   the mint limits, and shut USDX down.
  */
 contract USDX is ERC20, Ownable {
 	using SafeMath for uint256;
 	using SafeCast for int256;
+	using SafeCast for uint256;
 	uint8 constant FEED_DECS = 8;
 	AggregatorV3Interface public usdPriceFeed;
 	uint80 public priceStalenessThreshold = 0;
 
+	// An account's locked and mint are the sums of its lots, which
+	// are a list in the order they were added to the account.  An
+	// account has at most MAX_LOTS lots, so every loop over an
+	// account's lots is bounded.  The lot fields share a slot.
 	struct account {
-		uint256 locked; // eth
-		uint256 mint;   // usdx
+		uint256 locked;   // eth
+		uint256 mint;     // usdx
+		uint40 firstLot;  // id, or 0 if the account has no lots
+		uint40 lastLot;
+		uint40 lots;      // number of lots
 	}
 	mapping (address => account) public accounts;
+	uint256 public constant MAX_LOTS = 100;
+
+	// Lots are only added to an account by its owner, and senders it
+	// allows: by minting to it, transferring it a lot's token, or
+	// transferring it an account once it has lots.  Otherwise anyone
+	// could fill an account with dust lots, up to MAX_LOTS.  Allowing
+	// the zero address allows every sender.
+	mapping (address => mapping (address => bool)) public lotSenders; // owner => sender => allowed
+
+	event LotSenderSet(address indexed owner, address indexed sender, bool allowed);
+	mapping (address => uint256) public withdrawable; // eth
+
+	// A lot is the eth locked, and usdx minted, by mints into an
+	// account.  Lot ids start at 1, and aren't reused.  A lot is 2
+	// slots: its owner and links, and its amounts.
+	struct lot {
+		address owner;
+		uint16 bucket;  // redemption bucket; see bucketOf
+		uint40 prev;    // owner's previous lot id, or 0
+		uint40 next;    // owner's next lot id, or 0
+		uint128 locked; // eth
+		uint128 mint;   // usdx
+	}
+	mapping (uint256 => lot) public lots;
+	uint40 public lastLotId;
+
+	// Lots are redeemed from in order of their break-even rate, the
+	// eth/usd rate at which their eth is worth their mint: lowest
+	// first, so most eth locked per usdx minted first.  Lots are
+	// indexed by bucket of break-even rate, and redeem only redeems
+	// from lots in the lowest bucket with lots.  A bucket is 1/64th
+	// of a power of two wide, so lots in a bucket differ by less than
+	// 1.6%, and are redeemed from in any order.  Lots minting no usdx
+	// aren't redeemable, and are in bucket 0, which isn't indexed.
+	mapping (uint256 => uint256) private bucketLots; // bucket => number of lots
+	mapping (uint256 => uint256) private bucketMap;  // bucket/256 => bit per bucket with lots
+	uint256 constant MAX_BUCKET = 128 * 64;
+
+	// Lot id moved from from's account to the end of to's, with its
+	// locked eth and minted usdx, by a transfer of its token or by
+	// transferAcct.
+	event LotTransferred(address indexed from, address indexed to, uint256 indexed id, uint256 locked, uint256 mint);
+
+	enum LotOrder { FIFO, LIFO }
+
+	// An order is an account owner's standing instruction, executable
+	// by anyone once the eth/usd rate triggers it.  A stop-loss order
+	// unlocks up to amount usdx (0 is all) of its owner's lots, FIFO,
+	// once the rate is at or below price; a take-profit order collects
+	// up to amount usdx (0 is all) of its owner's appreciation once
+	// the rate is at or above price.  Unlocked eth is credited to the
+	// owner's withdrawable balance, and collected usdx minted to the
+	// owner, so an executor is only able to choose when an order is
+	// executed, never where its proceeds go.  Orders are executed
+	// once.  Order ids start at 1, and aren't reused.
+	enum OrderKind { StopLoss, TakeProfit }
+	struct order {
+		address owner;
+		OrderKind kind;
+		int256 price;   // eth/usd rate, in the price feed's 8 decimals
+		uint256 amount; // usdx
+	}
+	mapping (uint256 => order) public orders;
+	uint256 public lastOrderId;
+
+	event OrderPlaced(uint256 indexed id, address indexed owner, OrderKind kind, int256 price, uint256 amount);
+	event OrderCancelled(uint256 indexed id);
+	event OrderExecuted(uint256 indexed id, address indexed executor, uint256 amount);
+
+	// An account's collector may collect the account's appreciation,
+	// minted to the account, at least threshold usdx at a time.
+	// Collectors are set by address, and aren't moved by transferAcct.
+	struct delegation {
+		address collector;
+		uint256 threshold; // usdx
+	}
+	mapping (address => delegation) public collectors;
+
+	event CollectorSet(address indexed owner, address indexed collector, uint256 threshold);
+
+	// Appreciation of owner's lots collected as usdx minted to owner,
+	// however it was collected.  It's logged right after the mint's
+	// Transfer, which is otherwise indistinguishable from a lock's.
+	event Collected(address indexed owner, uint256 usdx);
+
+	// The sum of every account's locked eth.
+	uint256 public totalLocked;
+
+	// A redemption of usdx of redeemer's, for eth of owner's lot at
+	// the current rate.  surplus is the eth left in the lot when its
+	// mint was fully redeemed, credited to owner's withdrawable
+	// balance.
+	event Redeemed(address indexed redeemer, address indexed owner, uint256 indexed lot, uint256 usdx, uint256 eth, uint256 surplus);
+
+	// Shutdown.  Once settlementPrice is set, minting, unlocking,
+	// collecting and redeeming stop.  Anyone may settle lots: a lot's
+	// mint is worth mint/settlementPrice eth, capped to its locked
+	// eth, which is added to settlementPool, and the rest of its eth
+	// is credited to its owner.  Once every lot is settled, usdx
+	// holders claim settlementPool pro rata: at par if the system was
+	// collateralized at settlementPrice, and less otherwise.  A
+	// settled lot's mint leaves its account, but its usdx is only
+	// burned when claimed, so accounts' mints and settledSupply sum to
+	// totalSupply.
+	uint256 constant SHUTDOWN_STALENESS = 3 days;
+	int256 public settlementPrice; // 0 until shutdown
+	uint256 public settlementPool; // eth, for usdx holders
+	uint256 public lotCount;       // lots which exist
+	uint256 public settledSupply;  // usdx of settled lots, until claimed
+
+	event Shutdown(int256 price);
+	event LotSettled(uint256 indexed id, address indexed owner, uint256 mint, uint256 debt, uint256 surplus);
+	event Claimed(address indexed holder, uint256 usdx, uint256 eth);
+
+	// The guardian may pause minting and collecting appreciation,
+	// which mint usdx at the oracle's rate.  Nothing which burns usdx
+	// or moves eth out, such as unlock, and nothing which moves
+	// accounts, such as transferAcct, is paused.
+	address public guardian;
+	bool public paused;
+
+	event GuardianSet(address indexed guardian);
+	event Paused(address indexed guardian);
+	event Unpaused(address indexed guardian);
+
+	// Mint limits, on all usdx minted, by minting or collecting
+	// appreciation.  supplyCap caps totalSupply, and mintLimit caps the
+	// usdx minted in each window: mintWindow seconds, aligned to
+	// multiples of mintWindow, or a block if mintWindow is 0.  A limit
+	// of 0 is no limit.
+	uint256 public supplyCap;  // usdx
+	uint256 public mintLimit;  // usdx
+	uint256 public mintWindow; // seconds
+	uint256 private window;       // start time, or number, of the latest window minted in
+	uint256 private windowMinted; // usdx minted in window
+
+	event MintLimitsSet(uint256 supplyCap, uint256 mintLimit, uint256 mintWindow);
+
+	USDXPositions public immutable positions;
 
 	constructor (address _priceFeed) ERC20("USDX Stablecoin", "USDX") {
 		setFeed(_priceFeed);
+		positions = new USDXPositions();
 	}
 
 	// _newFeed's decimals parameter must immutably be set to 8.
@@ -52,17 +230,172 @@ contract USDX is ERC20, Ownable {
 		priceStalenessThreshold = _newThreshold;
 	}
 
+	// Sets the guardian.  The zero address leaves USDX without one,
+	// as it's deployed; a pause is kept until a guardian unpauses.
+	function setGuardian(address _guardian) public onlyOwner {
+		guardian = _guardian;
+		emit GuardianSet(_guardian);
+	}
+
+	function pause() public onlyGuardian {
+		require(!paused, "paused");
+		paused = true;
+		emit Paused(msg.sender);
+	}
+
+	function unpause() public onlyGuardian {
+		require(paused, "not paused");
+		paused = false;
+		emit Unpaused(msg.sender);
+	}
+
+	// Sets the mint limits.  usdx minted in the current window counts
+	// toward a new mintLimit, unless mintWindow changes.  Lowering
+	// supplyCap below totalSupply stops minting, without burning
+	// anything.
+	function setMintLimits(uint256 _supplyCap, uint256 _mintLimit, uint256 _mintWindow) public onlyOwner {
+		if (_mintWindow != mintWindow) {
+			windowMinted = 0;
+		}
+		supplyCap = _supplyCap;
+		mintLimit = _mintLimit;
+		mintWindow = _mintWindow;
+		emit MintLimitsSet(_supplyCap, _mintLimit, _mintWindow);
+	}
+
+	// Returns the usdx which may be minted before totalSupply reaches
+	// supplyCap, or the max uint256 if uncapped.
+	function supplyHeadroom() public view returns (uint256) {
+		if (supplyCap == 0) {
+			return type(uint256).max;
+		}
+		(, uint256 room) = SafeMath.trySub(supplyCap, totalSupply());
+		return room;
+	}
+
+	// Returns the usdx which may be minted in the current window, or
+	// the max uint256 if unlimited.
+	function windowHeadroom() public view returns (uint256) {
+		if (mintLimit == 0) {
+			return type(uint256).max;
+		}
+		uint256 minted = window == currentWindow() ? windowMinted : 0;
+		(, uint256 room) = SafeMath.trySub(mintLimit, minted);
+		return room;
+	}
+
+	// Returns the usdx which may be minted now: the lesser of
+	// supplyHeadroom and windowHeadroom.
+	function mintHeadroom() public view returns (uint256) {
+		return min(supplyHeadroom(), windowHeadroom());
+	}
+
+	function currentWindow() private view returns (uint256) {
+		if (mintWindow == 0) {
+			return block.number;
+		}
+		return block.timestamp - block.timestamp % mintWindow;
+	}
+
+	// Mints are checked against, and counted toward, the mint limits.
+	function _beforeTokenTransfer(address _from, address _to, uint256 _amount) internal override {
+		super._beforeTokenTransfer(_from, _to, _amount);
+		if (_from != address(0)) {
+			return;
+		}
+		require(_amount <= supplyHeadroom(), "supply cap exceeded");
+		require(_amount <= windowHeadroom(), "mint limit exceeded");
+		uint256 w = currentWindow();
+		if (w != window) {
+			window = w;
+			windowMinted = 0;
+		}
+		windowMinted += _amount;
+	}
+
+	modifier onlyGuardian() {
+		require(msg.sender == guardian, "not guardian");
+		_;
+	}
+
+	modifier whenNotPaused() {
+		require(!paused, "paused");
+		_;
+	}
+
+	modifier live() {
+		require(settlementPrice == 0, "shut down");
+		_;
+	}
+
 	// Received eth is minted into usdx at the current eth/usd
 	// exchange rate, and locked in sender's account.
 	// Note: msg.sender must be payable to allow unlocked of
 	// deposited eth.
 	receive() external payable {
+		lock(msg.sender);
+	}
+
+	// mint is receive with slippage protection: it reverts if fewer
+	// than _minUSDX usdx would be minted, or if it's mined after
+	// _deadline (a unix timestamp), so a mint signed at one price
+	// can't be filled after an oracle update at a worse one.  Returns
+	// the amount of usdx minted.
+	function mint(uint256 _minUSDX, uint256 _deadline) external payable returns (uint256) {
+		return mintTo(msg.sender, _minUSDX, _deadline);
+	}
+
+	// mintTo is mint, with msg.value locked in _to's account, and
+	// usdx minted to _to.  Only _to is able to unlock the eth, and _to
+	// must allow msg.sender as a lot sender (see setLotSender).
+	function mintTo(address _to, uint256 _minUSDX, uint256 _deadline) public payable returns (uint256) {
+		require(block.timestamp <= _deadline, "mint expired");
+		uint256 toMint = lock(_to);
+		require(toMint >= _minUSDX, "insufficient usdx minted");
+		return toMint;
+	}
+
+	// mintFor is receive on behalf of _beneficiary: mintTo
+	// _beneficiary, without slippage protection.  Only _beneficiary
+	// is able to unlock the eth; msg.sender has no claim on it.
+	function mintFor(address _beneficiary) external payable returns (uint256) {
+		return mintTo(_beneficiary, 0, block.timestamp);
+	}
+
+	// Locks msg.value in _to's account, and mints usdx to _to at the
+	// current eth/usd exchange rate.  The eth is added to _to's newest
+	// lot if it's in the same redemption bucket, or if _to already has
+	// MAX_LOTS lots, so minting never fails for lack of room; otherwise
+	// it's a new lot.
+	function lock(address _to) private live whenNotPaused returns (uint256) {
+		require(acceptsLots(_to, msg.sender), "lot sender not allowed");
 		int256 xrate = rate();
 		uint256 toMint = weiToUSDX(msg.value, xrate);
-		account storage acct = accounts[msg.sender];
+		if (msg.value == 0) {
+			return 0;
+		}
+		account storage acct = accounts[_to];
 		acct.locked += msg.value;
 		acct.mint += toMint;
-		_mint(msg.sender, toMint);
+		totalLocked += msg.value;
+
+		uint256 last = acct.lastLot;
+		if (last != 0 && (acct.lots == MAX_LOTS || lots[last].bucket == bucketOf(msg.value, toMint))) {
+			lot storage l = lots[last];
+			l.locked += msg.value.toUint128();
+			l.mint += toMint.toUint128();
+			reindexLot(last);
+		} else {
+			uint256 id = ++lastLotId;
+			lotCount++;
+			lots[id] = lot(_to, 0, 0, 0, msg.value.toUint128(), toMint.toUint128());
+			linkLot(_to, id);
+			indexLot(id);
+			positions.mint(_to, id);
+		}
+
+		_mint(_to, toMint);
+		return toMint;
 	}
 
 	// Unlocks _usdx amount of eth.  This method can only be called by
@@ -71,13 +404,26 @@ contract USDX is ERC20, Ownable {
 	// of eth the sender has previously sent.  The amount of eth
 	// unlocked is unrelated to the current ETH/USD price; it's based
 	// purely on the ratio of previously sent eth and previously
-	// minted usdx (ie: if 1 eth was previously received by this
-	// contract to mint 1000usdx, 500 usdx is able to be unlocked for
-	// .5 eth regardless of whether usd/eth price has decreased to
-	// 800usd/eth).  If _usdx is 0, msg.sender's full usdx balance
-	// will be used to unlock eth.
+	// minted usdx of the lots unlocked, oldest first (ie: if 1 eth
+	// was previously received by this contract to mint 1000usdx, 500
+	// usdx is able to be unlocked for .5 eth regardless of whether
+	// usd/eth price has decreased to 800usd/eth).  If _usdx is 0,
+	// msg.sender's full usdx balance will be used to unlock eth.
+	// Unlocked eth is credited to msg.sender's withdrawable balance;
+	// use withdraw to receive it.
 	function unlock(uint256 _usdx) public returns (uint256) {
-		account storage acct = accounts[msg.sender];
+		return unlockLots(_usdx, LotOrder.FIFO);
+	}
+
+	// unlock, with lots unlocked oldest first (FIFO), or newest first
+	// (LIFO).
+	function unlockLots(uint256 _usdx, LotOrder _order) public returns (uint256) {
+		return unlockFor(msg.sender, _usdx, _order);
+	}
+
+	// unlockLots, of _owner's lots and usdx.
+	function unlockFor(address _owner, uint256 _usdx, LotOrder _order) private live returns (uint256) {
+		account storage acct = accounts[_owner];
 		require(acct.locked > 0, "nothing to redeem");
 		if (_usdx == 0) {
 			_usdx = acct.mint;
@@ -85,61 +431,592 @@ contract USDX is ERC20, Ownable {
 			_usdx = min(_usdx, acct.mint);
 		}
 
+		_usdx = min(_usdx, balanceOf(_owner));
+		require(_usdx > 0, "no usdx balance");
+
+		uint256 left = _usdx;
+		uint256 unlockAmt = 0;
+		uint256 id = _order == LotOrder.FIFO ? acct.firstLot : acct.lastLot;
+		while (left > 0 && id != 0) {
+			uint256 next = _order == LotOrder.FIFO ? lots[id].next : lots[id].prev;
+			(uint256 burned, uint256 amt) = unlockFromLot(id, left);
+			left -= burned;
+			unlockAmt += amt;
+			id = next;
+		}
+		settleUnlock(_owner, _usdx, unlockAmt);
+		return _usdx;
+	}
+
+	// unlock, from lot _id only.  If _usdx is 0, the lot's full mint
+	// is unlocked, capped to msg.sender's usdx balance.  Since no
+	// other lots are read, a lot can always be unlocked, however many
+	// lots an account has.
+	function unlockLot(uint256 _id, uint256 _usdx) public live returns (uint256) {
+		lot storage l = lots[_id];
+		require(l.owner == msg.sender, "not lot owner");
+		if (_usdx == 0) {
+			_usdx = l.mint;
+		} else {
+			_usdx = min(_usdx, l.mint);
+		}
+
 		_usdx = min(_usdx, balanceOf(msg.sender));
 		require(_usdx > 0, "no usdx balance");
-		_burn(msg.sender, _usdx);
 
-		uint256 unlockAmt = acct.locked.mul(_usdx).div(acct.mint);
-		if (_usdx == acct.mint) {
-			delete accounts[msg.sender];
+		(, uint256 unlockAmt) = unlockFromLot(_id, _usdx);
+		settleUnlock(msg.sender, _usdx, unlockAmt);
+		return _usdx;
+	}
+
+	// Burns up to _usdx of lot _id's mint, and returns the usdx burned
+	// and the eth unlocked, in the lot's ratio of eth to usdx.  A lot
+	// whose mint is fully burned is removed with all its eth.
+	function unlockFromLot(uint256 _id, uint256 _usdx) private returns (uint256, uint256) {
+		lot storage l = lots[_id];
+		uint256 burn = min(_usdx, l.mint);
+		if (burn == l.mint) {
+			uint256 all = l.locked;
+			removeLot(_id);
+			return (burn, all);
+		}
+		uint256 unlockAmt = uint256(l.locked).mul(burn).div(l.mint);
+		l.mint -= burn.toUint128();
+		l.locked -= unlockAmt.toUint128();
+		reindexLot(_id);
+		return (burn, unlockAmt);
+	}
+
+	// Burns _usdx of _owner's, unlocked from its lots for _unlockAmt
+	// eth, and credits the eth to _owner's withdrawable balance.
+	function settleUnlock(address _owner, uint256 _usdx, uint256 _unlockAmt) private {
+		_burn(_owner, _usdx);
+		account storage acct = accounts[_owner];
+		if (acct.firstLot == 0) {
+			delete accounts[_owner];
 		} else {
 			acct.mint -= _usdx;
-			acct.locked -= unlockAmt;
+			acct.locked -= _unlockAmt;
+		}
+		totalLocked -= _unlockAmt;
+		withdrawable[_owner] += _unlockAmt;
+	}
+
+	// Redeems up to _usdx of msg.sender's usdx for eth at the current
+	// eth/usd exchange rate, taken from lots _ids in turn, and returns
+	// the usdx redeemed.  Anyone holding usdx may redeem it; the eth
+	// is credited to msg.sender's withdrawable balance, and reverts
+	// if less than _minEth.
+	//
+	// Redeeming r usdx from a lot removes r/rate eth from its locked,
+	// and r usdx from its mint, which is fair to the lot's owner at
+	// the current rate: the owner keeps their usdx, and the lot's
+	// appreciation is unchanged.  A lot whose mint is fully redeemed
+	// is removed, and its remaining eth credited to its owner's
+	// withdrawable balance.
+	//
+	// Lots must be redeemed from in order of most eth locked per usdx
+	// minted, so each of _ids must be in the lowest bucket with lots
+	// when it's redeemed from (see RedemptionLots in pkg/usdx), and be
+	// worth at least its mint at the current rate.  Redeeming from a
+	// lot only increases its eth per usdx.
+	function redeem(uint256 _usdx, uint256[] calldata _ids, uint256 _minEth) public live returns (uint256) {
+		require(_usdx > 0 && _usdx <= balanceOf(msg.sender), "insufficient usdx balance");
+		int256 xrate = rate();
+
+		uint256 left = _usdx;
+		uint256 eth = 0;
+		for (uint256 i = 0; i < _ids.length && left > 0; i++) {
+			lot storage l = lots[_ids[i]];
+			require(l.owner != address(0), "no such lot");
+			require(l.bucket != 0 && l.bucket == lowestBucket(), "lot not next in redemption order");
+			require(weiToUSDX(l.locked, xrate) >= l.mint, "lot undercollateralized");
+
+			uint256 r = min(left, l.mint);
+			uint256 amt = r.mul(10**FEED_DECS).div(xrate.toUint256());
+			left -= r;
+			eth += amt;
+			redeemFromLot(_ids[i], r, amt);
+		}
+
+		uint256 redeemed = _usdx - left;
+		require(redeemed > 0, "nothing redeemed");
+		require(eth >= _minEth, "insufficient eth redeemed");
+		_burn(msg.sender, redeemed);
+		withdrawable[msg.sender] += eth;
+		return redeemed;
+	}
+
+	// Removes _usdx from lot _id's mint, and _eth from its locked.  A
+	// lot whose mint is fully removed is removed, and its surplus eth
+	// credited to its owner.
+	function redeemFromLot(uint256 _id, uint256 _usdx, uint256 _eth) private {
+		lot storage l = lots[_id];
+		address owner = l.owner;
+		account storage acct = accounts[owner];
+		uint256 surplus = 0;
+		if (_usdx == l.mint) {
+			surplus = l.locked - _eth;
+			acct.locked -= l.locked;
+			acct.mint -= l.mint;
+			totalLocked -= l.locked;
+			withdrawable[owner] += surplus;
+			removeLot(_id);
+			if (acct.firstLot == 0) {
+				delete accounts[owner];
+			}
+		} else {
+			l.locked -= _eth.toUint128();
+			l.mint -= _usdx.toUint128();
+			acct.locked -= _eth;
+			acct.mint -= _usdx;
+			totalLocked -= _eth;
+			reindexLot(_id);
+		}
+		emit Redeemed(msg.sender, owner, _id, _usdx, _eth, surplus);
+	}
+
+	// Removes lot _id, which has been fully unlocked, and burns its
+	// token.
+	function removeLot(uint256 _id) private {
+		unindexLot(_id);
+		unlinkLot(_id);
+		delete lots[_id];
+		lotCount--;
+		positions.burn(_id);
+	}
+
+	// Returns the redemption bucket of a lot locking _locked eth and
+	// minting _mint usdx: 0 if it mints nothing, and otherwise its
+	// break-even rate's power of two, and the next 6 bits below it, in
+	// order.  Break-even rates of 2^128 or more share the top bucket.
+	function bucketOf(uint256 _locked, uint256 _mint) private pure returns (uint256) {
+		if (_mint == 0) {
+			return 0;
+		}
+		if (_locked == 0) {
+			return MAX_BUCKET;
+		}
+		uint256 c = _mint.mul(10**FEED_DECS).div(_locked);
+		if (c == 0) {
+			return 1;
+		}
+		uint256 n = log2(c);
+		if (n >= 128) {
+			return MAX_BUCKET;
+		}
+		uint256 sub = n >= 6 ? c >> (n - 6) : c << (6 - n);
+		return n * 64 + (sub & 63) + 1;
+	}
+
+	// Adds lot _id to the bucket of its current locked and mint.
+	function indexLot(uint256 _id) private {
+		lot storage l = lots[_id];
+		uint256 b = bucketOf(l.locked, l.mint);
+		l.bucket = uint16(b);
+		if (b != 0 && bucketLots[b]++ == 0) {
+			bucketMap[b >> 8] |= 1 << (b & 255);
+		}
+	}
+
+	// Removes lot _id from its bucket.
+	function unindexLot(uint256 _id) private {
+		uint256 b = lots[_id].bucket;
+		if (b != 0 && --bucketLots[b] == 0) {
+			bucketMap[b >> 8] &= ~(1 << (b & 255));
+		}
+	}
+
+	// Moves lot _id to the bucket of its current locked and mint,
+	// after they've changed.
+	function reindexLot(uint256 _id) private {
+		lot storage l = lots[_id];
+		if (bucketOf(l.locked, l.mint) != l.bucket) {
+			unindexLot(_id);
+			indexLot(_id);
+		}
+	}
+
+	// Returns the lowest bucket with lots, or 0 if there are none.
+	function lowestBucket() private view returns (uint256) {
+		for (uint256 w = 0; w <= MAX_BUCKET >> 8; w++) {
+			uint256 bits = bucketMap[w];
+			if (bits != 0) {
+				unchecked {
+					return (w << 8) + log2(bits & (~bits + 1));
+				}
+			}
+		}
+		return 0;
+	}
+
+	// Returns the floor of log2(_x), which must be nonzero.
+	function log2(uint256 _x) private pure returns (uint256 n) {
+		for (uint256 s = 128; s > 0; s >>= 1) {
+			if (_x >> s != 0) {
+				_x >>= s;
+				n += s;
+			}
+		}
+	}
+
+	// Appends lot _id to _owner's list of lots.
+	function linkLot(address _owner, uint256 _id) private {
+		account storage acct = accounts[_owner];
+		require(acct.lots < MAX_LOTS, "too many lots");
+		acct.lots++;
+		lots[_id].prev = acct.lastLot;
+		lots[_id].next = 0;
+		if (acct.lastLot == 0) {
+			acct.firstLot = uint40(_id);
+		} else {
+			lots[acct.lastLot].next = uint40(_id);
+		}
+		acct.lastLot = uint40(_id);
+	}
+
+	// Removes lot _id from its owner's list of lots.
+	function unlinkLot(uint256 _id) private {
+		lot storage l = lots[_id];
+		account storage acct = accounts[l.owner];
+		acct.lots--;
+		if (l.prev == 0) {
+			acct.firstLot = l.next;
+		} else {
+			lots[l.prev].next = l.next;
+		}
+		if (l.next == 0) {
+			acct.lastLot = l.prev;
+		} else {
+			lots[l.next].prev = l.prev;
 		}
-		payable(msg.sender).transfer(unlockAmt);
-		return _usdx;
+	}
+
+	// Moves lot _id from _from's account to the end of _to's, when its
+	// token is transferred, if _to accepts lots from _from.  Only
+	// positions may call transferLot.
+	function transferLot(address _from, address _to, uint256 _id) external {
+		require(msg.sender == address(positions), "caller is not positions");
+		require(lots[_id].owner == _from);
+		require(acceptsLots(_to, _from), "lot sender not allowed");
+		moveLot(_from, _to, _id);
+	}
+
+	// Moves lot _id from _from's account to the end of _to's.
+	function moveLot(address _from, address _to, uint256 _id) private {
+		lot storage l = lots[_id];
+		unlinkLot(_id);
+		account storage src = accounts[_from];
+		if (src.firstLot == 0) {
+			delete accounts[_from];
+		} else {
+			src.locked -= l.locked;
+			src.mint -= l.mint;
+		}
+
+		account storage dst = accounts[_to];
+		dst.locked += l.locked;
+		dst.mint += l.mint;
+		l.owner = _to;
+		linkLot(_to, _id);
+		emit LotTransferred(_from, _to, _id, l.locked, l.mint);
+	}
+
+	// Sends msg.sender's full withdrawable balance of unlocked eth.
+	// Eth is sent with call rather than transfer, so smart contract
+	// wallets which require more than 2300 gas to receive eth are
+	// supported.  The balance is cleared before sending, so
+	// reentering withdraw has nothing left to send.
+	function withdraw() public returns (uint256) {
+		uint256 amt = withdrawable[msg.sender];
+		require(amt > 0, "nothing to withdraw");
+		withdrawable[msg.sender] = 0;
+		(bool ok, ) = payable(msg.sender).call{value: amt}("");
+		require(ok, "withdraw failed");
+		return amt;
 	}
 
 	// Appreciation occurs when previously locked eth appreciates in
-	// usd price. The amount of appreciation can be collected as new
-	// usdx, up to _limit.  A _limit of 0 collects all available
+	// usd price.  Each lot appreciates separately: a lot which has
+	// depreciated doesn't offset another's appreciation.  The amount
+	// of appreciation can be collected as new usdx, up to _limit,
+	// from lots oldest first.  A _limit of 0 collects all available
 	// appreciation. To redeem the locked eth, both the principle usdx
 	// mint and any collected appreciation must be returned.
 	function collectAppreciation(uint256 _limit) public returns (uint256) {
-		account storage acct = accounts[msg.sender];
-		(bool ok, uint256 appr) = acctAppreciation(acct);
-		if (!ok) {
+		return collectFor(msg.sender, _limit);
+	}
+
+	// collectAppreciation, of _owner's account, by _owner's collector.
+	// The usdx collected is minted to _owner, and must be at least
+	// _owner's threshold.
+	function collectAppreciationFor(address _owner, uint256 _limit) public returns (uint256) {
+		delegation storage d = collectors[_owner];
+		require(d.collector != address(0) && d.collector == msg.sender, "not collector");
+		uint256 total = collectFor(_owner, _limit);
+		require(total >= d.threshold, "below threshold");
+		return total;
+	}
+
+	// Sets msg.sender's collector, who may collect msg.sender's
+	// appreciation whenever at least _threshold usdx is collectable.
+	// The zero address revokes the collector.
+	function setCollector(address _collector, uint256 _threshold) public {
+		if (_collector == address(0)) {
+			delete collectors[msg.sender];
+		} else {
+			collectors[msg.sender] = delegation(_collector, _threshold);
+		}
+		emit CollectorSet(msg.sender, _collector, _collector == address(0) ? 0 : _threshold);
+	}
+
+	// collectAppreciation, of _owner's lots, minted to _owner.
+	function collectFor(address _owner, uint256 _limit) private live whenNotPaused returns (uint256) {
+		account storage acct = accounts[_owner];
+		int256 xrate = rate();
+		uint256 total = 0;
+		for (uint256 id = acct.firstLot; id != 0; id = lots[id].next) {
+			uint256 appr = lotAppr(lots[id], xrate);
+			if (_limit > 0) {
+				appr = min(_limit - total, appr);
+			}
+			if (appr > 0) {
+				lots[id].mint += appr.toUint128();
+				reindexLot(id);
+				total += appr;
+			}
+			if (_limit > 0 && total == _limit) {
+				break;
+			}
+		}
+		if (total == 0) {
 			return 0;
 		}
+		acct.mint += total;
+		_mint(_owner, total);
+		emit Collected(_owner, total);
+		return total;
+	}
+
+	// collectAppreciation, from lot _id only.
+	function collectLotAppreciation(uint256 _id, uint256 _limit) public live whenNotPaused returns (uint256) {
+		lot storage l = lots[_id];
+		require(l.owner == msg.sender, "not lot owner");
+		uint256 appr = lotAppr(l, rate());
 		if (_limit > 0) {
 			appr = min(_limit, appr);
 		}
-		acct.mint += appr;
+		if (appr == 0) {
+			return 0;
+		}
+		l.mint += appr.toUint128();
+		reindexLot(_id);
+		accounts[msg.sender].mint += appr;
 		_mint(msg.sender, appr);
+		emit Collected(msg.sender, appr);
 		return appr;
 	}
 
-	// transferAcct will transfer the sender's locked eth to _to.  It
-	// does not transfer any usdx balance.  _to must not already have
-	// a usdx account.
+	// Places an order on msg.sender's account, and returns its id.
+	// msg.sender needn't have an account yet; an order is checked
+	// when it's executed.
+	function placeOrder(OrderKind _kind, int256 _price, uint256 _amount) public returns (uint256) {
+		require(_price > 0, "invalid price");
+		uint256 id = ++lastOrderId;
+		orders[id] = order(msg.sender, _kind, _price, _amount);
+		emit OrderPlaced(id, msg.sender, _kind, _price, _amount);
+		return id;
+	}
+
+	// Cancels msg.sender's order _id.
+	function cancelOrder(uint256 _id) public {
+		require(orders[_id].owner == msg.sender, "not order owner");
+		delete orders[_id];
+		emit OrderCancelled(_id);
+	}
+
+	// Executes order _id, if the current eth/usd rate triggers it, and
+	// returns the usdx unlocked or collected.  Anyone may execute an
+	// order.  Execution reverts, leaving the order open, if the order
+	// isn't triggered, or if it would unlock or collect nothing.
+	function executeOrder(uint256 _id) public returns (uint256) {
+		order memory o = orders[_id];
+		require(o.owner != address(0), "no such order");
+		int256 xrate = rate();
+		if (o.kind == OrderKind.StopLoss) {
+			require(xrate <= o.price, "order not triggered");
+		} else {
+			require(xrate >= o.price, "order not triggered");
+		}
+		delete orders[_id];
+
+		uint256 amt;
+		if (o.kind == OrderKind.StopLoss) {
+			amt = unlockFor(o.owner, o.amount, LotOrder.FIFO);
+		} else {
+			amt = collectFor(o.owner, o.amount);
+			require(amt > 0, "no appreciation");
+		}
+		emit OrderExecuted(_id, msg.sender, amt);
+		return amt;
+	}
+
+	// Shuts USDX down at _price, an eth/usd rate with the price feed's
+	// decimals, such as the last price the feed reported correctly.
+	function shutdown(int256 _price) public onlyOwner live {
+		require(_price > 0, "invalid price");
+		settlementPrice = _price;
+		emit Shutdown(_price);
+	}
+
+	// Shuts USDX down at the price feed's latest price, if the feed
+	// hasn't been updated for SHUTDOWN_STALENESS.  Anyone may call it.
+	// A round without an update time is incomplete, not stale.
+	function shutdownStale() public live {
+		(, int256 xrate,, uint256 updatedAt,) = usdPriceFeed.latestRoundData();
+		require(updatedAt != 0, "incomplete round");
+		require(block.timestamp > updatedAt + SHUTDOWN_STALENESS, "price feed not stale");
+		require(xrate > 0, "invalid price");
+		settlementPrice = xrate;
+		emit Shutdown(xrate);
+	}
+
+	// Settles lots _ids at the settlement price.  Anyone may settle
+	// lots, once USDX is shut down.
+	function settleLots(uint256[] calldata _ids) public {
+		require(settlementPrice != 0, "not shut down");
+		for (uint256 i = 0; i < _ids.length; i++) {
+			uint256 id = _ids[i];
+			lot storage l = lots[id];
+			require(l.owner != address(0), "no such lot");
+			address owner = l.owner;
+			uint256 locked = l.locked;
+			uint256 lotMint = l.mint;
+
+			// Debts round up, in holders' favor.
+			uint256 debt = lotMint.mul(10**FEED_DECS).add(settlementPrice.toUint256() - 1).div(settlementPrice.toUint256());
+			debt = min(debt, locked);
+			uint256 surplus = locked - debt;
+
+			account storage acct = accounts[owner];
+			acct.locked -= locked;
+			acct.mint -= lotMint;
+			totalLocked -= locked;
+			settlementPool += debt;
+			settledSupply += lotMint;
+			withdrawable[owner] += surplus;
+			removeLot(id);
+			if (acct.firstLot == 0) {
+				delete accounts[owner];
+			}
+			emit LotSettled(id, owner, lotMint, debt, surplus);
+		}
+	}
+
+	// Burns up to _usdx of msg.sender's usdx (0 is all) for its pro
+	// rata share of settlementPool, once every lot is settled, and
+	// credits the eth to msg.sender's withdrawable balance.  Returns
+	// the eth claimed.
+	function claim(uint256 _usdx) public returns (uint256) {
+		require(settlementPrice != 0, "not shut down");
+		require(lotCount == 0, "lots unsettled");
+		uint256 bal = balanceOf(msg.sender);
+		_usdx = _usdx == 0 ? bal : min(_usdx, bal);
+		require(_usdx > 0, "no usdx balance");
+
+		uint256 eth = settlementPool.mul(_usdx).div(totalSupply());
+		settlementPool -= eth;
+		settledSupply -= _usdx;
+		_burn(msg.sender, _usdx);
+		withdrawable[msg.sender] += eth;
+		emit Claimed(msg.sender, _usdx, eth);
+		return eth;
+	}
+
+	// Sets whether _sender may add lots to msg.sender's account.
+	function setLotSender(address _sender, bool _allowed) public {
+		lotSenders[msg.sender][_sender] = _allowed;
+		emit LotSenderSet(msg.sender, _sender, _allowed);
+	}
+
+	// Returns whether _owner accepts lots from _sender: its own, and
+	// those of the senders it allows.
+	function acceptsLots(address _owner, address _sender) public view returns (bool) {
+		return _owner == _sender || lotSenders[_owner][_sender] || lotSenders[_owner][address(0)];
+	}
+
+	// transferAcct will transfer the sender's locked eth, and all its
+	// lots and their tokens, to _to.  It does not transfer any usdx
+	// balance.  An address without lots accepts an account from
+	// anyone.  Otherwise the lots are added to the end of _to's, so _to
+	// must allow msg.sender as a lot sender, and have room for them
+	// within MAX_LOTS.
 	function transferAcct(address _to) public {
 		require(accounts[msg.sender].locked != 0);
-		require(accounts[_to].locked == 0);
-		accounts[_to] = accounts[msg.sender];
-		delete accounts[msg.sender];
+		require(_to != msg.sender);
+		require(accounts[_to].firstLot == 0 || acceptsLots(_to, msg.sender), "lot sender not allowed");
+		uint256 id = accounts[msg.sender].firstLot;
+		while (id != 0) {
+			uint256 next = lots[id].next;
+			moveLot(msg.sender, _to, id);
+			positions.move(msg.sender, _to, id);
+			id = next;
+		}
 	}
 
-	// Returns the amount of accrued appreciation for _account.
+	// Returns the amount of accrued appreciation for _account, the
+	// sum of its lots' appreciation.
 	function appreciation(address _account) public view returns (uint256) {
-		account storage acct = accounts[_account];
-		(, uint256 appr) = acctAppreciation(acct);
-		return appr;
+		int256 xrate = rate();
+		uint256 total = 0;
+		for (uint256 id = accounts[_account].firstLot; id != 0; id = lots[id].next) {
+			total += lotAppr(lots[id], xrate);
+		}
+		return total;
 	}
 
-	function acctAppreciation(account storage _acct) private view returns (bool, uint256) {
-		int256 xrate = rate();
-		uint256 lockedVal = weiToUSDX(_acct.locked, xrate);
-		return SafeMath.trySub(lockedVal, _acct.mint);
+	// Returns the lots with ids from _start to _start+_count-1 which
+	// exist, in order of id, so every lot can be read a page per call
+	// rather than a call per lot.  next is the id the following page
+	// starts at, or 0 once the page reaches lastLotId.
+	function lotPage(uint256 _start, uint256 _count) public view returns (uint256[] memory ids, address[] memory owners, uint256[] memory locked, uint256[] memory mint, uint256 next) {
+		uint256 end = uint256(lastLotId) + 1;
+		if (_start < end && _count < end - _start) {
+			end = _start + _count;
+			next = end;
+		}
+		uint256 n = 0;
+		for (uint256 id = _start; id < end; id++) {
+			if (lots[id].owner != address(0)) {
+				n++;
+			}
+		}
+		ids = new uint256[](n);
+		owners = new address[](n);
+		locked = new uint256[](n);
+		mint = new uint256[](n);
+		n = 0;
+		for (uint256 id = _start; id < end; id++) {
+			lot storage l = lots[id];
+			if (l.owner != address(0)) {
+				ids[n] = id;
+				owners[n] = l.owner;
+				locked[n] = l.locked;
+				mint[n] = l.mint;
+				n++;
+			}
+		}
+	}
+
+	// Returns the amount of accrued appreciation for lot _id.
+	function lotAppreciation(uint256 _id) public view returns (uint256) {
+		return lotAppr(lots[_id], rate());
+	}
+
+	function lotAppr(lot storage _lot, int256 _xrate) private view returns (uint256) {
+		uint256 lockedVal = weiToUSDX(_lot.locked, _xrate);
+		(, uint256 appr) = SafeMath.trySub(lockedVal, _lot.mint);
+		return appr;
 	}
 
 	function rate() private view returns (int256) {
@@ -156,3 +1033,44 @@ contract USDX is ERC20, Ownable {
 		return a < b ? a : b;
 	}
 }
+
+// USDXPositions are ERC-721 tokens of USDX's lots; a lot's token id is
+// its lot id.  USDX mints a lot's token to the lot's owner, moves it
+// with transferAcct, and burns it when the lot is fully unlocked.
+// Transferring a token any other way transfers its lot, to a recipient
+// which accepts lots from the token's holder (see USDX.setLotSender),
+// so a token's holder is always its lot's owner, and the only account
+// able to unlock it.  The lot's usdx isn't transferred with it.
+contract USDXPositions is ERC721 {
+	USDX public immutable usdx;
+
+	constructor () ERC721("USDX Position", "USDXP") {
+		usdx = USDX(payable(msg.sender));
+	}
+
+	modifier onlyUSDX() {
+		require(msg.sender == address(usdx), "caller is not usdx");
+		_;
+	}
+
+	function mint(address _to, uint256 _id) external onlyUSDX {
+		_mint(_to, _id);
+	}
+
+	function burn(uint256 _id) external onlyUSDX {
+		_burn(_id);
+	}
+
+	function move(address _from, address _to, uint256 _id) external onlyUSDX {
+		_transfer(_from, _to, _id);
+	}
+
+	// Transfers, other than USDX's mints, burns and moves, transfer
+	// the token's lot.
+	function _beforeTokenTransfer(address _from, address _to, uint256 _id) internal override {
+		super._beforeTokenTransfer(_from, _to, _id);
+		if (msg.sender != address(usdx)) {
+			usdx.transferLot(_from, _to, _id);
+		}
+	}
+}